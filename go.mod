@@ -1,6 +1,6 @@
 module github.com/heimweh/go-pagerduty
 
-go 1.17
+go 1.21
 
 require (
 	github.com/google/go-querystring v1.1.0
@@ -1,6 +1,12 @@
 package pagerduty
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
 
 // AbilityService handles the communication with ability related methods
 // of the PagerDuty API.
@@ -11,14 +17,40 @@ type ListAbilitiesResponse struct {
 	Abilities []string `json:"abilities,omitempty"`
 }
 
-// Test tests whether the account has a given ability.
-func (s *AbilityService) Test(id string) (*Response, error) {
+// Test tests whether the account has a given ability. It returns false
+// without an error when the API reports the ability as unavailable (HTTP 402)
+// or unknown (HTTP 404).
+func (s *AbilityService) Test(id string) (bool, *Response, error) {
+	return s.TestContext(context.Background(), id)
+}
+
+// TestContext tests whether the account has a given ability. It returns false
+// without an error when the API reports the ability as unavailable (HTTP 402)
+// or unknown (HTTP 404).
+func (s *AbilityService) TestContext(ctx context.Context, id string) (bool, *Response, error) {
 	u := fmt.Sprintf("/abilities/%s", id)
-	return s.client.newRequestDo("GET", u, nil, nil, nil)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, nil, WithRoute("/abilities/{id}"))
+	if hasStatusCode(err, http.StatusPaymentRequired) || hasStatusCode(err, http.StatusNotFound) {
+		return false, resp, nil
+	}
+	if err != nil {
+		return false, resp, err
+	}
+
+	return true, resp, nil
 }
 
-// List lists available abilities.
+// List lists available abilities. With Config.AbilitiesCacheTTL set, the list
+// is only requested again once the TTL has passed or Refresh was called, and
+// the returned *Response is nil for cached results.
 func (s *AbilityService) List() (*ListAbilitiesResponse, *Response, error) {
+	return s.ListContext(context.Background())
+}
+
+// ListContext lists available abilities. With Config.AbilitiesCacheTTL set,
+// the list is only requested again once the TTL has passed or Refresh was
+// called, and the returned *Response is nil for cached results.
+func (s *AbilityService) ListContext(ctx context.Context) (*ListAbilitiesResponse, *Response, error) {
 	u := "/abilities"
 	v := new(ListAbilitiesResponse)
 
@@ -27,10 +59,66 @@ func (s *AbilityService) List() (*ListAbilitiesResponse, *Response, error) {
 		return v, nil, nil
 	}
 
-	resp, err := s.client.newRequestDo("GET", u, nil, nil, v)
+	cache := s.client.abilitiesCache
+	if cache != nil {
+		if abilities, ok := cache.get(s.client.now()); ok {
+			return &ListAbilitiesResponse{Abilities: abilities}, nil, nil
+		}
+	}
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, v, WithRoute("/abilities"))
 	if err != nil {
 		return nil, nil, err
 	}
 
+	if cache != nil {
+		cache.put(v.Abilities, s.client.now())
+	}
+
 	return v, resp, nil
 }
+
+// Refresh discards the abilities cached by List, so that the next call
+// requests them from the API again. It does nothing without
+// Config.AbilitiesCacheTTL.
+func (s *AbilityService) Refresh() {
+	if s.client.abilitiesCache != nil {
+		s.client.abilitiesCache.clear()
+	}
+}
+
+// abilitiesCache holds the abilities of the account for a limited time. It
+// is safe for concurrent use.
+type abilitiesCache struct {
+	ttl time.Duration
+
+	mu        sync.Mutex
+	abilities []string
+	expiry    time.Time
+}
+
+// get returns a copy of the cached abilities, if they have not expired.
+func (c *abilitiesCache) get(now time.Time) ([]string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.abilities == nil || !now.Before(c.expiry) {
+		return nil, false
+	}
+	return append([]string(nil), c.abilities...), true
+}
+
+func (c *abilitiesCache) put(abilities []string, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.abilities = append([]string{}, abilities...)
+	c.expiry = now.Add(c.ttl)
+}
+
+func (c *abilitiesCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.abilities = nil
+}
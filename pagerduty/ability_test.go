@@ -3,7 +3,9 @@ package pagerduty
 import (
 	"net/http"
 	"reflect"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestAbilitiesList(t *testing.T) {
@@ -52,9 +54,13 @@ func TestAbilitiesTestAbility(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 
-	if _, err := client.Abilities.Test("sso"); err != nil {
+	ok, _, err := client.Abilities.Test("sso")
+	if err != nil {
 		t.Fatal(err)
 	}
+	if !ok {
+		t.Error("Test returned false; want true")
+	}
 }
 
 func TestAbilitiesTestAbilityFailure(t *testing.T) {
@@ -66,7 +72,113 @@ func TestAbilitiesTestAbilityFailure(t *testing.T) {
 		w.WriteHeader(http.StatusForbidden)
 	})
 
-	if _, err := client.Abilities.Test("sso"); err == nil {
+	if _, _, err := client.Abilities.Test("sso"); err == nil {
 		t.Fatal("expected error; got nil")
 	}
 }
+
+func TestAbilitiesTestAbilityUnavailable(t *testing.T) {
+	for _, status := range []int{http.StatusPaymentRequired, http.StatusNotFound} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			mux.HandleFunc("/abilities/sso", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(status)
+			})
+
+			ok, _, err := client.Abilities.Test("sso")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok {
+				t.Error("Test returned true; want false")
+			}
+		})
+	}
+}
+
+func TestAbilitiesListCache(t *testing.T) {
+	setup()
+	defer teardown()
+
+	c, err := NewClient(&Config{BaseURL: server.URL, Token: "foo", AbilitiesCacheTTL: time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	calls := 0
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{"abilities": ["sso"]}`))
+	})
+
+	list := func() {
+		t.Helper()
+		abilities, _, err := c.Abilities.List()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(abilities.Abilities, []string{"sso"}) {
+			t.Errorf("returned %#v; want [sso]", abilities.Abilities)
+		}
+	}
+
+	list()
+	list()
+	if calls != 1 {
+		t.Errorf("calls = %d, want the second list to be cached", calls)
+	}
+
+	now = now.Add(time.Minute)
+	list()
+	if calls != 2 {
+		t.Errorf("calls = %d, want the list to be requested after the TTL", calls)
+	}
+
+	c.Abilities.Refresh()
+	list()
+	if calls != 3 {
+		t.Errorf("calls = %d, want the list to be requested after Refresh", calls)
+	}
+}
+
+func TestAbilitiesListCacheConcurrent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	c, err := NewClient(&Config{BaseURL: server.URL, Token: "foo", AbilitiesCacheTTL: time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"abilities": ["sso", "teams"]}`))
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			abilities, _, err := c.Abilities.List()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			abilities.Abilities[0] = "modified"
+			c.Abilities.Refresh()
+		}()
+	}
+	wg.Wait()
+
+	abilities, _, err := c.Abilities.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if abilities.Abilities[0] != "sso" {
+		t.Errorf("cached abilities were modified by a caller: %v", abilities.Abilities)
+	}
+}
@@ -1,6 +1,9 @@
 package pagerduty
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // AddonService handles the communication with add-on related methods
 // of the PagerDuty API.
@@ -19,10 +22,11 @@ type Addon struct {
 
 // ListAddonsOptions represents options when listing add-ons.
 type ListAddonsOptions struct {
-	Limit      int      `url:"limit,omitempty"`
-	More       bool     `url:"more,omitempty"`
-	Offset     int      `url:"offset,omitempty"`
-	Total      int      `url:"total,omitempty"`
+	ListOptions
+
+	// Deprecated: More is not a request parameter and is ignored.
+	More bool `url:"-"`
+
 	Filter     string   `url:"filter,omitempty"`
 	Include    []string `url:"include,omitempty,brackets"`
 	ServiceIDs []string `url:"service_ids,omitempty,brackets"`
@@ -30,10 +34,7 @@ type ListAddonsOptions struct {
 
 // ListAddonsResponse represents a list response of add-ons.
 type ListAddonsResponse struct {
-	Limit  int      `json:"limit,omitempty"`
-	More   bool     `json:"more,omitempty"`
-	Offset int      `json:"offset,omitempty"`
-	Total  int      `json:"total,omitempty"`
+	PaginationMeta
 	Addons []*Addon `json:"addons,omitempty"`
 }
 
@@ -44,10 +45,15 @@ type AddonPayload struct {
 
 // List lists installed add-ons.
 func (s *AddonService) List(o *ListAddonsOptions) (*ListAddonsResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists installed add-ons.
+func (s *AddonService) ListContext(ctx context.Context, o *ListAddonsOptions) (*ListAddonsResponse, *Response, error) {
 	u := "/addons"
 	v := new(ListAddonsResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/addons"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -57,10 +63,15 @@ func (s *AddonService) List(o *ListAddonsOptions) (*ListAddonsResponse, *Respons
 
 // Install installs an add-on.
 func (s *AddonService) Install(addon *Addon) (*Addon, *Response, error) {
+	return s.InstallContext(context.Background(), addon)
+}
+
+// InstallContext installs an add-on.
+func (s *AddonService) InstallContext(ctx context.Context, addon *Addon) (*Addon, *Response, error) {
 	u := "/addons"
 	v := new(AddonPayload)
 
-	resp, err := s.client.newRequestDo("POST", u, nil, &AddonPayload{Addon: addon}, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &AddonPayload{Addon: addon}, v, WithRoute("/addons"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -70,16 +81,26 @@ func (s *AddonService) Install(addon *Addon) (*Addon, *Response, error) {
 
 // Delete removes an existing add-on.
 func (s *AddonService) Delete(id string) (*Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext removes an existing add-on.
+func (s *AddonService) DeleteContext(ctx context.Context, id string) (*Response, error) {
 	u := fmt.Sprintf("/addons/%s", id)
-	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/addons/{id}"))
 }
 
 // Get retrieves information about an add-on.
 func (s *AddonService) Get(id string) (*Addon, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext retrieves information about an add-on.
+func (s *AddonService) GetContext(ctx context.Context, id string) (*Addon, *Response, error) {
 	u := fmt.Sprintf("/addons/%s", id)
 	v := new(AddonPayload)
 
-	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/addons/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -89,12 +110,68 @@ func (s *AddonService) Get(id string) (*Addon, *Response, error) {
 
 // Update updates an existing add-on.
 func (s *AddonService) Update(id string, addon *Addon) (*Addon, *Response, error) {
+	return s.UpdateContext(context.Background(), id, addon)
+}
+
+// UpdateContext updates an existing add-on.
+func (s *AddonService) UpdateContext(ctx context.Context, id string, addon *Addon) (*Addon, *Response, error) {
 	u := fmt.Sprintf("/addons/%s", id)
 	v := new(AddonPayload)
-	resp, err := s.client.newRequestDo("PUT", u, nil, &AddonPayload{Addon: addon}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &AddonPayload{Addon: addon}, &v, WithRoute("/addons/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
 
 	return v.Addon, resp, nil
 }
+
+type listAddonsOptionsGen struct {
+	options *ListAddonsOptions
+}
+
+func (o *listAddonsOptionsGen) currentOffset() int {
+	return o.options.Offset
+}
+
+func (o *listAddonsOptionsGen) changeOffset(i int) {
+	o.options.Offset = i
+}
+
+func (o *listAddonsOptionsGen) buildStruct() interface{} {
+	return o.options
+}
+
+// ListPages lists existing add-ons, calling fn with every page of results until
+// there are no more pages or fn returns an error.
+func (s *AddonService) ListPages(o *ListAddonsOptions, fn func([]*Addon) error) error {
+	return s.ListPagesContext(context.Background(), o, fn)
+}
+
+// ListPagesContext lists existing add-ons, calling fn with every page of results until
+// there are no more pages or fn returns an error.
+func (s *AddonService) ListPagesContext(ctx context.Context, o *ListAddonsOptions, fn func([]*Addon) error) error {
+	opts := ListAddonsOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	responseHandler := func(response *Response) (PaginationMeta, *Response, error) {
+		var result ListAddonsResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return PaginationMeta{}, response, err
+		}
+
+		if err := fn(result.Addons); err != nil {
+			return PaginationMeta{}, response, err
+		}
+
+		return PaginationMeta{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+
+	return s.client.newRequestPagedGetQueryDoContext(ctx, "/addons", responseHandler, &listAddonsOptionsGen{options: &opts}, WithRoute("/addons"))
+}
@@ -0,0 +1,167 @@
+package pagerduty
+
+import (
+	"context"
+)
+
+// AuditService handles the communication with audit record
+// related methods of the PagerDuty API.
+type AuditService service
+
+// AuditRecord represents a single audit record.
+type AuditRecord struct {
+	ID               string                 `json:"id,omitempty"`
+	Self             string                 `json:"self,omitempty"`
+	ExecutionTime    string                 `json:"execution_time,omitempty"`
+	ExecutionContext *AuditExecutionContext `json:"execution_context,omitempty"`
+	Actors           []*AuditActor          `json:"actors,omitempty"`
+	Method           *AuditMethod           `json:"method,omitempty"`
+	RootResource     *AuditResource         `json:"root_resource,omitempty"`
+	Action           string                 `json:"action,omitempty"`
+	Details          *AuditDetails          `json:"details,omitempty"`
+}
+
+// AuditExecutionContext represents the context in which an audited action was executed.
+type AuditExecutionContext struct {
+	RequestID     string `json:"request_id,omitempty"`
+	RemoteAddress string `json:"remote_address,omitempty"`
+}
+
+// AuditActor represents the entity that performed an audited action.
+type AuditActor struct {
+	ID      string `json:"id,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Summary string `json:"summary,omitempty"`
+}
+
+// AuditMethod represents how an audited action was performed.
+type AuditMethod struct {
+	Type           string `json:"type,omitempty"`
+	Description    string `json:"description,omitempty"`
+	TruncatedToken string `json:"truncated_token,omitempty"`
+}
+
+// AuditResource represents a resource referenced by an audit record.
+type AuditResource struct {
+	ID      string `json:"id,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Summary string `json:"summary,omitempty"`
+	Self    string `json:"self,omitempty"`
+	HTMLURL string `json:"html_url,omitempty"`
+}
+
+// AuditDetails represents the changes recorded by an audit record.
+type AuditDetails struct {
+	Resource   *AuditResource         `json:"resource,omitempty"`
+	Fields     []*AuditField          `json:"fields,omitempty"`
+	References []*AuditFieldReference `json:"references,omitempty"`
+}
+
+// AuditField represents a single changed field of an audited resource.
+type AuditField struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Value       string `json:"value,omitempty"`
+	BeforeValue string `json:"before_value,omitempty"`
+}
+
+// AuditFieldReference represents changed references of an audited resource.
+type AuditFieldReference struct {
+	Name        string           `json:"name,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Added       []*AuditResource `json:"added,omitempty"`
+	Removed     []*AuditResource `json:"removed,omitempty"`
+}
+
+// ListAuditRecordsOptions represents options when listing audit records.
+type ListAuditRecordsOptions struct {
+	CursorPagination
+	Since                string   `url:"since,omitempty"`
+	Until                string   `url:"until,omitempty"`
+	RootResourceTypes    []string `url:"root_resource_types,omitempty,brackets"`
+	ActorType            string   `url:"actor_type,omitempty"`
+	ActorID              string   `url:"actor_id,omitempty"`
+	MethodType           string   `url:"method_type,omitempty"`
+	MethodTruncatedToken string   `url:"method_truncated_token,omitempty"`
+	Actions              []string `url:"actions,omitempty,brackets"`
+}
+
+// ListAuditRecordsResponse represents a list response of audit records.
+type ListAuditRecordsResponse struct {
+	CursorPagination
+	Records []*AuditRecord `json:"records,omitempty"`
+}
+
+// List lists a single page of audit records. Use the NextCursor of the
+// response as the Cursor option to fetch the following page.
+func (s *AuditService) List(o *ListAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists a single page of audit records. Use the NextCursor of the
+// response as the Cursor option to fetch the following page.
+func (s *AuditService) ListContext(ctx context.Context, o *ListAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	return s.client.listAuditRecordsContext(ctx, "/audit/records", "/audit/records", o)
+}
+
+// ListAll lists every audit record matching the options, following the
+// cursor until the last page.
+func (s *AuditService) ListAll(o *ListAuditRecordsOptions) ([]*AuditRecord, error) {
+	return s.ListAllContext(context.Background(), o)
+}
+
+// ListAllContext lists every audit record matching the options, following the
+// cursor until the last page.
+func (s *AuditService) ListAllContext(ctx context.Context, o *ListAuditRecordsOptions) ([]*AuditRecord, error) {
+	return s.client.listAllAuditRecordsContext(ctx, "/audit/records", "/audit/records", o)
+}
+
+// listAuditRecordsContext fetches a single page of audit records from u,
+// whose templated route is route. It is shared by every endpoint that
+// returns audit records.
+func (c *Client) listAuditRecordsContext(ctx context.Context, u, route string, o *ListAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	v := new(ListAuditRecordsResponse)
+
+	resp, err := c.newRequestDoOptionsContext(ctx, "GET", u, o, nil, v, WithRoute(route))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// listAllAuditRecordsContext fetches every page of audit records from u,
+// whose templated route is route.
+func (c *Client) listAllAuditRecordsContext(ctx context.Context, u, route string, o *ListAuditRecordsOptions) ([]*AuditRecord, error) {
+	opts := ListAuditRecordsOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	records := make([]*AuditRecord, 0)
+
+	responseHandler := func(response *Response) (CursorListResp, *Response, error) {
+		var result ListAuditRecordsResponse
+
+		if err := c.DecodeJSON(response, &result); err != nil {
+			return CursorListResp{}, response, err
+		}
+
+		records = append(records, result.Records...)
+
+		return CursorListResp{
+			Limit:      result.Limit,
+			NextCursor: result.NextCursor,
+		}, response, nil
+	}
+
+	err := c.newRequestCursorPagedGetQueryDoContext(ctx, u, responseHandler, &cursorPaginationGen{
+		options: &opts,
+		page:    &opts.CursorPagination,
+	}, WithRoute(route))
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
@@ -0,0 +1,78 @@
+package pagerduty
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAuditList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/audit/records", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.Query().Get("cursor"); got != "abc" {
+			t.Errorf("cursor = %q, want %q", got, "abc")
+		}
+		if got := r.URL.Query().Get("limit"); got != "1" {
+			t.Errorf("limit = %q, want %q", got, "1")
+		}
+		w.Write([]byte(`{"records": [{"id": "1", "action": "create"}], "limit": 1, "next_cursor": "def"}`))
+	})
+
+	resp, _, err := client.Audit.List(&ListAuditRecordsOptions{
+		CursorPagination: CursorPagination{Limit: 1, Cursor: "abc"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListAuditRecordsResponse{
+		CursorPagination: CursorPagination{Limit: 1, NextCursor: "def"},
+		Records:          []*AuditRecord{{ID: "1", Action: "create"}},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestAuditListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/audit/records", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.Query().Get("actor_id"); got != "PXPGF42" {
+			t.Errorf("actor_id = %q, want %q", got, "PXPGF42")
+		}
+
+		switch cursor := r.URL.Query().Get("cursor"); cursor {
+		case "":
+			w.Write([]byte(`{"records": [{"id": "1"}], "next_cursor": "abc"}`))
+		case "abc":
+			w.Write([]byte(`{"records": [{"id": "2"}], "next_cursor": "def"}`))
+		case "def":
+			w.Write([]byte(`{"records": [{"id": "3"}], "next_cursor": null}`))
+		default:
+			t.Fatalf("Unexpected cursor: %v", cursor)
+		}
+	})
+
+	opts := &ListAuditRecordsOptions{ActorID: "PXPGF42"}
+	resp, err := client.Audit.ListAll(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*AuditRecord{{ID: "1"}, {ID: "2"}, {ID: "3"}}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+
+	if opts.Cursor != "" {
+		t.Errorf("ListAll modified the caller's options: cursor = %q", opts.Cursor)
+	}
+}
@@ -1,6 +1,9 @@
 package pagerduty
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // AutomationActionsAction handles the communication with Automation Actions
 // related methods of the PagerDuty API.
@@ -47,10 +50,15 @@ var automationActionsActionBaseUrl = "/automation_actions/actions"
 
 // Create creates a new action
 func (s *AutomationActionsActionService) Create(action *AutomationActionsAction) (*AutomationActionsAction, *Response, error) {
+	return s.CreateContext(context.Background(), action)
+}
+
+// CreateContext creates a new action
+func (s *AutomationActionsActionService) CreateContext(ctx context.Context, action *AutomationActionsAction) (*AutomationActionsAction, *Response, error) {
 	u := automationActionsActionBaseUrl
 	v := new(AutomationActionsActionPayload)
 
-	resp, err := s.client.newRequestDoOptions("POST", u, nil, &AutomationActionsActionPayload{Action: action}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &AutomationActionsActionPayload{Action: action}, &v, WithRoute(automationActionsActionBaseUrl))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -60,10 +68,15 @@ func (s *AutomationActionsActionService) Create(action *AutomationActionsAction)
 
 // Get retrieves information about an action.
 func (s *AutomationActionsActionService) Get(id string) (*AutomationActionsAction, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext retrieves information about an action.
+func (s *AutomationActionsActionService) GetContext(ctx context.Context, id string) (*AutomationActionsAction, *Response, error) {
 	u := fmt.Sprintf("%s/%s", automationActionsActionBaseUrl, id)
 	v := new(AutomationActionsActionPayload)
 
-	resp, err := s.client.newRequestDoOptions("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/automation_actions/actions/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -73,11 +86,16 @@ func (s *AutomationActionsActionService) Get(id string) (*AutomationActionsActio
 
 // Update an existing action
 func (s *AutomationActionsActionService) Update(ID string, action *AutomationActionsAction) (*AutomationActionsAction, *Response, error) {
+	return s.UpdateContext(context.Background(), ID, action)
+}
+
+// UpdateContext updates an existing action.
+func (s *AutomationActionsActionService) UpdateContext(ctx context.Context, ID string, action *AutomationActionsAction) (*AutomationActionsAction, *Response, error) {
 	u := fmt.Sprintf("%s/%s", automationActionsActionBaseUrl, ID)
 	v := new(AutomationActionsActionPayload)
 	p := &AutomationActionsActionPayload{Action: action}
 
-	resp, err := s.client.newRequestDo("PUT", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, p, v, WithRoute("/automation_actions/actions/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -87,20 +105,30 @@ func (s *AutomationActionsActionService) Update(ID string, action *AutomationAct
 
 // Delete deletes an existing action.
 func (s *AutomationActionsActionService) Delete(id string) (*Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext deletes an existing action.
+func (s *AutomationActionsActionService) DeleteContext(ctx context.Context, id string) (*Response, error) {
 	u := fmt.Sprintf("%s/%s", automationActionsActionBaseUrl, id)
 
-	return s.client.newRequestDoOptions("DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/automation_actions/actions/{id}"))
 }
 
 // Associate an Automation Action with a team
 func (s *AutomationActionsActionService) AssociateToTeam(actionID, teamID string) (*AutomationActionsActionTeamAssociationPayload, *Response, error) {
+	return s.AssociateToTeamContext(context.Background(), actionID, teamID)
+}
+
+// AssociateToTeamContext Associate an Automation Action with a team
+func (s *AutomationActionsActionService) AssociateToTeamContext(ctx context.Context, actionID, teamID string) (*AutomationActionsActionTeamAssociationPayload, *Response, error) {
 	u := fmt.Sprintf("%s/%s/teams", automationActionsActionBaseUrl, actionID)
 	v := new(AutomationActionsActionTeamAssociationPayload)
 	p := &AutomationActionsActionTeamAssociationPayload{
 		Team: &TeamReference{ID: teamID, Type: "team_reference"},
 	}
 
-	resp, err := s.client.newRequestDoOptions("POST", u, nil, p, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, p, &v, WithRoute("/automation_actions/actions/{id}/teams"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -110,17 +138,27 @@ func (s *AutomationActionsActionService) AssociateToTeam(actionID, teamID string
 
 // Dissociate an Automation Action with a team
 func (s *AutomationActionsActionService) DissociateToTeam(actionID, teamID string) (*Response, error) {
+	return s.DissociateToTeamContext(context.Background(), actionID, teamID)
+}
+
+// DissociateToTeamContext Dissociate an Automation Action with a team
+func (s *AutomationActionsActionService) DissociateToTeamContext(ctx context.Context, actionID, teamID string) (*Response, error) {
 	u := fmt.Sprintf("%s/%s/teams/%s", automationActionsActionBaseUrl, actionID, teamID)
 
-	return s.client.newRequestDoOptions("DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/automation_actions/actions/{id}/teams/{id}"))
 }
 
 // Gets the details of an Automation Action / team relation
 func (s *AutomationActionsActionService) GetAssociationToTeam(actionID, teamID string) (*AutomationActionsActionTeamAssociationPayload, *Response, error) {
+	return s.GetAssociationToTeamContext(context.Background(), actionID, teamID)
+}
+
+// GetAssociationToTeamContext Gets the details of an Automation Action / team relation
+func (s *AutomationActionsActionService) GetAssociationToTeamContext(ctx context.Context, actionID, teamID string) (*AutomationActionsActionTeamAssociationPayload, *Response, error) {
 	u := fmt.Sprintf("%s/%s/teams/%s", automationActionsActionBaseUrl, actionID, teamID)
 	v := new(AutomationActionsActionTeamAssociationPayload)
 
-	resp, err := s.client.newRequestDoOptions("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/automation_actions/actions/{id}/teams/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -130,13 +168,18 @@ func (s *AutomationActionsActionService) GetAssociationToTeam(actionID, teamID s
 
 // Associate an Automation Action with a service
 func (s *AutomationActionsActionService) AssociateToService(actionID, serviceID string) (*AutomationActionsActionServiceAssociationPayload, *Response, error) {
+	return s.AssociateToServiceContext(context.Background(), actionID, serviceID)
+}
+
+// AssociateToServiceContext Associate an Automation Action with a service
+func (s *AutomationActionsActionService) AssociateToServiceContext(ctx context.Context, actionID, serviceID string) (*AutomationActionsActionServiceAssociationPayload, *Response, error) {
 	u := fmt.Sprintf("%s/%s/services", automationActionsActionBaseUrl, actionID)
 	v := new(AutomationActionsActionServiceAssociationPayload)
 	p := &AutomationActionsActionServiceAssociationPayload{
 		Service: &ServiceReference{ID: serviceID, Type: "service_reference"},
 	}
 
-	resp, err := s.client.newRequestDoOptions("POST", u, nil, p, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, p, &v, WithRoute("/automation_actions/actions/{id}/services"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -146,17 +189,27 @@ func (s *AutomationActionsActionService) AssociateToService(actionID, serviceID
 
 // Dissociate an Automation Action with a service
 func (s *AutomationActionsActionService) DissociateFromService(actionID, serviceID string) (*Response, error) {
+	return s.DissociateFromServiceContext(context.Background(), actionID, serviceID)
+}
+
+// DissociateFromServiceContext Dissociate an Automation Action with a service
+func (s *AutomationActionsActionService) DissociateFromServiceContext(ctx context.Context, actionID, serviceID string) (*Response, error) {
 	u := fmt.Sprintf("%s/%s/services/%s", automationActionsActionBaseUrl, actionID, serviceID)
 
-	return s.client.newRequestDoOptions("DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/automation_actions/actions/{id}/services/{id}"))
 }
 
 // Gets the details of an Automation Action / service relation
 func (s *AutomationActionsActionService) GetAssociationToService(actionID, serviceID string) (*AutomationActionsActionServiceAssociationPayload, *Response, error) {
+	return s.GetAssociationToServiceContext(context.Background(), actionID, serviceID)
+}
+
+// GetAssociationToServiceContext Gets the details of an Automation Action / service relation
+func (s *AutomationActionsActionService) GetAssociationToServiceContext(ctx context.Context, actionID, serviceID string) (*AutomationActionsActionServiceAssociationPayload, *Response, error) {
 	u := fmt.Sprintf("%s/%s/services/%s", automationActionsActionBaseUrl, actionID, serviceID)
 	v := new(AutomationActionsActionServiceAssociationPayload)
 
-	resp, err := s.client.newRequestDoOptions("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/automation_actions/actions/{id}/services/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
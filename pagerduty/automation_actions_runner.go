@@ -1,6 +1,9 @@
 package pagerduty
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // AutomationActionsRunner handles the communication with schedule
 // related methods of the PagerDuty API.
@@ -37,10 +40,15 @@ var automationActionsRunnerBaseUrl = "/automation_actions/runners"
 
 // Create creates a new runner
 func (s *AutomationActionsRunnerService) Create(runner *AutomationActionsRunner) (*AutomationActionsRunner, *Response, error) {
+	return s.CreateContext(context.Background(), runner)
+}
+
+// CreateContext creates a new runner
+func (s *AutomationActionsRunnerService) CreateContext(ctx context.Context, runner *AutomationActionsRunner) (*AutomationActionsRunner, *Response, error) {
 	u := automationActionsRunnerBaseUrl
 	v := new(AutomationActionsRunnerPayload)
 
-	resp, err := s.client.newRequestDoOptions("POST", u, nil, &AutomationActionsRunnerPayload{Runner: runner}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &AutomationActionsRunnerPayload{Runner: runner}, &v, WithRoute(automationActionsRunnerBaseUrl))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -50,10 +58,15 @@ func (s *AutomationActionsRunnerService) Create(runner *AutomationActionsRunner)
 
 // Get retrieves information about a runner.
 func (s *AutomationActionsRunnerService) Get(id string) (*AutomationActionsRunner, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext retrieves information about a runner.
+func (s *AutomationActionsRunnerService) GetContext(ctx context.Context, id string) (*AutomationActionsRunner, *Response, error) {
 	u := fmt.Sprintf("%s/%s", automationActionsRunnerBaseUrl, id)
 	v := new(AutomationActionsRunnerPayload)
 
-	resp, err := s.client.newRequestDoOptions("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/automation_actions/runners/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -63,11 +76,16 @@ func (s *AutomationActionsRunnerService) Get(id string) (*AutomationActionsRunne
 
 // Update an existing runner
 func (s *AutomationActionsRunnerService) Update(ID string, runner *AutomationActionsRunner) (*AutomationActionsRunner, *Response, error) {
+	return s.UpdateContext(context.Background(), ID, runner)
+}
+
+// UpdateContext updates an existing runner.
+func (s *AutomationActionsRunnerService) UpdateContext(ctx context.Context, ID string, runner *AutomationActionsRunner) (*AutomationActionsRunner, *Response, error) {
 	u := fmt.Sprintf("%s/%s", automationActionsRunnerBaseUrl, ID)
 	v := new(AutomationActionsRunnerPayload)
 	p := &AutomationActionsRunnerPayload{Runner: runner}
 
-	resp, err := s.client.newRequestDo("PUT", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, p, v, WithRoute("/automation_actions/runners/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -77,20 +95,30 @@ func (s *AutomationActionsRunnerService) Update(ID string, runner *AutomationAct
 
 // Delete deletes an existing runner.
 func (s *AutomationActionsRunnerService) Delete(id string) (*Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext deletes an existing runner.
+func (s *AutomationActionsRunnerService) DeleteContext(ctx context.Context, id string) (*Response, error) {
 	u := fmt.Sprintf("%s/%s", automationActionsRunnerBaseUrl, id)
 
-	return s.client.newRequestDoOptions("DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/automation_actions/runners/{id}"))
 }
 
 // Associate a Runner with a team
 func (s *AutomationActionsRunnerService) AssociateToTeam(runnerID, teamID string) (*AutomationActionsRunnerTeamAssociationPayload, *Response, error) {
+	return s.AssociateToTeamContext(context.Background(), runnerID, teamID)
+}
+
+// AssociateToTeamContext Associate a Runner with a team
+func (s *AutomationActionsRunnerService) AssociateToTeamContext(ctx context.Context, runnerID, teamID string) (*AutomationActionsRunnerTeamAssociationPayload, *Response, error) {
 	u := fmt.Sprintf("%s/%s/teams", automationActionsRunnerBaseUrl, runnerID)
 	v := new(AutomationActionsRunnerTeamAssociationPayload)
 	p := &AutomationActionsRunnerTeamAssociationPayload{
 		Team: &TeamReference{ID: teamID, Type: "team_reference"},
 	}
 
-	resp, err := s.client.newRequestDoOptions("POST", u, nil, p, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, p, &v, WithRoute("/automation_actions/runners/{id}/teams"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -100,17 +128,27 @@ func (s *AutomationActionsRunnerService) AssociateToTeam(runnerID, teamID string
 
 // Dissociate an Runner with a team
 func (s *AutomationActionsRunnerService) DissociateFromTeam(runnerID, teamID string) (*Response, error) {
+	return s.DissociateFromTeamContext(context.Background(), runnerID, teamID)
+}
+
+// DissociateFromTeamContext Dissociate an Runner with a team
+func (s *AutomationActionsRunnerService) DissociateFromTeamContext(ctx context.Context, runnerID, teamID string) (*Response, error) {
 	u := fmt.Sprintf("%s/%s/teams/%s", automationActionsRunnerBaseUrl, runnerID, teamID)
 
-	return s.client.newRequestDoOptions("DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/automation_actions/runners/{id}/teams/{id}"))
 }
 
 // Gets the details of a Runner / team relation
 func (s *AutomationActionsRunnerService) GetAssociationToTeam(runnerID, teamID string) (*AutomationActionsRunnerTeamAssociationPayload, *Response, error) {
+	return s.GetAssociationToTeamContext(context.Background(), runnerID, teamID)
+}
+
+// GetAssociationToTeamContext Gets the details of a Runner / team relation
+func (s *AutomationActionsRunnerService) GetAssociationToTeamContext(ctx context.Context, runnerID, teamID string) (*AutomationActionsRunnerTeamAssociationPayload, *Response, error) {
 	u := fmt.Sprintf("%s/%s/teams/%s", automationActionsRunnerBaseUrl, runnerID, teamID)
 	v := new(AutomationActionsRunnerTeamAssociationPayload)
 
-	resp, err := s.client.newRequestDoOptions("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/automation_actions/runners/{id}/teams/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
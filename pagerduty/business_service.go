@@ -1,6 +1,9 @@
 package pagerduty
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // BusinessServiceService handles the communication with business service
 // related methods of the PagerDuty API.
@@ -42,6 +45,11 @@ type ListBusinessServicesResponse struct {
 
 // List lists existing business services.
 func (s *BusinessServiceService) List() (*ListBusinessServicesResponse, *Response, error) {
+	return s.ListContext(context.Background())
+}
+
+// ListContext lists existing business services.
+func (s *BusinessServiceService) ListContext(ctx context.Context) (*ListBusinessServicesResponse, *Response, error) {
 	u := "/business_services"
 	v := new(ListBusinessServicesResponse)
 
@@ -49,24 +57,24 @@ func (s *BusinessServiceService) List() (*ListBusinessServicesResponse, *Respons
 
 	// Create a handler closure capable of parsing data from the business_services endpoint
 	// and appending resultant response plays to the return slice.
-	responseHandler := func(response *Response) (ListResp, *Response, error) {
+	responseHandler := func(response *Response) (PaginationMeta, *Response, error) {
 		var result ListBusinessServicesResponse
 
 		if err := s.client.DecodeJSON(response, &result); err != nil {
-			return ListResp{}, response, err
+			return PaginationMeta{}, response, err
 		}
 
 		businessServices = append(businessServices, result.BusinessServices...)
 
 		// Return stats on the current page. Caller can use this information to
 		// adjust for requesting additional pages.
-		return ListResp{
+		return PaginationMeta{
 			More:   result.More,
 			Offset: result.Offset,
 			Limit:  result.Limit,
 		}, response, nil
 	}
-	err := s.client.newRequestPagedGetDo(u, responseHandler)
+	err := s.client.newRequestPagedGetDoContext(ctx, u, responseHandler, WithRoute("/business_services"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -77,11 +85,16 @@ func (s *BusinessServiceService) List() (*ListBusinessServicesResponse, *Respons
 
 // Create creates a new business service.
 func (s *BusinessServiceService) Create(bservice *BusinessService) (*BusinessService, *Response, error) {
+	return s.CreateContext(context.Background(), bservice)
+}
+
+// CreateContext creates a new business service.
+func (s *BusinessServiceService) CreateContext(ctx context.Context, bservice *BusinessService) (*BusinessService, *Response, error) {
 	u := "/business_services"
 	v := new(BusinessServicePayload)
 	p := &BusinessServicePayload{BusinessService: bservice}
 
-	resp, err := s.client.newRequestDo("POST", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, p, v, WithRoute("/business_services"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -91,11 +104,16 @@ func (s *BusinessServiceService) Create(bservice *BusinessService) (*BusinessSer
 
 // Get gets a business service.
 func (s *BusinessServiceService) Get(ID string) (*BusinessService, *Response, error) {
+	return s.GetContext(context.Background(), ID)
+}
+
+// GetContext gets a business service.
+func (s *BusinessServiceService) GetContext(ctx context.Context, ID string) (*BusinessService, *Response, error) {
 	u := fmt.Sprintf("/business_services/%s", ID)
 	v := new(BusinessServicePayload)
 	p := &BusinessServicePayload{}
 
-	resp, err := s.client.newRequestDo("GET", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, p, v, WithRoute("/business_services/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -105,17 +123,27 @@ func (s *BusinessServiceService) Get(ID string) (*BusinessService, *Response, er
 
 // Delete deletes a business service.
 func (s *BusinessServiceService) Delete(ID string) (*Response, error) {
+	return s.DeleteContext(context.Background(), ID)
+}
+
+// DeleteContext deletes a business service.
+func (s *BusinessServiceService) DeleteContext(ctx context.Context, ID string) (*Response, error) {
 	u := fmt.Sprintf("/business_services/%s", ID)
-	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/business_services/{id}"))
 }
 
 // Update updates a business service.
 func (s *BusinessServiceService) Update(ID string, bserv *BusinessService) (*BusinessService, *Response, error) {
+	return s.UpdateContext(context.Background(), ID, bserv)
+}
+
+// UpdateContext updates a business service.
+func (s *BusinessServiceService) UpdateContext(ctx context.Context, ID string, bserv *BusinessService) (*BusinessService, *Response, error) {
 	u := fmt.Sprintf("/business_services/%s", ID)
 	v := new(BusinessServicePayload)
 	p := BusinessServicePayload{BusinessService: bserv}
 
-	resp, err := s.client.newRequestDo("PUT", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, p, v, WithRoute("/business_services/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"context"
 	"errors"
 	"fmt"
 )
@@ -39,6 +40,11 @@ type ListBusinessServiceSubscribersResponse struct {
 
 // List lists existing business service subscribers.
 func (s *BusinessServiceSubscriberService) List(businessServiceID string) (*ListBusinessServiceSubscribersResponse, *Response, error) {
+	return s.ListContext(context.Background(), businessServiceID)
+}
+
+// ListContext lists existing business service subscribers.
+func (s *BusinessServiceSubscriberService) ListContext(ctx context.Context, businessServiceID string) (*ListBusinessServiceSubscribersResponse, *Response, error) {
 	u := fmt.Sprintf("/business_services/%s/subscribers", businessServiceID)
 	v := new(ListBusinessServiceSubscribersResponse)
 
@@ -46,24 +52,24 @@ func (s *BusinessServiceSubscriberService) List(businessServiceID string) (*List
 
 	// Create a handler closure capable of parsing data from the subscribers endpoint
 	// and appending resultant response plays to the return slice.
-	responseHandler := func(response *Response) (ListResp, *Response, error) {
+	responseHandler := func(response *Response) (PaginationMeta, *Response, error) {
 		var result ListBusinessServiceSubscribersResponse
 
 		if err := s.client.DecodeJSON(response, &result); err != nil {
-			return ListResp{}, response, err
+			return PaginationMeta{}, response, err
 		}
 
 		businessServiceSubscribers = append(businessServiceSubscribers, result.BusinessServiceSubscribers...)
 
 		// Return stats on the current page. Caller can use this information to
 		// adjust for requesting additional pages.
-		return ListResp{
+		return PaginationMeta{
 			More:   result.More,
 			Offset: result.Offset,
 			Limit:  result.Limit,
 		}, response, nil
 	}
-	err := s.client.newRequestPagedGetDo(u, responseHandler)
+	err := s.client.newRequestPagedGetDoContext(ctx, u, responseHandler, WithRoute("/business_services/{id}/subscribers"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -74,13 +80,18 @@ func (s *BusinessServiceSubscriberService) List(businessServiceID string) (*List
 
 // Create creates a new business service subscriber.
 func (s *BusinessServiceSubscriberService) Create(businessServiceID string, subscriber *BusinessServiceSubscriber) (*Response, error) {
+	return s.CreateContext(context.Background(), businessServiceID, subscriber)
+}
+
+// CreateContext creates a new business service subscriber.
+func (s *BusinessServiceSubscriberService) CreateContext(ctx context.Context, businessServiceID string, subscriber *BusinessServiceSubscriber) (*Response, error) {
 	u := fmt.Sprintf("/business_services/%s/subscribers", businessServiceID)
 	v := new(BusinessServiceSubscriberPayload)
 	subscriberArr := make([]*BusinessServiceSubscriber, 0)
 	subscriberArr = append(subscriberArr, subscriber)
 	p := &BusinessServiceSubscriberPayload{BusinessServiceSubscriber: subscriberArr}
 
-	resp, err := s.client.newRequestDo("POST", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, p, v, WithRoute("/business_services/{id}/subscribers"))
 	if err != nil {
 		return nil, err
 	}
@@ -109,13 +120,18 @@ func (s *BusinessServiceSubscriberService) Create(businessServiceID string, subs
 
 // Delete deletes a business service subscriber.
 func (s *BusinessServiceSubscriberService) Delete(businessServiceID string, subscriber *BusinessServiceSubscriber) (*Response, error) {
+	return s.DeleteContext(context.Background(), businessServiceID, subscriber)
+}
+
+// DeleteContext deletes a business service subscriber.
+func (s *BusinessServiceSubscriberService) DeleteContext(ctx context.Context, businessServiceID string, subscriber *BusinessServiceSubscriber) (*Response, error) {
 	u := fmt.Sprintf("/business_services/%s/unsubscribe", businessServiceID)
 	v := new(BusinessServiceSubscriberPayload)
 	subscriberArr := make([]*BusinessServiceSubscriber, 0)
 	subscriberArr = append(subscriberArr, subscriber)
 	p := &BusinessServiceSubscriberPayload{BusinessServiceSubscriber: subscriberArr}
 
-	resp, err := s.client.newRequestDo("POST", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, p, v, WithRoute("/business_services/{id}/unsubscribe"))
 	if err != nil {
 		return nil, err
 	}
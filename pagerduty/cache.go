@@ -118,8 +118,8 @@ func PopulateMemoryCache() {
 	cachePut("misc", "abilities", abilitiesRecord)
 
 	var pdo = ListUsersOptions{
-		Include: []string{"contact_methods", "notification_rules"},
-		Limit:   100,
+		ListOptions: ListOptions{Limit: 100},
+		Include:     []string{"contact_methods", "notification_rules"},
 	}
 
 	fullUsers, err := pdClient.Users.ListAll(&pdo)
@@ -234,8 +234,8 @@ func refreshMongoUsersCache(lastRefreshed *time.Time) error {
 	}
 
 	var pdo = ListUsersOptions{
-		Include: []string{"contact_methods", "notification_rules"},
-		Limit:   100,
+		ListOptions: ListOptions{Limit: 100},
+		Include:     []string{"contact_methods", "notification_rules"},
 	}
 
 	fullUsers, err := pdClient.Users.ListAll(&pdo)
@@ -0,0 +1,134 @@
+package pagerduty
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ClientOption configures a client built with NewClientWithOptions.
+type ClientOption func(*Config) error
+
+// NewClientWithOptions returns a new PagerDuty API client configured by
+// opts, which are applied in order. A later option overrides an earlier
+// one setting the same field.
+//
+// Exactly one authentication option must be given: WithToken,
+// WithOAuthToken and WithTokenSource conflict with each other and result
+// in an error.
+func NewClientWithOptions(opts ...ClientOption) (*Client, error) {
+	config := &Config{}
+	for _, opt := range opts {
+		if err := opt(config); err != nil {
+			return nil, err
+		}
+	}
+
+	return NewClient(config)
+}
+
+// WithToken authenticates with a REST API key.
+func WithToken(token string) ClientOption {
+	return func(c *Config) error {
+		if token == "" {
+			return fmt.Errorf("WithToken: token must not be empty")
+		}
+		c.Token = token
+		return nil
+	}
+}
+
+// WithOAuthToken authenticates with an OAuth access token.
+func WithOAuthToken(token string) ClientOption {
+	return func(c *Config) error {
+		if token == "" {
+			return fmt.Errorf("WithOAuthToken: token must not be empty")
+		}
+		c.OAuthToken = token
+		return nil
+	}
+}
+
+// WithTokenSource authenticates with OAuth access tokens obtained from ts.
+func WithTokenSource(ts TokenSource) ClientOption {
+	return func(c *Config) error {
+		if ts == nil {
+			return fmt.Errorf("WithTokenSource: token source must not be nil")
+		}
+		c.TokenSource = ts
+		return nil
+	}
+}
+
+// WithBaseURL sets the base URL of the PagerDuty API, e.g. for the EU
+// service region or a test server.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Config) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return fmt.Errorf("WithBaseURL: %w", err)
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("WithBaseURL: %q is not an absolute URL", baseURL)
+		}
+		c.BaseURL = baseURL
+		return nil
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to send requests.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Config) error {
+		if httpClient == nil {
+			return fmt.Errorf("WithHTTPClient: client must not be nil")
+		}
+		c.HTTPClient = httpClient
+		return nil
+	}
+}
+
+// WithUserAgent identifies the caller in the User-Agent header sent with
+// every request, after the default that identifies this library.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Config) error {
+		if userAgent == "" {
+			return fmt.Errorf("WithUserAgent: user agent must not be empty")
+		}
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithRetries sets the maximum number of retries of a failed request, see
+// Config.MaxRetries. Zero disables retries.
+func WithRetries(maxRetries int) ClientOption {
+	return func(c *Config) error {
+		if maxRetries < 0 {
+			return fmt.Errorf("WithRetries: max retries must not be negative, got %d", maxRetries)
+		}
+		c.MaxRetries = maxRetries
+		if maxRetries == 0 {
+			// Config treats zero as "use the default".
+			c.MaxRetries = -1
+		}
+		return nil
+	}
+}
+
+// WithLogger sets the logger that receives request, response and retry
+// details, see Config.Logger.
+func WithLogger(logger Logger) ClientOption {
+	return func(c *Config) error {
+		c.Logger = logger
+		return nil
+	}
+}
+
+// WithDefaultFromEmail sets the From header sent with mutating requests, see
+// Config.DefaultFromEmail.
+func WithDefaultFromEmail(email string) ClientOption {
+	return func(c *Config) error {
+		c.DefaultFromEmail = email
+		return nil
+	}
+}
@@ -0,0 +1,91 @@
+package pagerduty
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewClientWithOptions(t *testing.T) {
+	httpClient := &http.Client{}
+
+	c, err := NewClientWithOptions(
+		WithToken("foo"),
+		WithBaseURL("https://api.eu.pagerduty.com"),
+		WithHTTPClient(httpClient),
+		WithUserAgent("my-agent"),
+		WithRetries(2),
+		WithDefaultFromEmail("user@example.com"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Config.Token != "foo" {
+		t.Errorf("Token = %q, want %q", c.Config.Token, "foo")
+	}
+	if c.baseURL.String() != "https://api.eu.pagerduty.com" {
+		t.Errorf("baseURL = %q, want %q", c.baseURL, "https://api.eu.pagerduty.com")
+	}
+	if c.client != httpClient {
+		t.Error("HTTP client was not set")
+	}
+	if c.Config.UserAgent != "my-agent" {
+		t.Errorf("UserAgent = %q, want %q", c.Config.UserAgent, "my-agent")
+	}
+	if c.Config.MaxRetries != 2 {
+		t.Errorf("MaxRetries = %d, want 2", c.Config.MaxRetries)
+	}
+	if c.Config.DefaultFromEmail != "user@example.com" {
+		t.Errorf("DefaultFromEmail = %q, want %q", c.Config.DefaultFromEmail, "user@example.com")
+	}
+}
+
+func TestNewClientWithOptionsDefaults(t *testing.T) {
+	c, err := NewClientWithOptions(WithOAuthToken("foo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.baseURL.String() != defaultBaseURL {
+		t.Errorf("baseURL = %q, want %q", c.baseURL, defaultBaseURL)
+	}
+	if c.Config.MaxRetries != defaultMaxRetries {
+		t.Errorf("MaxRetries = %d, want %d", c.Config.MaxRetries, defaultMaxRetries)
+	}
+}
+
+func TestNewClientWithOptionsRetriesDisabled(t *testing.T) {
+	c, err := NewClientWithOptions(WithToken("foo"), WithRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Config.MaxRetries >= 0 {
+		t.Errorf("MaxRetries = %d, want retries disabled", c.Config.MaxRetries)
+	}
+}
+
+func TestNewClientWithOptionsErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		opts []ClientOption
+	}{
+		{"no auth", nil},
+		{"empty token", []ClientOption{WithToken("")}},
+		{"conflicting auth", []ClientOption{WithToken("foo"), WithOAuthToken("bar")}},
+		{"nil token source", []ClientOption{WithTokenSource(nil)}},
+		{"unparsable base url", []ClientOption{WithToken("foo"), WithBaseURL("://bad")}},
+		{"relative base url", []ClientOption{WithToken("foo"), WithBaseURL("/api")}},
+		{"nil http client", []ClientOption{WithToken("foo"), WithHTTPClient(nil)}},
+		{"empty user agent", []ClientOption{WithToken("foo"), WithUserAgent("")}},
+		{"negative retries", []ClientOption{WithToken("foo"), WithRetries(-1)}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := NewClientWithOptions(tc.opts...); err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+		})
+	}
+}
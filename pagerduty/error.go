@@ -1,18 +1,43 @@
 package pagerduty
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
 )
 
 var (
-	// ErrNoToken is returned by NewClient if a user
-	// passed an empty/missing token.
+	// ErrNoToken is returned, wrapped, by NewClient if a user
+	// passed an empty/missing token and no other credentials.
 	ErrNoToken = errors.New("an empty token was provided")
 
 	// ErrAuthFailure is returned by NewClient if a user
 	// passed an invalid token and failed validation against the PagerDuty API.
 	ErrAuthFailure = errors.New("failed to authenticate using the provided token")
+
+	// ErrInvalidToken is matched by the *AuthError returned by ValidateAuth
+	// when the credentials were rejected as invalid (HTTP 401).
+	ErrInvalidToken = errors.New("the provided token is invalid")
+
+	// ErrInsufficientScope is matched by the *AuthError returned by
+	// ValidateAuth when the credentials lack a required scope or permission
+	// (HTTP 403).
+	ErrInsufficientScope = errors.New("the provided token lacks a required scope")
+
+	// ErrNotFound is matched by the errors of lookups that found no match,
+	// such as UserService.FindByEmail. IsNotFound reports true for them.
+	ErrNotFound = errors.New("not found")
+
+	// ErrResponseTooLarge is matched by the error returned when a response
+	// body exceeds Config.MaxResponseBytes.
+	ErrResponseTooLarge = errors.New("response body too large")
 )
 
 type errorResponse struct {
@@ -27,9 +52,554 @@ type Error struct {
 	Message        string      `json:"message,omitempty"`
 	RequiredScopes string      `json:"required_scopes,omitempty"`
 	TokenScopes    string      `json:"token_scopes,omitempty"`
-	needToRetry    bool
 }
 
 func (e *Error) Error() string {
 	return fmt.Sprintf("%s API call to %s failed %v. Code: %d, Errors: %v, Message: %s", e.ErrorResponse.Response.Request.Method, e.ErrorResponse.Response.Request.URL.String(), e.ErrorResponse.Response.Status, e.Code, e.Errors, e.Message)
 }
+
+// APIError is the error returned for any non-2xx response from the PagerDuty
+// API. When the response body contains a PagerDuty error object, it is
+// available through errors.As as an *Error and its fields are copied over.
+type APIError struct {
+	StatusCode int
+	Method     string
+	URL        string
+	Code       int
+	Message    string
+	Errors     []string
+
+	// RawBody is the response body as received, which is the only source of
+	// information when it is not a PagerDuty error object.
+	RawBody []byte
+
+	// Attempts is the number of times the request was sent before giving up.
+	// It is only set when the request was retried.
+	Attempts int
+
+	// RequestID is the X-Request-Id header of the response, if any.
+	RequestID string
+
+	status      string
+	err         *Error
+	needToRetry bool
+	retryAfter  time.Duration
+}
+
+func newAPIError(res *Response, e *Error) *APIError {
+	apiErr := &APIError{
+		StatusCode: res.Response.StatusCode,
+		Method:     res.Response.Request.Method,
+		URL:        res.Response.Request.URL.String(),
+		RawBody:    res.BodyBytes,
+		RequestID:  res.RequestID,
+		status:     res.Response.Status,
+		err:        e,
+	}
+
+	if e != nil {
+		apiErr.Code = e.Code
+		apiErr.Message = e.Message
+		apiErr.Errors = flattenErrors(e.Errors)
+	}
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	var msg string
+	if e.err != nil {
+		msg = e.err.Error()
+	} else {
+		msg = fmt.Sprintf("%s API call to %s failed: %v, Body: %s", e.Method, e.URL, e.status, bodySnippet(e.RawBody))
+	}
+
+	if e.Attempts > 1 {
+		msg = fmt.Sprintf("%s, Attempts: %d", msg, e.Attempts)
+	}
+	if e.RequestID != "" {
+		msg = fmt.Sprintf("%s, Request ID: %s", msg, e.RequestID)
+	}
+	return msg
+}
+
+// Unwrap returns the PagerDuty error object decoded from the response body,
+// if any.
+func (e *APIError) Unwrap() error {
+	if e.err == nil {
+		return nil
+	}
+	return e.err
+}
+
+// AuthError is returned by ValidateAuth when the PagerDuty API rejected the
+// credentials. Reason is ErrInvalidToken or ErrInsufficientScope, Err is the
+// underlying API error. It also matches ErrAuthFailure.
+type AuthError struct {
+	Reason error
+	Err    error
+}
+
+func (e *AuthError) Error() string {
+	return fmt.Sprintf("%v: %v", e.Reason, e.Err)
+}
+
+// Is reports whether target is the reason of the error or ErrAuthFailure.
+func (e *AuthError) Is(target error) bool {
+	return target == e.Reason || target == ErrAuthFailure
+}
+
+// Unwrap returns the underlying API error.
+func (e *AuthError) Unwrap() error {
+	return e.Err
+}
+
+// AmbiguousMatchError is returned by lookups that expect a single match,
+// such as UserService.FindByEmail, when several resources match. IDs lists
+// the IDs of all of them.
+type AmbiguousMatchError struct {
+	Value string
+	IDs   []string
+}
+
+func (e *AmbiguousMatchError) Error() string {
+	return fmt.Sprintf("%q matches %d resources: %s", e.Value, len(e.IDs), strings.Join(e.IDs, ", "))
+}
+
+// NoCurrentUserError is returned by UserService.GetCurrent when the client
+// uses an account level API key, which does not belong to a user. Err is the
+// underlying API error.
+type NoCurrentUserError struct {
+	Err error
+}
+
+// noCurrentUserErrorCode is the code of the API error returned when the
+// current user of an account level API key is requested.
+const noCurrentUserErrorCode = 2500
+
+func (e *NoCurrentUserError) Error() string {
+	return fmt.Sprintf("the credentials do not belong to a user: %v", e.Err)
+}
+
+// Unwrap returns the underlying API error.
+func (e *NoCurrentUserError) Unwrap() error {
+	return e.Err
+}
+
+// UserDeletionError is returned by UserService.Delete when the user is
+// still referenced by schedules, escalation policies or open incidents. The
+// references are taken from the conflicts of the error response, they are
+// empty if the API did not list them. Err is the underlying API error.
+type UserDeletionError struct {
+	UserID             string
+	Schedules          []*ScheduleReference
+	EscalationPolicies []*EscalationPolicyReference
+	Incidents          []*IncidentReference
+	Err                error
+}
+
+func (e *UserDeletionError) Error() string {
+	return fmt.Sprintf("user %s is referenced by %d schedules, %d escalation policies and %d incidents: %v",
+		e.UserID, len(e.Schedules), len(e.EscalationPolicies), len(e.Incidents), e.Err)
+}
+
+// Unwrap returns the underlying API error.
+func (e *UserDeletionError) Unwrap() error {
+	return e.Err
+}
+
+// deletionConflictsResponse represents the body of the error returned when
+// deleting a resource still referenced by others.
+type deletionConflictsResponse struct {
+	Error struct {
+		Conflicts []*resourceReference `json:"conflicts"`
+	} `json:"error"`
+}
+
+// deletionConflicts returns the references listed in the conflicts of the
+// body of apiErr, if any.
+func deletionConflicts(apiErr *APIError) []*resourceReference {
+	var r deletionConflictsResponse
+	if json.Unmarshal(apiErr.RawBody, &r) != nil {
+		return nil
+	}
+
+	conflicts := make([]*resourceReference, 0, len(r.Error.Conflicts))
+	for _, c := range r.Error.Conflicts {
+		if c != nil {
+			conflicts = append(conflicts, c)
+		}
+	}
+	return conflicts
+}
+
+// newUserDeletionError builds a *UserDeletionError from the conflicts listed
+// in the body of apiErr, ignoring references of unknown types.
+func newUserDeletionError(userID string, apiErr *APIError) *UserDeletionError {
+	e := &UserDeletionError{UserID: userID, Err: apiErr}
+	for _, c := range deletionConflicts(apiErr) {
+		switch c.Type {
+		case "schedule", "schedule_reference":
+			e.Schedules = append(e.Schedules, (*ScheduleReference)(c))
+		case "escalation_policy", "escalation_policy_reference":
+			e.EscalationPolicies = append(e.EscalationPolicies, (*EscalationPolicyReference)(c))
+		case "incident", "incident_reference":
+			e.Incidents = append(e.Incidents, (*IncidentReference)(c))
+		}
+	}
+	return e
+}
+
+// TeamDeletionError is returned by TeamService.Delete when the team still
+// has services, escalation policies or schedules. The references are taken
+// from the conflicts of the error response, they are empty if the API did
+// not list them. Err is the underlying API error.
+type TeamDeletionError struct {
+	TeamID             string
+	Services           []*ServiceReference
+	EscalationPolicies []*EscalationPolicyReference
+	Schedules          []*ScheduleReference
+	Err                error
+}
+
+func (e *TeamDeletionError) Error() string {
+	return fmt.Sprintf("team %s still has %d services, %d escalation policies and %d schedules: %v",
+		e.TeamID, len(e.Services), len(e.EscalationPolicies), len(e.Schedules), e.Err)
+}
+
+// Unwrap returns the underlying API error.
+func (e *TeamDeletionError) Unwrap() error {
+	return e.Err
+}
+
+// newTeamDeletionError builds a *TeamDeletionError from the conflicts listed
+// in the body of apiErr, ignoring references of unknown types.
+func newTeamDeletionError(teamID string, apiErr *APIError) *TeamDeletionError {
+	e := &TeamDeletionError{TeamID: teamID, Err: apiErr}
+	for _, c := range deletionConflicts(apiErr) {
+		switch c.Type {
+		case "service", "service_reference":
+			e.Services = append(e.Services, (*ServiceReference)(c))
+		case "escalation_policy", "escalation_policy_reference":
+			e.EscalationPolicies = append(e.EscalationPolicies, (*EscalationPolicyReference)(c))
+		case "schedule", "schedule_reference":
+			e.Schedules = append(e.Schedules, (*ScheduleReference)(c))
+		}
+	}
+	return e
+}
+
+// ScheduleDeletionError is returned by ScheduleService.Delete when the
+// schedule is still used by escalation policies, which have to stop
+// referencing it first. OpenIncidents is set for the variant of the error
+// where the escalation policies have open incidents, listed in Incidents
+// when the API did. The references are taken from the conflicts of the
+// error response, they are empty if the API did not list them. Err is the
+// underlying API error.
+type ScheduleDeletionError struct {
+	ScheduleID         string
+	EscalationPolicies []*EscalationPolicyReference
+	Incidents          []*IncidentReference
+	OpenIncidents      bool
+	Err                error
+}
+
+func (e *ScheduleDeletionError) Error() string {
+	if e.OpenIncidents {
+		return fmt.Sprintf("schedule %s is used by %d escalation policies with %d open incidents: %v",
+			e.ScheduleID, len(e.EscalationPolicies), len(e.Incidents), e.Err)
+	}
+	return fmt.Sprintf("schedule %s is used by %d escalation policies: %v", e.ScheduleID, len(e.EscalationPolicies), e.Err)
+}
+
+// Unwrap returns the underlying API error.
+func (e *ScheduleDeletionError) Unwrap() error {
+	return e.Err
+}
+
+// newScheduleDeletionError builds a *ScheduleDeletionError from the
+// conflicts listed in the body of apiErr, ignoring references of unknown
+// types.
+func newScheduleDeletionError(scheduleID string, apiErr *APIError) *ScheduleDeletionError {
+	e := &ScheduleDeletionError{ScheduleID: scheduleID, Err: apiErr}
+	for _, c := range deletionConflicts(apiErr) {
+		switch c.Type {
+		case "escalation_policy", "escalation_policy_reference":
+			e.EscalationPolicies = append(e.EscalationPolicies, (*EscalationPolicyReference)(c))
+		case "incident", "incident_reference":
+			e.Incidents = append(e.Incidents, (*IncidentReference)(c))
+		}
+	}
+
+	e.OpenIncidents = len(e.Incidents) > 0
+	for _, msg := range append([]string{apiErr.Message}, apiErr.Errors...) {
+		if strings.Contains(strings.ToLower(msg), "open incident") {
+			e.OpenIncidents = true
+		}
+	}
+	return e
+}
+
+// EscalationPolicyDeletionError is returned by EscalationPolicyService.Delete
+// when the escalation policy is still used by services, which have to be
+// moved to another escalation policy first. The references are taken from
+// the conflicts of the error response, they are empty if the API did not
+// list them. Err is the underlying API error.
+type EscalationPolicyDeletionError struct {
+	EscalationPolicyID string
+	Services           []*ServiceReference
+	Err                error
+}
+
+func (e *EscalationPolicyDeletionError) Error() string {
+	return fmt.Sprintf("escalation policy %s is used by %d services: %v", e.EscalationPolicyID, len(e.Services), e.Err)
+}
+
+// Unwrap returns the underlying API error.
+func (e *EscalationPolicyDeletionError) Unwrap() error {
+	return e.Err
+}
+
+// newEscalationPolicyDeletionError builds an *EscalationPolicyDeletionError
+// from the conflicts listed in the body of apiErr, ignoring references of
+// unknown types.
+func newEscalationPolicyDeletionError(escalationPolicyID string, apiErr *APIError) *EscalationPolicyDeletionError {
+	e := &EscalationPolicyDeletionError{EscalationPolicyID: escalationPolicyID, Err: apiErr}
+	for _, c := range deletionConflicts(apiErr) {
+		switch c.Type {
+		case "service", "service_reference":
+			e.Services = append(e.Services, (*ServiceReference)(c))
+		}
+	}
+	return e
+}
+
+// DecodeError is returned when a response body cannot be decoded as JSON.
+// It keeps the body, which is often an HTML error page from a proxy, so the
+// error message can show what the server actually sent.
+type DecodeError struct {
+	StatusCode int
+	Method     string
+	URL        string
+	Body       []byte
+	Err        error
+}
+
+func newDecodeError(res *Response, err error) *DecodeError {
+	decodeErr := &DecodeError{Body: res.BodyBytes, Err: err}
+	if res.Response != nil {
+		decodeErr.StatusCode = res.Response.StatusCode
+		if res.Response.Request != nil {
+			decodeErr.Method = res.Response.Request.Method
+			decodeErr.URL = res.Response.Request.URL.String()
+		}
+	}
+	return decodeErr
+}
+
+func (e *DecodeError) Error() string {
+	if e.Method == "" {
+		return fmt.Sprintf("failed to decode response body: %v, Body: %s", e.Err, bodySnippet(e.Body))
+	}
+	return fmt.Sprintf("failed to decode response body of %s API call to %s (status %d): %v, Body: %s", e.Method, e.URL, e.StatusCode, e.Err, bodySnippet(e.Body))
+}
+
+// Unwrap returns the underlying JSON error.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// bodySnippet returns a quoted excerpt of a response body for use in error
+// messages, truncated to maxErrorBodySnippet bytes.
+func bodySnippet(body []byte) string {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return "<empty>"
+	}
+	if len(body) > maxErrorBodySnippet {
+		return fmt.Sprintf("%q... (truncated, %d bytes total)", body[:maxErrorBodySnippet], len(body))
+	}
+	return fmt.Sprintf("%q", body)
+}
+
+// flattenErrors converts the errors field of a PagerDuty error object, which
+// is either a list of messages or a map of field names to messages, into a
+// list of messages.
+func flattenErrors(v interface{}) []string {
+	var out []string
+
+	switch errs := v.(type) {
+	case []interface{}:
+		for _, e := range errs {
+			out = append(out, fmt.Sprintf("%v", e))
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(errs))
+		for k := range errs {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			for _, msg := range flattenErrors(errs[k]) {
+				out = append(out, fmt.Sprintf("%s %s", k, msg))
+			}
+		}
+	case string:
+		out = append(out, errs)
+	case nil:
+	default:
+		out = append(out, fmt.Sprintf("%v", errs))
+	}
+
+	return out
+}
+
+// hasErrorMessage reports whether err is an API error whose errors field is
+// exactly the given list of messages.
+func hasErrorMessage(err error, messages ...string) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return strings.Join(apiErr.Errors, "\x00") == strings.Join(messages, "\x00")
+}
+
+// IsNotFound reports whether err is an API error caused by a resource that
+// does not exist (HTTP 404), or matches ErrNotFound.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound) || hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsUnauthorized reports whether err is an API error caused by missing or
+// invalid credentials (HTTP 401).
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
+
+// IsPaymentRequired reports whether err is an API error caused by a feature
+// that the plan of the account does not include (HTTP 402).
+func IsPaymentRequired(err error) bool {
+	return hasStatusCode(err, http.StatusPaymentRequired)
+}
+
+// IsRateLimited reports whether err is an API error caused by the request
+// being rate limited (HTTP 429), which happens once retries are exhausted.
+func IsRateLimited(err error) bool {
+	return hasStatusCode(err, http.StatusTooManyRequests)
+}
+
+// IsTimeout reports whether err is caused by a request exceeding its
+// deadline, set by WithTimeout, the context or Config.HTTPClient.Timeout.
+func IsTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+// IsConflict reports whether err is an API error caused by a change that
+// conflicts with the current state of a resource (HTTP 409), or a
+// *ConflictError.
+func IsConflict(err error) bool {
+	var conflictErr *ConflictError
+	return errors.As(err, &conflictErr) || hasStatusCode(err, http.StatusConflict)
+}
+
+// ConflictError is returned when a change conflicts with the current state
+// of the resource with the given ID, e.g. acknowledging an incident that is
+// already resolved. Err is the underlying API error.
+type ConflictError struct {
+	ID  string
+	Err error
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("conflict updating %s: %v", e.ID, e.Err)
+}
+
+// Unwrap returns the underlying API error.
+func (e *ConflictError) Unwrap() error {
+	return e.Err
+}
+
+// PaginationLimitError is returned when listing all pages of an offset
+// paginated endpoint would require going past the maximum offset supported by
+// the API. Narrow down the results using the list options in that case.
+type PaginationLimitError struct {
+	Offset int
+	Limit  int
+}
+
+func (e *PaginationLimitError) Error() string {
+	return fmt.Sprintf("pagination limit of %d records exceeded requesting offset %d with limit %d", maxPaginationOffset, e.Offset, e.Limit)
+}
+
+// BulkError is returned by bulk operations, which are split into several
+// requests, when some of the requests failed. Failed maps the ID of every
+// item that was not updated to the error of its request.
+type BulkError struct {
+	Failed map[string]error
+}
+
+func (e *BulkError) Error() string {
+	ids := e.FailedIDs()
+	if len(ids) == 0 {
+		return "bulk operation failed"
+	}
+	return fmt.Sprintf("bulk operation failed for %d items (%s): %v", len(ids), strings.Join(ids, ", "), e.Failed[ids[0]])
+}
+
+// FailedIDs returns the sorted IDs of the items that were not updated.
+func (e *BulkError) FailedIDs() []string {
+	ids := make([]string, 0, len(e.Failed))
+	for id := range e.Failed {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Unwrap returns the error of the first failed item, so that e.g.
+// IsRateLimited reports on it.
+func (e *BulkError) Unwrap() error {
+	ids := e.FailedIDs()
+	if len(ids) == 0 {
+		return nil
+	}
+	return e.Failed[ids[0]]
+}
+
+// updateInChunks calls update with consecutive chunks of at most size items.
+// It returns a *BulkError with the ID of every item of the chunks that failed
+// to update, or nil if all of them succeeded.
+func updateInChunks[T any](items []T, size int, id func(T) string, update func([]T) error) error {
+	var bulkErr *BulkError
+	for start := 0; start < len(items); start += size {
+		end := start + size
+		if end > len(items) {
+			end = len(items)
+		}
+		chunk := items[start:end]
+
+		if err := update(chunk); err != nil {
+			if bulkErr == nil {
+				bulkErr = &BulkError{Failed: make(map[string]error)}
+			}
+			for _, item := range chunk {
+				bulkErr.Failed[id(item)] = err
+			}
+		}
+	}
+
+	if bulkErr != nil {
+		return bulkErr
+	}
+	return nil
+}
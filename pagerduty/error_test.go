@@ -2,9 +2,12 @@ package pagerduty
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -66,3 +69,327 @@ func TestErrorResponses(t *testing.T) {
 		})
 	}
 }
+
+func TestAPIError(t *testing.T) {
+	testCases := []struct {
+		name       string
+		status     int
+		body       string
+		want       *APIError
+		wantPDErr  bool
+		wantString string
+	}{
+		{
+			name:   "error with multiple errors",
+			status: http.StatusBadRequest,
+			body:   `{"error": {"errors": ["Invalid reference", "bar"], "code": 2001, "message": "Invalid Input Provided"}}`,
+			want: &APIError{
+				StatusCode: http.StatusBadRequest,
+				Method:     "GET",
+				Code:       2001,
+				Message:    "Invalid Input Provided",
+				Errors:     []string{"Invalid reference", "bar"},
+			},
+			wantPDErr: true,
+		},
+		{
+			name:   "error with map errors",
+			status: http.StatusBadRequest,
+			body:   `{"error": {"message": "Invalid Schedule", "code": 3001, "errors": {"name": ["is too long"], "layer": ["is invalid"]}}}`,
+			want: &APIError{
+				StatusCode: http.StatusBadRequest,
+				Method:     "GET",
+				Code:       3001,
+				Message:    "Invalid Schedule",
+				Errors:     []string{"layer is invalid", "name is too long"},
+			},
+			wantPDErr: true,
+		},
+		{
+			name:   "html body",
+			status: http.StatusBadGateway,
+			body:   `<html><body>Bad Gateway</body></html>`,
+			want: &APIError{
+				StatusCode: http.StatusBadGateway,
+				Method:     "GET",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			mux.HandleFunc("/teams/1", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				w.Write([]byte(tc.body))
+			})
+
+			_, _, err := client.Teams.Get("1")
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("got %#v; want *APIError", err)
+			}
+
+			if apiErr.StatusCode != tc.want.StatusCode || apiErr.Method != tc.want.Method || apiErr.Code != tc.want.Code || apiErr.Message != tc.want.Message {
+				t.Errorf("got %#v; want %#v", apiErr, tc.want)
+			}
+			if !reflect.DeepEqual(apiErr.Errors, tc.want.Errors) {
+				t.Errorf("got errors %#v; want %#v", apiErr.Errors, tc.want.Errors)
+			}
+			if want := server.URL + "/teams/1"; apiErr.URL != want {
+				t.Errorf("got URL %q; want %q", apiErr.URL, want)
+			}
+			if string(apiErr.RawBody) != tc.body {
+				t.Errorf("got raw body %q; want %q", apiErr.RawBody, tc.body)
+			}
+
+			var pdErr *Error
+			if got := errors.As(err, &pdErr); got != tc.wantPDErr {
+				t.Errorf("errors.As(err, *Error) = %v; want %v", got, tc.wantPDErr)
+			}
+		})
+	}
+}
+
+func TestErrorHelpers(t *testing.T) {
+	testCases := []struct {
+		name         string
+		status       int
+		body         string
+		notFound     bool
+		unauthorized bool
+		rateLimited  bool
+	}{
+		{
+			name:     "not found with body",
+			status:   http.StatusNotFound,
+			body:     `{"error": {"message": "Not Found", "code": 2100}}`,
+			notFound: true,
+		},
+		{
+			name:     "not found without body",
+			status:   http.StatusNotFound,
+			notFound: true,
+		},
+		{
+			name:         "unauthorized",
+			status:       http.StatusUnauthorized,
+			body:         `{"error": {"message": "Unauthorized", "code": 2006}}`,
+			unauthorized: true,
+		},
+		{
+			name:        "rate limited",
+			status:      http.StatusTooManyRequests,
+			rateLimited: true,
+		},
+		{
+			name:   "bad request",
+			status: http.StatusBadRequest,
+			body:   `{"error": {"message": "Invalid Input Provided", "code": 2001}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			client.Config.MaxRetries = -1
+
+			mux.HandleFunc("/users/1", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				w.Write([]byte(tc.body))
+			})
+
+			_, _, err := client.Users.Get("1", &GetUserOptions{})
+			if err == nil {
+				t.Fatal("expected error; got nil")
+			}
+
+			if got := IsNotFound(err); got != tc.notFound {
+				t.Errorf("IsNotFound = %v; want %v", got, tc.notFound)
+			}
+			if got := IsUnauthorized(err); got != tc.unauthorized {
+				t.Errorf("IsUnauthorized = %v; want %v", got, tc.unauthorized)
+			}
+			if got := IsRateLimited(err); got != tc.rateLimited {
+				t.Errorf("IsRateLimited = %v; want %v", got, tc.rateLimited)
+			}
+		})
+	}
+}
+
+func TestIsNotFoundDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/schedules/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.Schedules.Delete("1")
+	if !IsNotFound(err) {
+		t.Errorf("IsNotFound(%v) = false; want true", err)
+	}
+
+	if IsNotFound(errors.New("404")) {
+		t.Error("IsNotFound should not match plain errors")
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		body     string
+		contains []string
+	}{
+		{
+			name:     "html body",
+			body:     "<html>\n<body>Service Unavailable</body>\n</html>",
+			contains: []string{"invalid character '<'", `"<html>\n<body>Service Unavailable</body>\n</html>"`},
+		},
+		{
+			name:     "truncated json",
+			body:     `{"team": {"id": "1", "na`,
+			contains: []string{"unexpected end of JSON input", `"{\"team\": {\"id\": \"1\", \"na"`},
+		},
+		{
+			name:     "long body",
+			body:     "<html>" + strings.Repeat("a", 1000) + "</html>",
+			contains: []string{"... (truncated, 1013 bytes total)"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			mux.HandleFunc("/teams/1", func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(tc.body))
+			})
+
+			_, _, err := client.Teams.Get("1")
+
+			var decodeErr *DecodeError
+			if !errors.As(err, &decodeErr) {
+				t.Fatalf("got %#v; want *DecodeError", err)
+			}
+			if decodeErr.StatusCode != http.StatusOK || decodeErr.Method != "GET" {
+				t.Errorf("got status %d and method %q; want 200 and GET", decodeErr.StatusCode, decodeErr.Method)
+			}
+			if string(decodeErr.Body) != tc.body {
+				t.Errorf("got body %q; want %q", decodeErr.Body, tc.body)
+			}
+			for _, want := range tc.contains {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not contain %q", err.Error(), want)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIErrorRawBodySnippet(t *testing.T) {
+	testCases := []struct {
+		name     string
+		body     string
+		contains string
+	}{
+		{"html body", "<html><body>Bad Gateway</body></html>", `Body: "<html><body>Bad Gateway</body></html>"`},
+		{"empty body", "", "Body: <empty>"},
+		{"truncated json", `{"error": {"code": 20`, `Body: "{\"error\": {\"code\": 20"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			mux.HandleFunc("/teams/1", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadGateway)
+				w.Write([]byte(tc.body))
+			})
+
+			_, _, err := client.Teams.Get("1")
+			if err == nil || !strings.Contains(err.Error(), tc.contains) {
+				t.Errorf("error %v does not contain %q", err, tc.contains)
+			}
+		})
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/teams/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-1")
+		w.Write([]byte(`{"team": {"id": "1"}}`))
+	})
+	mux.HandleFunc("/teams/2", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-2")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error": {"code": 2100, "message": "Not Found"}}`))
+	})
+	mux.HandleFunc("/teams/3", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error": {"code": 2100, "message": "Not Found"}}`))
+	})
+
+	_, resp, err := client.Teams.Get("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.RequestID != "req-1" {
+		t.Errorf("RequestID = %q, want %q", resp.RequestID, "req-1")
+	}
+
+	_, _, err = client.Teams.Get("2")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.RequestID != "req-2" {
+		t.Fatalf("expected an API error with request ID req-2, got %#v", err)
+	}
+	if !strings.Contains(err.Error(), "Request ID: req-2") {
+		t.Errorf("error %q does not contain the request ID", err)
+	}
+
+	_, _, err = client.Teams.Get("3")
+	if !errors.As(err, &apiErr) || apiErr.RequestID != "" {
+		t.Fatalf("expected an API error without request ID, got %#v", err)
+	}
+	if strings.Contains(err.Error(), "Request ID") {
+		t.Errorf("error %q mentions a request ID", err)
+	}
+}
+
+func TestRequestIDRetried(t *testing.T) {
+	setup()
+	defer teardown()
+
+	useFakeClock(client)
+
+	calls := 0
+	mux.HandleFunc("/teams/1", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("X-Request-Id", fmt.Sprintf("req-%d", calls))
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"error": {"code": 2020, "message": "Rate Limit Exceeded"}}`))
+	})
+
+	_, _, err := client.Teams.Get("1")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an API error, got %v", err)
+	}
+	if calls < 2 {
+		t.Fatalf("calls = %d, want the request to be retried", calls)
+	}
+	if want := fmt.Sprintf("req-%d", calls); apiErr.RequestID != want {
+		t.Errorf("RequestID = %q, want the ID of the final attempt %q", apiErr.RequestID, want)
+	}
+}
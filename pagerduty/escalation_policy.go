@@ -1,65 +1,189 @@
 package pagerduty
 
-import "fmt"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
 
 // EscalationPolicyService handles the communication with escalation policy
 // related methods of the PagerDuty API.
 type EscalationPolicyService service
 
 // EscalationRuleAssignmentStrategy represents an Escalation rule assignment
-// strategy
+// strategy. Type is one of the EscalationRuleAssignmentStrategy constants.
+// Only newer accounts support it, through the
+// EscalationRuleAssignmentStrategyEarlyAccess feature.
 type EscalationRuleAssignmentStrategy struct {
 	Type string `json:"type,omitempty"`
 }
 
-// EscalationRule represents an escalation rule.
+// Types of escalation rule assignment strategies: assign incidents to every
+// target of the rule, or to one target at a time in turn.
+const (
+	EscalationRuleAssignmentStrategyAssignToEveryone = "assign_to_everyone"
+	EscalationRuleAssignmentStrategyRoundRobin       = "round_robin"
+)
+
+// EscalationRuleAssignmentStrategyEarlyAccess is the early access feature
+// required to set and read the assignment strategy of escalation rules.
+// Create and Update opt into it when a rule sets a strategy; pass it to Get
+// with WithEarlyAccess to read the strategies.
+const EscalationRuleAssignmentStrategyEarlyAccess = "escalation-rule-assignment-strategy-early-access"
+
+// assignmentStrategyOptions returns the request options needed to send
+// escalation rules, in addition to reqOptions.
+func assignmentStrategyOptions(rules []*EscalationRule, reqOptions []RequestOptions) []RequestOptions {
+	for _, r := range rules {
+		if r != nil && r.EscalationRuleAssignmentStrategy != nil {
+			return append(reqOptions, WithEarlyAccess(EscalationRuleAssignmentStrategyEarlyAccess))
+		}
+	}
+	return reqOptions
+}
+
+// EscalationRule represents an escalation rule. When the targets are
+// returned as full objects, e.g. with the "targets" include,
+// ExpandedTargets holds them in the order of Targets. It is not sent back.
 type EscalationRule struct {
 	EscalationDelayInMinutes         int                               `json:"escalation_delay_in_minutes,omitempty"`
 	EscalationRuleAssignmentStrategy *EscalationRuleAssignmentStrategy `json:"escalation_rule_assignment_strategy,omitempty"`
 	ID                               string                            `json:"id,omitempty"`
 	Targets                          []*EscalationTargetReference      `json:"targets,omitempty"`
+	ExpandedTargets                  []*EscalationTarget               `json:"-"`
+}
+
+// EscalationTarget represents a target of an escalation rule as a full
+// object: User is set for user targets and Schedule for schedule targets.
+type EscalationTarget struct {
+	User     *User
+	Schedule *Schedule
+}
+
+// UnmarshalJSON decodes the targets of the rule both as references and, if
+// they are full objects, as ExpandedTargets.
+func (r *EscalationRule) UnmarshalJSON(data []byte) error {
+	type escalationRule EscalationRule
+	var v struct {
+		*escalationRule
+		Targets []json.RawMessage `json:"targets,omitempty"`
+	}
+	v.escalationRule = (*escalationRule)(r)
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	r.Targets, r.ExpandedTargets = nil, nil
+	expanded := false
+	for _, raw := range v.Targets {
+		ref := new(EscalationTargetReference)
+		if err := json.Unmarshal(raw, ref); err != nil {
+			return err
+		}
+		r.Targets = append(r.Targets, ref)
+
+		target := &EscalationTarget{}
+		switch ref.Type {
+		case "user":
+			target.User = new(User)
+			if err := json.Unmarshal(raw, target.User); err != nil {
+				return err
+			}
+		case "schedule":
+			target.Schedule = new(Schedule)
+			if err := json.Unmarshal(raw, target.Schedule); err != nil {
+				return err
+			}
+		}
+		expanded = expanded || !strings.HasSuffix(ref.Type, "_reference")
+		r.ExpandedTargets = append(r.ExpandedTargets, target)
+	}
+	if !expanded {
+		r.ExpandedTargets = nil
+	}
+	return nil
 }
 
-// EscalationPolicy represents an escalation policy.
+// EscalationPolicy represents an escalation policy. NumLoops is the number
+// of times the policy repeats after the last rule, from 0 to
+// MaxEscalationPolicyNumLoops; it is a pointer so that 0 is sent.
+// OnCallHandoffNotifications is one of the OnCallHandoffNotifications
+// constants.
 type EscalationPolicy struct {
-	Description     string              `json:"description,omitempty"`
-	EscalationRules []*EscalationRule   `json:"escalation_rules,omitempty"`
-	HTMLURL         string              `json:"html_url,omitempty"`
-	ID              string              `json:"id,omitempty"`
-	Name            string              `json:"name,omitempty"`
-	NumLoops        *int                `json:"num_loops,omitempty"`
-	RepeatEnabled   bool                `json:"repeat_enabled,omitempty"`
-	Self            string              `json:"self,omitempty"`
-	Services        []*ServiceReference `json:"services,omitempty"`
-	Summary         string              `json:"summary,omitempty"`
-	Teams           []*TeamReference    `json:"teams"`
-	Type            string              `json:"type,omitempty"`
+	Description                string              `json:"description,omitempty"`
+	EscalationRules            []*EscalationRule   `json:"escalation_rules,omitempty"`
+	HTMLURL                    string              `json:"html_url,omitempty"`
+	ID                         string              `json:"id,omitempty"`
+	Name                       string              `json:"name,omitempty"`
+	NumLoops                   *int                `json:"num_loops,omitempty"`
+	OnCallHandoffNotifications string              `json:"on_call_handoff_notifications,omitempty"`
+	RepeatEnabled              bool                `json:"repeat_enabled,omitempty"`
+	Self                       string              `json:"self,omitempty"`
+	Services                   []*ServiceReference `json:"services,omitempty"`
+	Summary                    string              `json:"summary,omitempty"`
+	Teams                      []*TeamReference    `json:"teams"`
+	Type                       string              `json:"type,omitempty"`
+}
+
+// Values of EscalationPolicy.OnCallHandoffNotifications: whether users are
+// notified of on-call handoffs only if the policy has services, or always.
+const (
+	OnCallHandoffNotificationsIfHasServices = "if_has_services"
+	OnCallHandoffNotificationsAlways        = "always"
+)
+
+// MaxEscalationPolicyNumLoops is the highest number of loops of an
+// escalation policy accepted by the API.
+const MaxEscalationPolicyNumLoops = 9
+
+// Validate checks that the number of loops and the on-call handoff
+// notifications of the policy are accepted by the API. Create and Update do
+// not call it; call it before them to catch mistakes without a round trip.
+func (p *EscalationPolicy) Validate() error {
+	if p.NumLoops != nil && (*p.NumLoops < 0 || *p.NumLoops > MaxEscalationPolicyNumLoops) {
+		return fmt.Errorf("escalation policy loops must be from 0 to %d, got %d", MaxEscalationPolicyNumLoops, *p.NumLoops)
+	}
+
+	switch p.OnCallHandoffNotifications {
+	case "", OnCallHandoffNotificationsIfHasServices, OnCallHandoffNotificationsAlways:
+	default:
+		return fmt.Errorf("unsupported on-call handoff notifications %q", p.OnCallHandoffNotifications)
+	}
+	return nil
 }
 
 // ListEscalationPoliciesResponse represents a list response of escalation policies.
 type ListEscalationPoliciesResponse struct {
-	Limit              int                 `json:"limit,omitempty"`
-	More               bool                `json:"more,omitempty"`
-	Offset             int                 `json:"offset,omitempty"`
-	Total              int                 `json:"total,omitempty"`
+	PaginationMeta
 	EscalationPolicies []*EscalationPolicy `json:"escalation_policies,omitempty"`
 }
 
 // ListEscalationRulesResponse represents a list response of escalation rules.
 type ListEscalationRulesResponse struct {
-	Limit           int               `json:"limit,omitempty"`
-	More            bool              `json:"more,omitempty"`
-	Offset          int               `json:"offset,omitempty"`
-	Total           int               `json:"total,omitempty"`
+	PaginationMeta
 	EscalationRules []*EscalationRule `json:"escalation_rules,omitempty"`
 }
 
-// ListEscalationPoliciesOptions represents options when listing escalation policies.
+// ListEscalationRulesOptions represents options when listing the rules of an
+// escalation policy.
+type ListEscalationRulesOptions struct {
+	Includes []string `url:"include,omitempty,brackets"`
+}
+
+// ListEscalationPoliciesOptions represents options when listing escalation
+// policies. Query matches policies whose name contains it and SortBy is
+// "name", "name:asc" or "name:desc". Includes takes "services", "teams" and
+// "targets"; with "targets", the rules hold their targets as full users and
+// schedules in EscalationRule.ExpandedTargets.
 type ListEscalationPoliciesOptions struct {
-	Limit    int      `url:"limit,omitempty"`
-	More     bool     `url:"more,omitempty"`
-	Offset   int      `url:"offset,omitempty"`
-	Total    int      `url:"total,omitempty"`
+	ListOptions
+
+	// Deprecated: More is not a request parameter and is ignored.
+	More bool `url:"-"`
+
 	Includes []string `url:"include,omitempty,brackets"`
 	Query    string   `url:"query,omitempty"`
 	SortBy   string   `url:"sort_by,omitempty"`
@@ -79,10 +203,15 @@ type GetEscalationPolicyOptions struct {
 
 // List lists existing escalation policies.
 func (s *EscalationPolicyService) List(o *ListEscalationPoliciesOptions) (*ListEscalationPoliciesResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists existing escalation policies.
+func (s *EscalationPolicyService) ListContext(ctx context.Context, o *ListEscalationPoliciesOptions) (*ListEscalationPoliciesResponse, *Response, error) {
 	u := "/escalation_policies"
 	v := new(ListEscalationPoliciesResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/escalation_policies"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -90,17 +219,52 @@ func (s *EscalationPolicyService) List(o *ListEscalationPoliciesOptions) (*ListE
 	return v, resp, nil
 }
 
+// ListAll lists existing escalation policies, going through every page of
+// results.
+func (s *EscalationPolicyService) ListAll(o *ListEscalationPoliciesOptions) ([]*EscalationPolicy, error) {
+	return s.ListAllContext(context.Background(), o)
+}
+
+// ListAllContext lists existing escalation policies, going through every
+// page of results.
+func (s *EscalationPolicyService) ListAllContext(ctx context.Context, o *ListEscalationPoliciesOptions) ([]*EscalationPolicy, error) {
+	escalationPolicies := make([]*EscalationPolicy, 0)
+	err := s.ListPagesContext(ctx, o, func(page []*EscalationPolicy) error {
+		escalationPolicies = append(escalationPolicies, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return escalationPolicies, nil
+}
+
 // EscalationPolicyPayload represents an escalation policy.
 type EscalationPolicyPayload struct {
 	EscalationPolicy *EscalationPolicy `json:"escalation_policy"`
 }
 
+// escalationRules returns the rules of an escalation policy, if any.
+func escalationRules(escalationPolicy *EscalationPolicy) []*EscalationRule {
+	if escalationPolicy == nil {
+		return nil
+	}
+	return escalationPolicy.EscalationRules
+}
+
 // Create creates a new escalation policy.
-func (s *EscalationPolicyService) Create(escalationPolicy *EscalationPolicy) (*EscalationPolicy, *Response, error) {
+func (s *EscalationPolicyService) Create(escalationPolicy *EscalationPolicy, reqOptions ...RequestOptions) (*EscalationPolicy, *Response, error) {
+	return s.CreateContext(context.Background(), escalationPolicy, reqOptions...)
+}
+
+// CreateContext creates a new escalation policy.
+func (s *EscalationPolicyService) CreateContext(ctx context.Context, escalationPolicy *EscalationPolicy, reqOptions ...RequestOptions) (*EscalationPolicy, *Response, error) {
 	u := "/escalation_policies"
 	v := new(EscalationPolicyPayload)
 
-	resp, err := s.client.newRequestDo("POST", u, nil, &EscalationPolicyPayload{EscalationPolicy: escalationPolicy}, v)
+	reqOptions = assignmentStrategyOptions(escalationRules(escalationPolicy), reqOptions)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &EscalationPolicyPayload{EscalationPolicy: escalationPolicy}, v, withRouteOptions("/escalation_policies", reqOptions)...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -108,18 +272,39 @@ func (s *EscalationPolicyService) Create(escalationPolicy *EscalationPolicy) (*E
 	return v.EscalationPolicy, resp, nil
 }
 
-// Delete deletes an existing escalation policy.
+// Delete deletes an existing escalation policy. An escalation policy still
+// used by services cannot be deleted, the error is then an
+// *EscalationPolicyDeletionError listing them.
 func (s *EscalationPolicyService) Delete(id string) (*Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext deletes an existing escalation policy. An escalation policy
+// still used by services cannot be deleted, the error is then an
+// *EscalationPolicyDeletionError listing them.
+func (s *EscalationPolicyService) DeleteContext(ctx context.Context, id string) (*Response, error) {
 	u := fmt.Sprintf("/escalation_policies/%s", id)
-	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/escalation_policies/{id}"))
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest {
+		err = newEscalationPolicyDeletionError(id, apiErr)
+	}
+
+	return resp, err
 }
 
 // Get retrieves information about an escalation policy.
-func (s *EscalationPolicyService) Get(id string, o *GetEscalationPolicyOptions) (*EscalationPolicy, *Response, error) {
+func (s *EscalationPolicyService) Get(id string, o *GetEscalationPolicyOptions, reqOptions ...RequestOptions) (*EscalationPolicy, *Response, error) {
+	return s.GetContext(context.Background(), id, o, reqOptions...)
+}
+
+// GetContext retrieves information about an escalation policy.
+func (s *EscalationPolicyService) GetContext(ctx context.Context, id string, o *GetEscalationPolicyOptions, reqOptions ...RequestOptions) (*EscalationPolicy, *Response, error) {
 	u := fmt.Sprintf("/escalation_policies/%s", id)
 	v := new(EscalationPolicyPayload)
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, v, withRouteOptions("/escalation_policies/{id}", reqOptions)...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -128,14 +313,194 @@ func (s *EscalationPolicyService) Get(id string, o *GetEscalationPolicyOptions)
 }
 
 // Update updates an existing escalation policy.
-func (s *EscalationPolicyService) Update(id string, escalationPolicy *EscalationPolicy) (*EscalationPolicy, *Response, error) {
+func (s *EscalationPolicyService) Update(id string, escalationPolicy *EscalationPolicy, reqOptions ...RequestOptions) (*EscalationPolicy, *Response, error) {
+	return s.UpdateContext(context.Background(), id, escalationPolicy, reqOptions...)
+}
+
+// UpdateContext updates an existing escalation policy.
+func (s *EscalationPolicyService) UpdateContext(ctx context.Context, id string, escalationPolicy *EscalationPolicy, reqOptions ...RequestOptions) (*EscalationPolicy, *Response, error) {
 	u := fmt.Sprintf("/escalation_policies/%s", id)
 	v := new(EscalationPolicyPayload)
 
-	resp, err := s.client.newRequestDo("PUT", u, nil, &EscalationPolicyPayload{EscalationPolicy: escalationPolicy}, v)
+	reqOptions = assignmentStrategyOptions(escalationRules(escalationPolicy), reqOptions)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &EscalationPolicyPayload{EscalationPolicy: escalationPolicy}, v, withRouteOptions("/escalation_policies/{id}", reqOptions)...)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	return v.EscalationPolicy, resp, nil
 }
+
+// ListAuditRecords lists a single page of the audit records of an
+// escalation policy, e.g. changes of the targets of its rules, with the
+// actors who made them. Since and Until are RFC 3339 timestamps. Use the
+// NextCursor of the response as the Cursor option to fetch the following
+// page.
+func (s *EscalationPolicyService) ListAuditRecords(escalationPolicyID string, o *ListAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	return s.ListAuditRecordsContext(context.Background(), escalationPolicyID, o)
+}
+
+// ListAuditRecordsContext lists a single page of the audit records of an
+// escalation policy, e.g. changes of the targets of its rules, with the
+// actors who made them. Since and Until are RFC 3339 timestamps. Use the
+// NextCursor of the response as the Cursor option to fetch the following
+// page.
+func (s *EscalationPolicyService) ListAuditRecordsContext(ctx context.Context, escalationPolicyID string, o *ListAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	return s.client.listAuditRecordsContext(ctx, fmt.Sprintf("/escalation_policies/%s/audit/records", escalationPolicyID), "/escalation_policies/{id}/audit/records", o)
+}
+
+// ListAllAuditRecords lists every audit record of an escalation policy
+// matching the options, following the cursor until the last page.
+func (s *EscalationPolicyService) ListAllAuditRecords(escalationPolicyID string, o *ListAuditRecordsOptions) ([]*AuditRecord, error) {
+	return s.ListAllAuditRecordsContext(context.Background(), escalationPolicyID, o)
+}
+
+// ListAllAuditRecordsContext lists every audit record of an escalation
+// policy matching the options, following the cursor until the last page.
+func (s *EscalationPolicyService) ListAllAuditRecordsContext(ctx context.Context, escalationPolicyID string, o *ListAuditRecordsOptions) ([]*AuditRecord, error) {
+	return s.client.listAllAuditRecordsContext(ctx, fmt.Sprintf("/escalation_policies/%s/audit/records", escalationPolicyID), "/escalation_policies/{id}/audit/records", o)
+}
+
+// EscalationRulePayload represents an escalation rule.
+type EscalationRulePayload struct {
+	EscalationRule *EscalationRule `json:"escalation_rule"`
+}
+
+// ListRules lists the rules of an escalation policy, in escalation order.
+func (s *EscalationPolicyService) ListRules(escalationPolicyID string, o *ListEscalationRulesOptions) (*ListEscalationRulesResponse, *Response, error) {
+	return s.ListRulesContext(context.Background(), escalationPolicyID, o)
+}
+
+// ListRulesContext lists the rules of an escalation policy, in escalation
+// order.
+func (s *EscalationPolicyService) ListRulesContext(ctx context.Context, escalationPolicyID string, o *ListEscalationRulesOptions) (*ListEscalationRulesResponse, *Response, error) {
+	u := fmt.Sprintf("/escalation_policies/%s/escalation_rules", escalationPolicyID)
+	v := new(ListEscalationRulesResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/escalation_policies/{id}/escalation_rules"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// GetRule retrieves a rule of an escalation policy.
+func (s *EscalationPolicyService) GetRule(escalationPolicyID, ruleID string, o *GetEscalationRuleOptions) (*EscalationRule, *Response, error) {
+	return s.GetRuleContext(context.Background(), escalationPolicyID, ruleID, o)
+}
+
+// GetRuleContext retrieves a rule of an escalation policy.
+func (s *EscalationPolicyService) GetRuleContext(ctx context.Context, escalationPolicyID, ruleID string, o *GetEscalationRuleOptions) (*EscalationRule, *Response, error) {
+	u := fmt.Sprintf("/escalation_policies/%s/escalation_rules/%s", escalationPolicyID, ruleID)
+	v := new(EscalationRulePayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/escalation_policies/{id}/escalation_rules/{id}"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.EscalationRule, resp, nil
+}
+
+// UpdateRule updates a single rule of an escalation policy, leaving the
+// other rules and the policy untouched, so that automations changing
+// different levels do not overwrite each other. The rule replaces the
+// existing one: fields left unset are not preserved by the API, so read the
+// rule with GetRule, change it and send it back whole.
+func (s *EscalationPolicyService) UpdateRule(escalationPolicyID, ruleID string, rule *EscalationRule, reqOptions ...RequestOptions) (*EscalationRule, *Response, error) {
+	return s.UpdateRuleContext(context.Background(), escalationPolicyID, ruleID, rule, reqOptions...)
+}
+
+// UpdateRuleContext updates a single rule of an escalation policy, leaving
+// the other rules and the policy untouched, so that automations changing
+// different levels do not overwrite each other. The rule replaces the
+// existing one: fields left unset are not preserved by the API, so read the
+// rule with GetRule, change it and send it back whole.
+func (s *EscalationPolicyService) UpdateRuleContext(ctx context.Context, escalationPolicyID, ruleID string, rule *EscalationRule, reqOptions ...RequestOptions) (*EscalationRule, *Response, error) {
+	u := fmt.Sprintf("/escalation_policies/%s/escalation_rules/%s", escalationPolicyID, ruleID)
+	v := new(EscalationRulePayload)
+
+	reqOptions = assignmentStrategyOptions([]*EscalationRule{rule}, reqOptions)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &EscalationRulePayload{EscalationRule: rule}, &v, withRouteOptions("/escalation_policies/{id}/escalation_rules/{id}", reqOptions)...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.EscalationRule, resp, nil
+}
+
+type listEscalationPoliciesOptionsGen struct {
+	options *ListEscalationPoliciesOptions
+}
+
+func (o *listEscalationPoliciesOptionsGen) currentOffset() int {
+	return o.options.Offset
+}
+
+func (o *listEscalationPoliciesOptionsGen) changeOffset(i int) {
+	o.options.Offset = i
+}
+
+func (o *listEscalationPoliciesOptionsGen) buildStruct() interface{} {
+	return o.options
+}
+
+// ListPages lists existing escalation policies, calling fn with every page of results until
+// there are no more pages or fn returns an error.
+func (s *EscalationPolicyService) ListPages(o *ListEscalationPoliciesOptions, fn func([]*EscalationPolicy) error) error {
+	return s.ListPagesContext(context.Background(), o, fn)
+}
+
+// ListPagesContext lists existing escalation policies, calling fn with every page of results until
+// there are no more pages or fn returns an error.
+func (s *EscalationPolicyService) ListPagesContext(ctx context.Context, o *ListEscalationPoliciesOptions, fn func([]*EscalationPolicy) error) error {
+	opts := ListEscalationPoliciesOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	responseHandler := func(response *Response) (PaginationMeta, *Response, error) {
+		var result ListEscalationPoliciesResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return PaginationMeta{}, response, err
+		}
+
+		if err := fn(result.EscalationPolicies); err != nil {
+			return PaginationMeta{}, response, err
+		}
+
+		return PaginationMeta{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+
+	return s.client.newRequestPagedGetQueryDoContext(ctx, "/escalation_policies", responseHandler, &listEscalationPoliciesOptionsGen{options: &opts}, WithRoute("/escalation_policies"))
+}
+
+// Iter returns an Iterator over existing escalation policies, which requests pages of
+// results as they are needed.
+func (s *EscalationPolicyService) Iter(o *ListEscalationPoliciesOptions) *Iterator[*EscalationPolicy] {
+	return s.IterContext(context.Background(), o)
+}
+
+// IterContext returns an Iterator over existing escalation policies, which requests pages
+// of results as they are needed.
+func (s *EscalationPolicyService) IterContext(ctx context.Context, o *ListEscalationPoliciesOptions) *Iterator[*EscalationPolicy] {
+	opts := ListEscalationPoliciesOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	return Iterate(opts.ListOptions, func(lo ListOptions) ([]*EscalationPolicy, PaginationMeta, *Response, error) {
+		opts.ListOptions = lo
+		v, resp, err := s.ListContext(ctx, &opts)
+		if err != nil {
+			return nil, PaginationMeta{}, resp, err
+		}
+		return v.EscalationPolicies, v.PaginationMeta, resp, nil
+	})
+}
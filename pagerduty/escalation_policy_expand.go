@@ -0,0 +1,141 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// maxExpandTargetsConcurrency is the number of users and schedules
+// GetExpanded fetches at the same time.
+const maxExpandTargetsConcurrency = 4
+
+// escalationTargetKey identifies a user or schedule target, whether it is a
+// reference or a full object.
+type escalationTargetKey struct {
+	Type string
+	ID   string
+}
+
+func newEscalationTargetKey(ref *EscalationTargetReference) (escalationTargetKey, bool) {
+	if ref == nil {
+		return escalationTargetKey{}, false
+	}
+	t := strings.TrimSuffix(ref.Type, "_reference")
+	if t != "user" && t != "schedule" {
+		return escalationTargetKey{}, false
+	}
+	return escalationTargetKey{Type: t, ID: ref.ID}, true
+}
+
+// GetExpanded retrieves an escalation policy and resolves the user and
+// schedule targets of its rules into full objects, set as the
+// ExpandedTargets of each rule in the order of its Targets. The rules keep
+// their order and escalation delays. A user or schedule targeted several
+// times is fetched once and shared; targets of other types are left nil.
+func (s *EscalationPolicyService) GetExpanded(id string) (*EscalationPolicy, error) {
+	return s.GetExpandedContext(context.Background(), id)
+}
+
+// GetExpandedContext retrieves an escalation policy and resolves the user
+// and schedule targets of its rules into full objects, set as the
+// ExpandedTargets of each rule in the order of its Targets. The rules keep
+// their order and escalation delays. A user or schedule targeted several
+// times is fetched once and shared; targets of other types are left nil.
+func (s *EscalationPolicyService) GetExpandedContext(ctx context.Context, id string) (*EscalationPolicy, error) {
+	policy, _, err := s.GetContext(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []escalationTargetKey
+	seen := make(map[escalationTargetKey]bool)
+	for _, r := range policy.EscalationRules {
+		for _, ref := range r.Targets {
+			if key, ok := newEscalationTargetKey(ref); ok && !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	targets, err := s.fetchEscalationTargets(ctx, keys)
+	if err != nil {
+		return nil, fmt.Errorf("expanding the targets of escalation policy %s: %w", id, err)
+	}
+
+	for _, r := range policy.EscalationRules {
+		r.ExpandedTargets = make([]*EscalationTarget, len(r.Targets))
+		for i, ref := range r.Targets {
+			key, ok := newEscalationTargetKey(ref)
+			if !ok {
+				continue
+			}
+			r.ExpandedTargets[i] = targets[key]
+		}
+	}
+
+	return policy, nil
+}
+
+// fetchEscalationTargets fetches the users and schedules of keys, at most
+// maxExpandTargetsConcurrency at a time. It stops at the first error.
+func (s *EscalationPolicyService) fetchEscalationTargets(ctx context.Context, keys []escalationTargetKey) (map[escalationTargetKey]*EscalationTarget, error) {
+	fetchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		targets  = make(map[escalationTargetKey]*EscalationTarget, len(keys))
+		sem      = make(chan struct{}, maxExpandTargetsConcurrency)
+	)
+
+	for _, key := range keys {
+		if fetchCtx.Err() != nil {
+			break
+		}
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func(key escalationTargetKey) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			target := &EscalationTarget{}
+			var err error
+			switch key.Type {
+			case "user":
+				target.User, _, err = s.client.Users.GetContext(fetchCtx, key.ID, nil)
+			case "schedule":
+				target.Schedule, _, err = s.client.Schedules.GetContext(fetchCtx, key.ID, nil)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("%s %s: %w", key.Type, key.ID, err)
+					cancel()
+				}
+				return
+			}
+			targets[key] = target
+		}(key)
+	}
+	wg.Wait()
+
+	// A failed request wins over the cancellation it caused. Otherwise a
+	// canceled ctx may have stopped the loop before every target was fetched.
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
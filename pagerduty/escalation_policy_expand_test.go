@@ -0,0 +1,174 @@
+package pagerduty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestEscalationPoliciesGetExpanded(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/escalation_policies/PANZZEQ", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"escalation_policy": {
+			"id": "PANZZEQ",
+			"escalation_rules": [
+				{"id": "R1", "escalation_delay_in_minutes": 30, "targets": [
+					{"id": "PI7DH85", "type": "schedule_reference"},
+					{"id": "PXPGF42", "type": "user_reference"}
+				]},
+				{"id": "R2", "escalation_delay_in_minutes": 15, "targets": [
+					{"id": "PXPGF42", "type": "user_reference"},
+					{"id": "PI7DH85", "type": "schedule_reference"},
+					{"id": "PAM4FGS", "type": "user_reference"}
+				]}
+			]
+		}}`))
+	})
+
+	var mu sync.Mutex
+	fetches := make(map[string]int)
+	handle := func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		mu.Lock()
+		fetches[r.URL.Path]++
+		mu.Unlock()
+
+		parts := strings.Split(r.URL.Path, "/")
+		id := parts[len(parts)-1]
+		if parts[1] == "users" {
+			fmt.Fprintf(w, `{"user": {"id": %q, "type": "user", "name": "user %s"}}`, id, id)
+		} else {
+			fmt.Fprintf(w, `{"schedule": {"id": %q, "type": "schedule", "name": "schedule %s"}}`, id, id)
+		}
+	}
+	mux.HandleFunc("/users/", handle)
+	mux.HandleFunc("/schedules/", handle)
+
+	resp, err := client.EscalationPolicies.GetExpanded("PANZZEQ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schedule := &EscalationTarget{Schedule: &Schedule{ID: "PI7DH85", Type: "schedule", Name: "schedule PI7DH85"}}
+	earline := &EscalationTarget{User: &User{ID: "PXPGF42", Type: "user", Name: "user PXPGF42"}}
+	kyler := &EscalationTarget{User: &User{ID: "PAM4FGS", Type: "user", Name: "user PAM4FGS"}}
+
+	if len(resp.EscalationRules) != 2 {
+		t.Fatalf("returned %d rules, want 2", len(resp.EscalationRules))
+	}
+	for i, want := range []struct {
+		id      string
+		delay   int
+		targets []*EscalationTarget
+	}{
+		{"R1", 30, []*EscalationTarget{schedule, earline}},
+		{"R2", 15, []*EscalationTarget{earline, schedule, kyler}},
+	} {
+		rule := resp.EscalationRules[i]
+		if rule.ID != want.id || rule.EscalationDelayInMinutes != want.delay {
+			t.Errorf("rule %d = %s with delay %d, want %s with delay %d", i, rule.ID, rule.EscalationDelayInMinutes, want.id, want.delay)
+		}
+		if !reflect.DeepEqual(rule.ExpandedTargets, want.targets) {
+			t.Errorf("rule %s expanded targets = %#v, want %#v", rule.ID, rule.ExpandedTargets, want.targets)
+		}
+	}
+
+	want := map[string]int{"/users/PXPGF42": 1, "/users/PAM4FGS": 1, "/schedules/PI7DH85": 1}
+	if !reflect.DeepEqual(fetches, want) {
+		t.Errorf("fetched %v, want each target once: %v", fetches, want)
+	}
+}
+
+func TestEscalationPoliciesGetExpandedConcurrency(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var targets []string
+	for i := 0; i < 3*maxExpandTargetsConcurrency; i++ {
+		targets = append(targets, fmt.Sprintf(`{"id": "U%d", "type": "user_reference"}`, i))
+	}
+
+	mux.HandleFunc("/escalation_policies/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"escalation_policy": {"id": "1", "escalation_rules": [{"targets": [%s]}]}}`, strings.Join(targets, ","))
+	})
+
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	mux.HandleFunc("/users/", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		fmt.Fprintf(w, `{"user": {"id": %q}}`, strings.TrimPrefix(r.URL.Path, "/users/"))
+	})
+
+	resp, err := client.EscalationPolicies.GetExpanded("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := len(resp.EscalationRules[0].ExpandedTargets); n != len(targets) {
+		t.Errorf("expanded %d targets, want %d", n, len(targets))
+	}
+	if maxInFlight > maxExpandTargetsConcurrency {
+		t.Errorf("fetched %d targets at once, want at most %d", maxInFlight, maxExpandTargetsConcurrency)
+	}
+}
+
+func TestEscalationPoliciesGetExpandedError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/escalation_policies/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"escalation_policy": {"id": "1", "escalation_rules": [{"targets": [{"id": "PXPGF42", "type": "user_reference"}]}]}}`))
+	})
+	mux.HandleFunc("/users/PXPGF42", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error": {"code": 2100, "message": "Not Found"}}`))
+	})
+
+	_, err := client.EscalationPolicies.GetExpanded("1")
+	if !IsNotFound(err) {
+		t.Fatalf("got error %v, want a not found error", err)
+	}
+	if !strings.Contains(err.Error(), "user PXPGF42") {
+		t.Errorf("got error %q, want it to name the target", err)
+	}
+}
+
+func TestEscalationPoliciesFetchTargetsCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/PXPGF42", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request with a canceled context")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	targets, err := client.EscalationPolicies.fetchEscalationTargets(ctx, []escalationTargetKey{{Type: "user", ID: "PXPGF42"}})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want %v", err, context.Canceled)
+	}
+	if targets != nil {
+		t.Errorf("returned %v, want no targets", targets)
+	}
+}
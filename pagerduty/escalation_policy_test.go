@@ -2,9 +2,12 @@ package pagerduty
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"net/url"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestEscalationPoliciesList(t *testing.T) {
@@ -34,6 +37,137 @@ func TestEscalationPoliciesList(t *testing.T) {
 	}
 }
 
+func TestEscalationPoliciesListFilters(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/escalation_policies", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		want := url.Values{
+			"query":      {"eng"},
+			"user_ids[]": {"PXPGF42", "PAM4FGS"},
+			"team_ids[]": {"PQ9K7I8"},
+			"sort_by":    {"name:desc"},
+			"include[]":  {"services", "teams", "targets"},
+			"limit":      {"25"},
+		}
+		if got := r.URL.Query(); !reflect.DeepEqual(got, want) {
+			t.Errorf("query = %v, want %v", got, want)
+		}
+		w.Write([]byte(`{"escalation_policies": [{"id": "1"}], "limit": 25, "offset": 0, "more": true, "total": 26}`))
+	})
+
+	resp, _, err := client.EscalationPolicies.List(&ListEscalationPoliciesOptions{
+		ListOptions: ListOptions{Limit: 25},
+		Query:       "eng",
+		UserIDs:     []string{"PXPGF42", "PAM4FGS"},
+		TeamIDs:     []string{"PQ9K7I8"},
+		SortBy:      "name:desc",
+		Includes:    []string{"services", "teams", "targets"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListEscalationPoliciesResponse{
+		PaginationMeta:     PaginationMeta{Limit: 25, More: true, Total: 26},
+		EscalationPolicies: []*EscalationPolicy{{ID: "1"}},
+	}
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestEscalationPoliciesListIncludeTargets(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/escalation_policies", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"escalation_policies": [{
+			"id": "PANZZEQ",
+			"escalation_rules": [{
+				"id": "PANZZEQ",
+				"escalation_delay_in_minutes": 30,
+				"targets": [
+					{"id": "PXPGF42", "type": "user", "name": "Earline Greenholt", "email": "125.greenholt.earline@graham.name", "time_zone": "America/Lima"},
+					{"id": "PI7DH85", "type": "schedule", "name": "Daily Engineering Rotation", "time_zone": "America/New_York"}
+				]
+			}]
+		}]}`))
+	})
+
+	resp, _, err := client.EscalationPolicies.List(&ListEscalationPoliciesOptions{Includes: []string{"targets"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rule := resp.EscalationPolicies[0].EscalationRules[0]
+
+	wantTargets := []*EscalationTargetReference{{ID: "PXPGF42", Type: "user"}, {ID: "PI7DH85", Type: "schedule"}}
+	if !reflect.DeepEqual(rule.Targets, wantTargets) {
+		t.Errorf("Targets = %#v, want %#v", rule.Targets, wantTargets)
+	}
+
+	wantExpanded := []*EscalationTarget{
+		{User: &User{ID: "PXPGF42", Type: "user", Name: "Earline Greenholt", Email: "125.greenholt.earline@graham.name", TimeZone: "America/Lima"}},
+		{Schedule: &Schedule{ID: "PI7DH85", Type: "schedule", Name: "Daily Engineering Rotation", TimeZone: "America/New_York"}},
+	}
+	if !reflect.DeepEqual(rule.ExpandedTargets, wantExpanded) {
+		t.Errorf("ExpandedTargets = %#v, want %#v", rule.ExpandedTargets, wantExpanded)
+	}
+
+	// Expanded targets are not sent back.
+	b, err := json.Marshal(rule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"escalation_delay_in_minutes":30,"id":"PANZZEQ","targets":[{"id":"PXPGF42","type":"user"},{"id":"PI7DH85","type":"schedule"}]}`; string(b) != want {
+		t.Errorf("encoded %s, want %s", b, want)
+	}
+}
+
+func TestEscalationRuleUnmarshalReferences(t *testing.T) {
+	var rule EscalationRule
+	if err := json.Unmarshal([]byte(`{"id": "1", "targets": [{"id": "PXPGF42", "type": "user_reference"}]}`), &rule); err != nil {
+		t.Fatal(err)
+	}
+
+	want := EscalationRule{ID: "1", Targets: []*EscalationTargetReference{{ID: "PXPGF42", Type: "user_reference"}}}
+	if !reflect.DeepEqual(rule, want) {
+		t.Errorf("decoded %#v, want %#v", rule, want)
+	}
+}
+
+func TestEscalationPoliciesListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/escalation_policies", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.Query()["team_ids[]"]; !reflect.DeepEqual(got, []string{"PQ9K7I8"}) {
+			t.Errorf("team_ids[] = %v, want [PQ9K7I8]", got)
+		}
+		switch r.URL.Query().Get("offset") {
+		case "0", "":
+			w.Write([]byte(`{"escalation_policies": [{"id": "1"}], "limit": 1, "offset": 0, "more": true, "total": 2}`))
+		case "1":
+			w.Write([]byte(`{"escalation_policies": [{"id": "2"}], "limit": 1, "offset": 1, "more": false, "total": 2}`))
+		default:
+			t.Errorf("unexpected offset %q", r.URL.Query().Get("offset"))
+		}
+	})
+
+	resp, err := client.EscalationPolicies.ListAll(&ListEscalationPoliciesOptions{TeamIDs: []string{"PQ9K7I8"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []*EscalationPolicy{{ID: "1"}, {ID: "2"}}; !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
 func TestEscalationPoliciesCreate(t *testing.T) {
 	setup()
 	defer teardown()
@@ -79,6 +213,51 @@ func TestEscalationPoliciesDelete(t *testing.T) {
 	}
 }
 
+func TestEscalationPoliciesDeleteUsedByServices(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/escalation_policies/PANZZEQ", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{
+			"error": {
+				"code": 2001,
+				"message": "Invalid Input Provided",
+				"errors": ["Escalation Policy cannot be deleted because it's in use by services"],
+				"conflicts": [
+					{"id": "PIJ90N7", "type": "service_reference", "summary": "My Application Service"},
+					{"id": "PSI2I6W", "type": "service_reference", "summary": "Checkout Service"}
+				]
+			}
+		}`))
+	})
+
+	_, err := client.EscalationPolicies.Delete("PANZZEQ")
+
+	var deletionErr *EscalationPolicyDeletionError
+	if !errors.As(err, &deletionErr) {
+		t.Fatalf("got error %v, want an *EscalationPolicyDeletionError", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != 2001 {
+		t.Errorf("got error %v, want an *APIError with code 2001", err)
+	}
+
+	want := &EscalationPolicyDeletionError{
+		EscalationPolicyID: "PANZZEQ",
+		Services: []*ServiceReference{
+			{ID: "PIJ90N7", Type: "service_reference", Summary: "My Application Service"},
+			{ID: "PSI2I6W", Type: "service_reference", Summary: "Checkout Service"},
+		},
+	}
+	deletionErr.Err = nil
+	if !reflect.DeepEqual(deletionErr, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", deletionErr, want)
+	}
+}
+
 func TestEscalationPoliciesGet(t *testing.T) {
 	setup()
 	defer teardown()
@@ -102,6 +281,67 @@ func TestEscalationPoliciesGet(t *testing.T) {
 	}
 }
 
+func TestEscalationPoliciesGetLoopsAndHandoff(t *testing.T) {
+	setup()
+	defer teardown()
+
+	body := `{"id":"1","name":"foo","num_loops":0,"on_call_handoff_notifications":"always","teams":null}`
+
+	mux.HandleFunc("/escalation_policies/1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.Write([]byte(`{"escalation_policy": ` + body + `}`))
+		case "PUT":
+			// What was read is sent back unchanged, so there is no drift.
+			testBody(t, r, `{"escalation_policy":`+body+`}`)
+			w.Write([]byte(`{"escalation_policy": ` + body + `}`))
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	resp, _, err := client.EscalationPolicies.Get("1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loops := 0
+	want := &EscalationPolicy{ID: "1", Name: "foo", NumLoops: &loops, OnCallHandoffNotifications: OnCallHandoffNotificationsAlways}
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+
+	if _, _, err := client.EscalationPolicies.Update("1", resp); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEscalationPolicyValidate(t *testing.T) {
+	loops := func(n int) *int { return &n }
+
+	valid := []*EscalationPolicy{
+		{},
+		{NumLoops: loops(0), OnCallHandoffNotifications: OnCallHandoffNotificationsIfHasServices},
+		{NumLoops: loops(MaxEscalationPolicyNumLoops), OnCallHandoffNotifications: OnCallHandoffNotificationsAlways},
+	}
+	for _, p := range valid {
+		if err := p.Validate(); err != nil {
+			t.Errorf("Validate() = %v for %#v", err, p)
+		}
+	}
+
+	invalid := []*EscalationPolicy{
+		{NumLoops: loops(-1)},
+		{NumLoops: loops(10)},
+		{OnCallHandoffNotifications: "never"},
+	}
+	for _, p := range invalid {
+		if err := p.Validate(); err == nil {
+			t.Errorf("expected an error validating %#v", p)
+		}
+	}
+}
+
 func TestEscalationPoliciesUpdate(t *testing.T) {
 	setup()
 	defer teardown()
@@ -155,3 +395,274 @@ func TestEscalationPoliciesUpdateTeams(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestEscalationPoliciesListAuditRecords(t *testing.T) {
+	setup()
+	defer teardown()
+
+	since := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mux.HandleFunc("/escalation_policies/PANZZEQ/audit/records", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		want := url.Values{
+			"since":  {"2023-01-01T00:00:00Z"},
+			"until":  {"2023-01-08T00:00:00Z"},
+			"cursor": {"abc"},
+		}
+		if got := r.URL.Query(); !reflect.DeepEqual(got, want) {
+			t.Errorf("query = %v, want %v", got, want)
+		}
+		w.Write([]byte(`{"records": [{
+			"id": "PDRECORDID1",
+			"execution_time": "2023-01-03T10:00:00.000Z",
+			"actors": [{"id": "PXPGF42", "type": "user_reference", "summary": "Earline Greenholt"}],
+			"method": {"type": "api_token", "truncated_token": "3xyz"},
+			"root_resource": {"id": "PANZZEQ", "type": "escalation_policy_reference"},
+			"action": "update",
+			"details": {
+				"resource": {"id": "PANZZEQ", "type": "escalation_policy_reference"},
+				"fields": [{"name": "escalation_rules", "value": "PAM4FGS", "before_value": "PXPGF42"}]
+			}
+		}], "limit": 25, "next_cursor": "def"}`))
+	})
+
+	resp, _, err := client.EscalationPolicies.ListAuditRecords("PANZZEQ", &ListAuditRecordsOptions{
+		CursorPagination: CursorPagination{Cursor: "abc"},
+		Since:            since.Format(time.RFC3339),
+		Until:            since.AddDate(0, 0, 7).Format(time.RFC3339),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListAuditRecordsResponse{
+		CursorPagination: CursorPagination{Limit: 25, NextCursor: "def"},
+		Records: []*AuditRecord{
+			{
+				ID:            "PDRECORDID1",
+				ExecutionTime: "2023-01-03T10:00:00.000Z",
+				Actors:        []*AuditActor{{ID: "PXPGF42", Type: "user_reference", Summary: "Earline Greenholt"}},
+				Method:        &AuditMethod{Type: "api_token", TruncatedToken: "3xyz"},
+				RootResource:  &AuditResource{ID: "PANZZEQ", Type: "escalation_policy_reference"},
+				Action:        "update",
+				Details: &AuditDetails{
+					Resource: &AuditResource{ID: "PANZZEQ", Type: "escalation_policy_reference"},
+					Fields:   []*AuditField{{Name: "escalation_rules", Value: "PAM4FGS", BeforeValue: "PXPGF42"}},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestEscalationPoliciesListAllAuditRecords(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/escalation_policies/PANZZEQ/audit/records", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.Query().Get("since"); got != "2023-01-01T00:00:00Z" {
+			t.Errorf("since = %q on every page, want 2023-01-01T00:00:00Z", got)
+		}
+		switch cursor := r.URL.Query().Get("cursor"); cursor {
+		case "":
+			w.Write([]byte(`{"records": [{"id": "1"}], "next_cursor": "abc"}`))
+		case "abc":
+			w.Write([]byte(`{"records": [{"id": "2"}], "next_cursor": null}`))
+		default:
+			t.Errorf("unexpected cursor %q", cursor)
+		}
+	})
+
+	resp, err := client.EscalationPolicies.ListAllAuditRecords("PANZZEQ", &ListAuditRecordsOptions{Since: "2023-01-01T00:00:00Z"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []*AuditRecord{{ID: "1"}, {ID: "2"}}; !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestEscalationPoliciesCreateAssignmentStrategy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	body := `{"escalation_policy":{"escalation_rules":[{"escalation_delay_in_minutes":30,"escalation_rule_assignment_strategy":{"type":"round_robin"},"targets":[{"id":"PXPGF42","type":"user_reference"},{"id":"PAM4FGS","type":"user_reference"}]}],"name":"foo","teams":null}}`
+
+	mux.HandleFunc("/escalation_policies", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if got := r.Header.Values("X-Early-Access"); !reflect.DeepEqual(got, []string{EscalationRuleAssignmentStrategyEarlyAccess}) {
+			t.Errorf("X-Early-Access = %v, want %s", got, EscalationRuleAssignmentStrategyEarlyAccess)
+		}
+		testBody(t, r, body)
+		w.Write([]byte(`{"escalation_policy": {"id": "1", "escalation_rules": [{"id": "R1", "escalation_rule_assignment_strategy": {"type": "round_robin"}}]}}`))
+	})
+
+	input := &EscalationPolicy{
+		Name: "foo",
+		EscalationRules: []*EscalationRule{{
+			EscalationDelayInMinutes:         30,
+			EscalationRuleAssignmentStrategy: &EscalationRuleAssignmentStrategy{Type: EscalationRuleAssignmentStrategyRoundRobin},
+			Targets:                          []*EscalationTargetReference{{ID: "PXPGF42", Type: "user_reference"}, {ID: "PAM4FGS", Type: "user_reference"}},
+		}},
+	}
+
+	resp, _, err := client.EscalationPolicies.Create(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &EscalationPolicy{
+		ID: "1",
+		EscalationRules: []*EscalationRule{{
+			ID:                               "R1",
+			EscalationRuleAssignmentStrategy: &EscalationRuleAssignmentStrategy{Type: EscalationRuleAssignmentStrategyRoundRobin},
+		}},
+	}
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestEscalationPoliciesUpdateWithoutAssignmentStrategy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/escalation_policies/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		if got := r.Header.Values("X-Early-Access"); len(got) != 0 {
+			t.Errorf("X-Early-Access = %v, want none", got)
+		}
+		// The strategy is left out entirely for classic accounts.
+		testBody(t, r, `{"escalation_policy":{"escalation_rules":[{"escalation_delay_in_minutes":30,"id":"R1","targets":[{"id":"PI7DH85","type":"schedule_reference"}]}],"teams":null}}`)
+		w.Write([]byte(`{"escalation_policy": {"id": "1", "escalation_rules": [{"id": "R1", "escalation_delay_in_minutes": 30, "targets": [{"id": "PI7DH85", "type": "schedule_reference"}]}]}}`))
+	})
+
+	input := &EscalationPolicy{
+		EscalationRules: []*EscalationRule{{
+			ID:                       "R1",
+			EscalationDelayInMinutes: 30,
+			Targets:                  []*EscalationTargetReference{{ID: "PI7DH85", Type: "schedule_reference"}},
+		}},
+	}
+
+	resp, _, err := client.EscalationPolicies.Update("1", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := (&EscalationPolicy{ID: "1", EscalationRules: input.EscalationRules}); !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestEscalationPoliciesGetAssignmentStrategy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/escalation_policies/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "X-Early-Access", EscalationRuleAssignmentStrategyEarlyAccess)
+		w.Write([]byte(`{"escalation_policy": {"id": "1", "escalation_rules": [{"id": "R1", "escalation_rule_assignment_strategy": {"type": "assign_to_everyone"}}]}}`))
+	})
+
+	resp, _, err := client.EscalationPolicies.Get("1", nil, WithEarlyAccess(EscalationRuleAssignmentStrategyEarlyAccess))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := resp.EscalationRules[0].EscalationRuleAssignmentStrategy; got == nil || got.Type != EscalationRuleAssignmentStrategyAssignToEveryone {
+		t.Errorf("assignment strategy = %#v, want %s", got, EscalationRuleAssignmentStrategyAssignToEveryone)
+	}
+}
+
+func TestEscalationPoliciesListRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/escalation_policies/PANZZEQ/escalation_rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.Query()["include[]"]; !reflect.DeepEqual(got, []string{"targets"}) {
+			t.Errorf("include[] = %v, want [targets]", got)
+		}
+		w.Write([]byte(`{"escalation_rules": [
+			{"id": "R1", "escalation_delay_in_minutes": 30, "targets": [{"id": "PI7DH85", "type": "schedule_reference"}]},
+			{"id": "R2", "escalation_delay_in_minutes": 15, "targets": [{"id": "PXPGF42", "type": "user_reference"}]}
+		]}`))
+	})
+
+	resp, _, err := client.EscalationPolicies.ListRules("PANZZEQ", &ListEscalationRulesOptions{Includes: []string{"targets"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListEscalationRulesResponse{
+		EscalationRules: []*EscalationRule{
+			{ID: "R1", EscalationDelayInMinutes: 30, Targets: []*EscalationTargetReference{{ID: "PI7DH85", Type: "schedule_reference"}}},
+			{ID: "R2", EscalationDelayInMinutes: 15, Targets: []*EscalationTargetReference{{ID: "PXPGF42", Type: "user_reference"}}},
+		},
+	}
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestEscalationPoliciesGetRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/escalation_policies/PANZZEQ/escalation_rules/R1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"escalation_rule": {"id": "R1", "escalation_delay_in_minutes": 30, "targets": [{"id": "PI7DH85", "type": "schedule_reference"}]}}`))
+	})
+
+	resp, _, err := client.EscalationPolicies.GetRule("PANZZEQ", "R1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &EscalationRule{ID: "R1", EscalationDelayInMinutes: 30, Targets: []*EscalationTargetReference{{ID: "PI7DH85", Type: "schedule_reference"}}}
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestEscalationPoliciesUpdateRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/escalation_policies/PANZZEQ/escalation_rules/R1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.Write([]byte(`{"escalation_rule": {"id": "R1", "escalation_delay_in_minutes": 30, "targets": [{"id": "PI7DH85", "type": "schedule_reference"}]}}`))
+		case "PUT":
+			// The fetched rule is sent back whole, with only the delay changed.
+			testBody(t, r, `{"escalation_rule":{"escalation_delay_in_minutes":10,"id":"R1","targets":[{"id":"PI7DH85","type":"schedule_reference"}]}}`)
+			w.Write([]byte(`{"escalation_rule": {"id": "R1", "escalation_delay_in_minutes": 10, "targets": [{"id": "PI7DH85", "type": "schedule_reference"}]}}`))
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	mux.HandleFunc("/escalation_policies/PANZZEQ", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s to the whole policy", r.Method, r.URL.Path)
+	})
+
+	rule, _, err := client.EscalationPolicies.GetRule("PANZZEQ", "R1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rule.EscalationDelayInMinutes = 10
+
+	resp, _, err := client.EscalationPolicies.UpdateRule("PANZZEQ", "R1", rule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(resp, rule) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, rule)
+	}
+}
@@ -0,0 +1,81 @@
+package pagerduty
+
+import (
+	"container/list"
+	"net/http"
+	"sync"
+)
+
+const defaultETagCacheMaxEntries = 1000
+
+// etagCache remembers the ETag and body of GET responses, so that unchanged
+// resources can be revalidated with If-None-Match instead of being
+// transferred again. It evicts the least recently used entry once full and
+// is safe for concurrent use.
+type etagCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	ll         *list.List
+	items      map[string]*list.Element
+}
+
+type etagCacheEntry struct {
+	key  string
+	etag string
+	body []byte
+}
+
+func newETagCache(maxEntries int) *etagCache {
+	if maxEntries <= 0 {
+		maxEntries = defaultETagCacheMaxEntries
+	}
+	return &etagCache{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+	}
+}
+
+// etagCacheKey identifies a GET request. The Authorization header is part of
+// the key since what a resource looks like depends on who is asking.
+func etagCacheKey(req *http.Request) string {
+	return req.URL.String() + "\x00" + req.Header.Get("Authorization")
+}
+
+func (c *etagCache) get(key string) *etagCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return nil
+	}
+	c.ll.MoveToFront(el)
+	return el.Value.(*etagCacheEntry)
+}
+
+func (c *etagCache) put(key, etag string, body []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &etagCacheEntry{key: key, etag: etag, body: body}
+	if el, ok := c.items[key]; ok {
+		el.Value = entry
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(entry)
+	if c.ll.Len() > c.maxEntries {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*etagCacheEntry).key)
+	}
+}
+
+func (c *etagCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.ll.Len()
+}
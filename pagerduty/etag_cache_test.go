@@ -0,0 +1,110 @@
+package pagerduty
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestETagCache(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Config.ETagCache = true
+	c, err := NewClient(client.Config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calls := 0
+	mux.HandleFunc("/teams/1", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		if calls > 1 {
+			t.Errorf("request %d without If-None-Match", calls)
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`{"team": {"id": "1", "name": "foo"}}`))
+	})
+
+	want := &Team{ID: "1", Name: "foo"}
+	for i := 0; i < 3; i++ {
+		team, resp, err := c.Teams.Get("1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(team, want) {
+			t.Errorf("got %#v, want %#v", team, want)
+		}
+		if wantStatus := http.StatusNotModified; i > 0 && resp.Response.StatusCode != wantStatus {
+			t.Errorf("got status %d, want %d", resp.Response.StatusCode, wantStatus)
+		}
+	}
+
+	if calls != 3 {
+		t.Errorf("server called %d times, want 3", calls)
+	}
+}
+
+func TestETagCacheDisabledByDefault(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/teams/1", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "If-None-Match", "")
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`{"team": {"id": "1"}}`))
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Teams.Get("1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestETagCacheBounded(t *testing.T) {
+	cache := newETagCache(2)
+
+	cache.put("a", "1", nil)
+	cache.put("b", "2", nil)
+	cache.get("a")
+	cache.put("c", "3", nil)
+
+	if cache.len() != 2 {
+		t.Fatalf("cache has %d entries, want 2", cache.len())
+	}
+	if cache.get("b") != nil {
+		t.Error("least recently used entry was not evicted")
+	}
+	if cache.get("a") == nil || cache.get("c") == nil {
+		t.Error("recently used entries were evicted")
+	}
+}
+
+func TestETagCacheConcurrent(t *testing.T) {
+	cache := newETagCache(10)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				key := fmt.Sprintf("%d", (i+j)%20)
+				cache.put(key, key, nil)
+				cache.get(key)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if cache.len() > 10 {
+		t.Errorf("cache has %d entries, want at most 10", cache.len())
+	}
+}
@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -35,6 +36,10 @@ type ListEventOrchestrationsResponse struct {
 var eventOrchestrationBaseUrl = "/event_orchestrations"
 
 func (s *EventOrchestrationService) List() (*ListEventOrchestrationsResponse, *Response, error) {
+	return s.ListContext(context.Background())
+}
+
+func (s *EventOrchestrationService) ListContext(ctx context.Context) (*ListEventOrchestrationsResponse, *Response, error) {
 	v := new(ListEventOrchestrationsResponse)
 	v.Total = 0
 
@@ -42,11 +47,11 @@ func (s *EventOrchestrationService) List() (*ListEventOrchestrationsResponse, *R
 
 	// Create a handler closure capable of parsing data from the event orchestrations endpoint
 	// and appending resultant orchestrations to the return slice.
-	responseHandler := func(response *Response) (ListResp, *Response, error) {
+	responseHandler := func(response *Response) (PaginationMeta, *Response, error) {
 		var result ListEventOrchestrationsResponse
 
 		if err := s.client.DecodeJSON(response, &result); err != nil {
-			return ListResp{}, response, err
+			return PaginationMeta{}, response, err
 		}
 
 		v.Total += result.Total
@@ -57,13 +62,13 @@ func (s *EventOrchestrationService) List() (*ListEventOrchestrationsResponse, *R
 
 		// Return stats on the current page. Caller can use this information to
 		// adjust for requesting additional pages.
-		return ListResp{
+		return PaginationMeta{
 			More:   result.More,
 			Offset: result.Offset,
 			Limit:  result.Limit,
 		}, response, nil
 	}
-	err := s.client.newRequestPagedGetDo(eventOrchestrationBaseUrl, responseHandler)
+	err := s.client.newRequestPagedGetDoContext(ctx, eventOrchestrationBaseUrl, responseHandler, WithRoute(eventOrchestrationBaseUrl))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -73,10 +78,14 @@ func (s *EventOrchestrationService) List() (*ListEventOrchestrationsResponse, *R
 }
 
 func (s *EventOrchestrationService) Create(orchestration *EventOrchestration) (*EventOrchestration, *Response, error) {
+	return s.CreateContext(context.Background(), orchestration)
+}
+
+func (s *EventOrchestrationService) CreateContext(ctx context.Context, orchestration *EventOrchestration) (*EventOrchestration, *Response, error) {
 	v := new(EventOrchestrationPayload)
 	p := &EventOrchestrationPayload{Orchestration: orchestration}
 
-	resp, err := s.client.newRequestDo("POST", eventOrchestrationBaseUrl, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", eventOrchestrationBaseUrl, nil, p, v, WithRoute(eventOrchestrationBaseUrl))
 
 	if err != nil {
 		return nil, nil, err
@@ -86,11 +95,15 @@ func (s *EventOrchestrationService) Create(orchestration *EventOrchestration) (*
 }
 
 func (s *EventOrchestrationService) Get(ID string) (*EventOrchestration, *Response, error) {
+	return s.GetContext(context.Background(), ID)
+}
+
+func (s *EventOrchestrationService) GetContext(ctx context.Context, ID string) (*EventOrchestration, *Response, error) {
 	u := fmt.Sprintf("%s/%s", eventOrchestrationBaseUrl, ID)
 	v := new(EventOrchestrationPayload)
 	p := &EventOrchestrationPayload{}
 
-	resp, err := s.client.newRequestDo("GET", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, p, v, WithRoute("/event_orchestrations/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -99,11 +112,15 @@ func (s *EventOrchestrationService) Get(ID string) (*EventOrchestration, *Respon
 }
 
 func (s *EventOrchestrationService) Update(ID string, orchestration *EventOrchestration) (*EventOrchestration, *Response, error) {
+	return s.UpdateContext(context.Background(), ID, orchestration)
+}
+
+func (s *EventOrchestrationService) UpdateContext(ctx context.Context, ID string, orchestration *EventOrchestration) (*EventOrchestration, *Response, error) {
 	u := fmt.Sprintf("%s/%s", eventOrchestrationBaseUrl, ID)
 	v := new(EventOrchestrationPayload)
 	p := &EventOrchestrationPayload{Orchestration: orchestration}
 
-	resp, err := s.client.newRequestDo("PUT", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, p, v, WithRoute("/event_orchestrations/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -112,6 +129,10 @@ func (s *EventOrchestrationService) Update(ID string, orchestration *EventOrches
 }
 
 func (s *EventOrchestrationService) Delete(ID string) (*Response, error) {
+	return s.DeleteContext(context.Background(), ID)
+}
+
+func (s *EventOrchestrationService) DeleteContext(ctx context.Context, ID string) (*Response, error) {
 	u := fmt.Sprintf("%s/%s", eventOrchestrationBaseUrl, ID)
-	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/event_orchestrations/{id}"))
 }
@@ -58,7 +58,7 @@ func (s *EventOrchestrationCacheVariableService) List(ctx context.Context, cache
 	u := buildEventOrchestrationCacheVariableUrl(cacheVariableType, orchestrationId, "")
 	v := new(ListEventOrchestrationCacheVariablesResponse)
 
-	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, v, WithRoute(buildEventOrchestrationCacheVariableUrl(cacheVariableType, "{id}", "")))
 
 	if err != nil {
 		return nil, nil, err
@@ -72,7 +72,7 @@ func (s *EventOrchestrationCacheVariableService) Create(ctx context.Context, cac
 	v := new(EventOrchestrationCacheVariablePayload)
 	p := &EventOrchestrationCacheVariablePayload{CacheVariable: cacheVariable}
 
-	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, p, v, WithRoute(buildEventOrchestrationCacheVariableUrl(cacheVariableType, "{id}", "")))
 
 	if err != nil {
 		return nil, nil, err
@@ -85,7 +85,7 @@ func (s *EventOrchestrationCacheVariableService) Get(ctx context.Context, cacheV
 	u := buildEventOrchestrationCacheVariableUrl(cacheVariableType, orchestrationId, cacheVariableId)
 	v := new(EventOrchestrationCacheVariablePayload)
 
-	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, v, WithRoute(buildEventOrchestrationCacheVariableUrl(cacheVariableType, "{id}", "{id}")))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -98,7 +98,7 @@ func (s *EventOrchestrationCacheVariableService) Update(ctx context.Context, cac
 	v := new(EventOrchestrationCacheVariablePayload)
 	p := &EventOrchestrationCacheVariablePayload{CacheVariable: cacheVariable}
 
-	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, p, v, WithRoute(buildEventOrchestrationCacheVariableUrl(cacheVariableType, "{id}", "{id}")))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -108,5 +108,5 @@ func (s *EventOrchestrationCacheVariableService) Update(ctx context.Context, cac
 
 func (s *EventOrchestrationCacheVariableService) Delete(ctx context.Context, cacheVariableType string, orchestrationId string, cacheVariableId string) (*Response, error) {
 	u := buildEventOrchestrationCacheVariableUrl(cacheVariableType, orchestrationId, cacheVariableId)
-	return s.client.newRequestDoContext(ctx, "DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute(buildEventOrchestrationCacheVariableUrl(cacheVariableType, "{id}", "{id}")))
 }
@@ -47,7 +47,7 @@ func (s *EventOrchestrationIntegrationService) ListContext(ctx context.Context,
 	u := buildEventOrchestrationIntegrationUrl(orchestrationId, "")
 	v := new(ListEventOrchestrationIntegrationsResponse)
 
-	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, v, WithRoute(buildEventOrchestrationIntegrationUrl("{id}", "")))
 
 	if err != nil {
 		return nil, nil, err
@@ -61,7 +61,7 @@ func (s *EventOrchestrationIntegrationService) CreateContext(ctx context.Context
 	v := new(EventOrchestrationIntegrationPayload)
 	p := &EventOrchestrationIntegrationPayload{Integration: integration}
 
-	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, p, v, WithRoute(buildEventOrchestrationIntegrationUrl("{id}", "")))
 
 	if err != nil {
 		return nil, nil, err
@@ -74,7 +74,7 @@ func (s *EventOrchestrationIntegrationService) GetContext(ctx context.Context, o
 	u := buildEventOrchestrationIntegrationUrl(orchestrationId, id)
 	v := new(EventOrchestrationIntegrationPayload)
 
-	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, v, WithRoute(buildEventOrchestrationIntegrationUrl("{id}", "{id}")))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -87,7 +87,7 @@ func (s *EventOrchestrationIntegrationService) UpdateContext(ctx context.Context
 	v := new(EventOrchestrationIntegrationPayload)
 	p := &EventOrchestrationIntegrationPayload{Integration: integration}
 
-	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, p, v, WithRoute(buildEventOrchestrationIntegrationUrl("{id}", "{id}")))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -97,7 +97,7 @@ func (s *EventOrchestrationIntegrationService) UpdateContext(ctx context.Context
 
 func (s *EventOrchestrationIntegrationService) DeleteContext(ctx context.Context, orchestrationId string, id string) (*Response, error) {
 	u := buildEventOrchestrationIntegrationUrl(orchestrationId, id)
-	return s.client.newRequestDoContext(ctx, "DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute(buildEventOrchestrationIntegrationUrl("{id}", "{id}")))
 }
 
 func (s *EventOrchestrationIntegrationService) MigrateFromOrchestrationContext(ctx context.Context, destinationOrchestrationId string, sourceOrchestrationId string, id string) (*ListEventOrchestrationIntegrationsResponse, *Response, error) {
@@ -109,7 +109,7 @@ func (s *EventOrchestrationIntegrationService) MigrateFromOrchestrationContext(c
 		IntegrationId: id,
 	}
 
-	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, p, v, WithRoute(buildEventOrchestrationIntegrationUrl("{id}", "migration")))
 
 	if err != nil {
 		return nil, nil, err
@@ -153,7 +153,7 @@ func (s *EventOrchestrationPathService) GetContext(ctx context.Context, id strin
 	u := orchestrationPathUrlBuilder(id, pathType)
 	v := new(EventOrchestrationPathPayload)
 
-	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute(orchestrationPathUrlBuilder("{id}", pathType)))
 
 	if err != nil {
 		return nil, nil, err
@@ -167,7 +167,7 @@ func (s *EventOrchestrationPathService) GetServiceActiveStatusContext(ctx contex
 	u := fmt.Sprintf("%s/services/%s/active", eventOrchestrationBaseUrl, id)
 	v := new(EventOrchestrationPathServiceActiveStatus)
 
-	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/event_orchestrations/services/{id}/active"))
 
 	if err != nil {
 		return nil, nil, err
@@ -186,7 +186,7 @@ func (s *EventOrchestrationPathService) UpdateContext(ctx context.Context, id st
 	v := new(EventOrchestrationPathPayload)
 	p := EventOrchestrationPathPayload{OrchestrationPath: orchestrationPath}
 
-	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, p, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, p, &v, WithRoute(orchestrationPathUrlBuilder("{id}", pathType)))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -200,7 +200,7 @@ func (s *EventOrchestrationPathService) UpdateServiceActiveStatusContext(ctx con
 	v := new(EventOrchestrationPathServiceActiveStatus)
 	p := EventOrchestrationPathServiceActiveStatus{Active: isActive}
 
-	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, p, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, p, &v, WithRoute("/event_orchestrations/services/{id}/active"))
 	if err != nil {
 		return nil, nil, err
 	}
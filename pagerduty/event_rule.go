@@ -1,6 +1,9 @@
 package pagerduty
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // EventRuleService handles the communication with event rules
 // related methods of the PagerDuty API.
@@ -25,10 +28,15 @@ type ListEventRulesResponse struct {
 
 // List lists existing event rules.
 func (s *EventRuleService) List() (*ListEventRulesResponse, *Response, error) {
+	return s.ListContext(context.Background())
+}
+
+// ListContext lists existing event rules.
+func (s *EventRuleService) ListContext(ctx context.Context) (*ListEventRulesResponse, *Response, error) {
 	u := "/event_rules"
 	v := new(ListEventRulesResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/event_rules"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -38,10 +46,15 @@ func (s *EventRuleService) List() (*ListEventRulesResponse, *Response, error) {
 
 // Create creates a new event rule.
 func (s *EventRuleService) Create(eventRule *EventRule) (*EventRule, *Response, error) {
+	return s.CreateContext(context.Background(), eventRule)
+}
+
+// CreateContext creates a new event rule.
+func (s *EventRuleService) CreateContext(ctx context.Context, eventRule *EventRule) (*EventRule, *Response, error) {
 	u := "/event_rules"
 	v := new(EventRule)
 
-	resp, err := s.client.newRequestDo("POST", u, nil, eventRule, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, eventRule, v, WithRoute("/event_rules"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -51,16 +64,26 @@ func (s *EventRuleService) Create(eventRule *EventRule) (*EventRule, *Response,
 
 // Delete deletes an existing event rule.
 func (s *EventRuleService) Delete(id string) (*Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext deletes an existing event rule.
+func (s *EventRuleService) DeleteContext(ctx context.Context, id string) (*Response, error) {
 	u := fmt.Sprintf("/event_rules/%s", id)
-	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/event_rules/{id}"))
 }
 
 // Update updates an existing event rule.
 func (s *EventRuleService) Update(id string, eventRule *EventRule) (*EventRule, *Response, error) {
+	return s.UpdateContext(context.Background(), id, eventRule)
+}
+
+// UpdateContext updates an existing event rule.
+func (s *EventRuleService) UpdateContext(ctx context.Context, id string, eventRule *EventRule) (*EventRule, *Response, error) {
 	u := fmt.Sprintf("/event_rules/%s", id)
 	v := new(EventRule)
 
-	resp, err := s.client.newRequestDo("PUT", u, nil, eventRule, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, eventRule, v, WithRoute("/event_rules/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
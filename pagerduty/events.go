@@ -0,0 +1,217 @@
+package pagerduty
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// EventsService handles the communication with the PagerDuty Events API V2,
+// which is authenticated by the routing key of each event instead of the
+// credentials of the client and served from Config.EventsBaseURL.
+type EventsService service
+
+// Event actions of a V2Event.
+const (
+	EventActionTrigger     = "trigger"
+	EventActionAcknowledge = "acknowledge"
+	EventActionResolve     = "resolve"
+)
+
+// V2Event represents an alert event sent to the Events API V2.
+type V2Event struct {
+	RoutingKey  string     `json:"routing_key"`
+	EventAction string     `json:"event_action"`
+	DedupKey    string     `json:"dedup_key,omitempty"`
+	Client      string     `json:"client,omitempty"`
+	ClientURL   string     `json:"client_url,omitempty"`
+	Payload     *V2Payload `json:"payload,omitempty"`
+	Images      []*V2Image `json:"images,omitempty"`
+	Links       []*V2Link  `json:"links,omitempty"`
+}
+
+// V2Payload represents the details of a triggered alert. Summary, Source
+// and Severity, one of "critical", "error", "warning" or "info", are
+// required.
+type V2Payload struct {
+	Summary       string      `json:"summary"`
+	Source        string      `json:"source"`
+	Severity      string      `json:"severity"`
+	Timestamp     string      `json:"timestamp,omitempty"`
+	Component     string      `json:"component,omitempty"`
+	Group         string      `json:"group,omitempty"`
+	Class         string      `json:"class,omitempty"`
+	CustomDetails interface{} `json:"custom_details,omitempty"`
+}
+
+// V2Image represents an image attached to an event.
+type V2Image struct {
+	Src  string `json:"src"`
+	Href string `json:"href,omitempty"`
+	Alt  string `json:"alt,omitempty"`
+}
+
+// V2Link represents a link attached to an event.
+type V2Link struct {
+	Href string `json:"href"`
+	Text string `json:"text,omitempty"`
+}
+
+// V2EventResponse represents the response of the Events API V2 to an
+// enqueued event.
+type V2EventResponse struct {
+	Status   string   `json:"status,omitempty"`
+	Message  string   `json:"message,omitempty"`
+	DedupKey string   `json:"dedup_key,omitempty"`
+	Errors   []string `json:"errors,omitempty"`
+}
+
+// EnqueueEvent sends an alert event with the given routing key, which
+// overrides event.RoutingKey. The returned response holds the dedup key of
+// the alert, needed to acknowledge or resolve it. The Events API rate limits
+// events per routing key; once retries are exhausted, IsRateLimited reports
+// true for the returned error.
+func (s *EventsService) EnqueueEvent(routingKey string, event *V2Event) (*V2EventResponse, *Response, error) {
+	return s.EnqueueEventContext(context.Background(), routingKey, event)
+}
+
+// EnqueueEventContext sends an alert event with the given routing key, which
+// overrides event.RoutingKey. The returned response holds the dedup key of
+// the alert, needed to acknowledge or resolve it. The Events API rate limits
+// events per routing key; once retries are exhausted, IsRateLimited reports
+// true for the returned error.
+func (s *EventsService) EnqueueEventContext(ctx context.Context, routingKey string, event *V2Event) (*V2EventResponse, *Response, error) {
+	if routingKey == "" {
+		return nil, nil, errors.New("a routing key is required to enqueue an event")
+	}
+	if event == nil {
+		return nil, nil, errors.New("an event is required")
+	}
+
+	e := *event
+	e.RoutingKey = routingKey
+
+	switch e.EventAction {
+	case EventActionTrigger:
+		if e.Payload == nil || e.Payload.Summary == "" || e.Payload.Source == "" || e.Payload.Severity == "" {
+			return nil, nil, errors.New("a trigger event requires a payload with summary, source and severity")
+		}
+	case EventActionAcknowledge, EventActionResolve:
+		if e.DedupKey == "" {
+			return nil, nil, fmt.Errorf("an %s event requires a dedup key", e.EventAction)
+		}
+	default:
+		return nil, nil, fmt.Errorf("unsupported event action %q", e.EventAction)
+	}
+
+	v := new(V2EventResponse)
+	resp, err := s.enqueueContext(ctx, "/v2/enqueue", &e, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// enqueueContext posts body to an Events API endpoint. Events are
+// deduplicated by PagerDuty, so unlike REST API POST requests they are
+// retried on server errors, and they are sent without the Authorization
+// header of the client.
+func (s *EventsService) enqueueContext(ctx context.Context, path string, body, v interface{}) (*Response, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, requestError("creating request for", "POST", path, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", s.client.eventsBaseURL.String()+path, bytes.NewReader(b))
+	if err != nil {
+		return nil, requestError("creating request for", "POST", path, err)
+	}
+	req = req.WithContext(context.WithValue(req.Context(), retryableContextKey{}, true))
+	req = req.WithContext(withRoute(req.Context(), path))
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", s.client.userAgent)
+
+	resp, err := s.client.do(req, v)
+
+	// Events API errors use the same format as successful responses, copy
+	// their details over.
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.Message == "" {
+		var r V2EventResponse
+		if json.Unmarshal(apiErr.RawBody, &r) == nil {
+			apiErr.Message = r.Message
+			apiErr.Errors = r.Errors
+		}
+	}
+
+	return resp, err
+}
+
+// ChangeEvent represents a change event, e.g. a deploy, sent to the Events
+// API V2. Summary is required.
+type ChangeEvent struct {
+	Summary       string
+	Source        string
+	Timestamp     time.Time
+	CustomDetails interface{}
+	Links         []*V2Link
+}
+
+type changeEventPayload struct {
+	Summary       string      `json:"summary"`
+	Source        string      `json:"source,omitempty"`
+	Timestamp     string      `json:"timestamp,omitempty"`
+	CustomDetails interface{} `json:"custom_details,omitempty"`
+}
+
+type changeEventRequest struct {
+	RoutingKey string              `json:"routing_key"`
+	Payload    *changeEventPayload `json:"payload"`
+	Links      []*V2Link           `json:"links,omitempty"`
+}
+
+// SendChangeEvent sends a change event with the given routing key. The
+// timestamp is sent in RFC 3339 format, or left for PagerDuty to set when
+// zero. The returned message ID identifies the change event.
+func (s *EventsService) SendChangeEvent(routingKey string, event *ChangeEvent) (string, *Response, error) {
+	return s.SendChangeEventContext(context.Background(), routingKey, event)
+}
+
+// SendChangeEventContext sends a change event with the given routing key.
+// The timestamp is sent in RFC 3339 format, or left for PagerDuty to set
+// when zero. The returned message ID identifies the change event.
+func (s *EventsService) SendChangeEventContext(ctx context.Context, routingKey string, event *ChangeEvent) (string, *Response, error) {
+	if routingKey == "" {
+		return "", nil, errors.New("a routing key is required to send a change event")
+	}
+	if event == nil || event.Summary == "" {
+		return "", nil, errors.New("a change event requires a summary")
+	}
+
+	payload := &changeEventPayload{
+		Summary:       event.Summary,
+		Source:        event.Source,
+		CustomDetails: event.CustomDetails,
+	}
+	if !event.Timestamp.IsZero() {
+		payload.Timestamp = event.Timestamp.Format(time.RFC3339)
+	}
+
+	v := new(V2EventResponse)
+	resp, err := s.enqueueContext(ctx, "/v2/change/enqueue", &changeEventRequest{
+		RoutingKey: routingKey,
+		Payload:    payload,
+		Links:      event.Links,
+	}, v)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return v.DedupKey, resp, nil
+}
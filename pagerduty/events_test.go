@@ -0,0 +1,179 @@
+package pagerduty
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEventsEnqueueEvent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/enqueue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Authorization", "")
+		testBody(t, r, `{"routing_key":"R0UT1NGK3Y","event_action":"trigger","dedup_key":"srv01/HTTP","payload":{"summary":"Example alert on host1.example.com","source":"monitoringtool:cloudvendor:central-region-dc-01:852559987:cluster/api-stats-prod-003","severity":"info","component":"postgres","class":"deploy","custom_details":{"ping time":"1500ms"}},"images":[{"src":"https://www.pagerduty.com/wp-content/uploads/2016/05/pagerduty-logo-green.png"}],"links":[{"href":"https://example.com/","text":"Link text"}]}`)
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"status": "success", "message": "Event processed", "dedup_key": "srv01/HTTP"}`))
+	})
+
+	event := &V2Event{
+		EventAction: EventActionTrigger,
+		DedupKey:    "srv01/HTTP",
+		Payload: &V2Payload{
+			Summary:       "Example alert on host1.example.com",
+			Source:        "monitoringtool:cloudvendor:central-region-dc-01:852559987:cluster/api-stats-prod-003",
+			Severity:      "info",
+			Component:     "postgres",
+			Class:         "deploy",
+			CustomDetails: map[string]string{"ping time": "1500ms"},
+		},
+		Images: []*V2Image{{Src: "https://www.pagerduty.com/wp-content/uploads/2016/05/pagerduty-logo-green.png"}},
+		Links:  []*V2Link{{Href: "https://example.com/", Text: "Link text"}},
+	}
+
+	resp, _, err := client.Events.EnqueueEvent("R0UT1NGK3Y", event)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &V2EventResponse{Status: "success", Message: "Event processed", DedupKey: "srv01/HTTP"}
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+	if event.RoutingKey != "" {
+		t.Error("the event passed in was modified")
+	}
+}
+
+func TestEventsEnqueueEventValidation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cases := []struct {
+		name       string
+		routingKey string
+		event      *V2Event
+	}{
+		{"no routing key", "", &V2Event{EventAction: EventActionResolve, DedupKey: "1"}},
+		{"no event", "R0UT1NGK3Y", nil},
+		{"unknown action", "R0UT1NGK3Y", &V2Event{EventAction: "escalate"}},
+		{"trigger without payload", "R0UT1NGK3Y", &V2Event{EventAction: EventActionTrigger}},
+		{"trigger without severity", "R0UT1NGK3Y", &V2Event{EventAction: EventActionTrigger, Payload: &V2Payload{Summary: "s", Source: "s"}}},
+		{"resolve without dedup key", "R0UT1NGK3Y", &V2Event{EventAction: EventActionResolve}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, _, err := client.Events.EnqueueEvent(tc.routingKey, tc.event); err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+		})
+	}
+}
+
+func TestEventsEnqueueEventInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/enqueue", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"status": "invalid event", "message": "Event object is invalid", "errors": ["'payload.severity' is invalid"]}`))
+	})
+
+	_, _, err := client.Events.EnqueueEvent("R0UT1NGK3Y", &V2Event{EventAction: EventActionAcknowledge, DedupKey: "1"})
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("expected an API error, got %v", err)
+	}
+	if apiErr.Message != "Event object is invalid" || !reflect.DeepEqual(apiErr.Errors, []string{"'payload.severity' is invalid"}) {
+		t.Errorf("unexpected API error %#v", apiErr)
+	}
+}
+
+func TestEventsEnqueueEventRetries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	useFakeClock(client)
+	client.Config.RetryServerErrors = true
+
+	calls := 0
+	mux.HandleFunc("/v2/enqueue", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		testHeader(t, r, "Authorization", "")
+		switch calls {
+		case 1:
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"status": "success", "dedup_key": "1"}`))
+		}
+	})
+
+	if _, _, err := client.Events.EnqueueEvent("R0UT1NGK3Y", &V2Event{EventAction: EventActionResolve, DedupKey: "1"}); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 {
+		t.Errorf("calls = %d, want 3", calls)
+	}
+}
+
+func TestEventsEnqueueEventRateLimited(t *testing.T) {
+	setup()
+	defer teardown()
+
+	useFakeClock(client)
+
+	mux.HandleFunc("/v2/enqueue", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, _, err := client.Events.EnqueueEvent("R0UT1NGK3Y", &V2Event{EventAction: EventActionResolve, DedupKey: "1"})
+	if !IsRateLimited(err) {
+		t.Errorf("expected a rate limit error, got %v", err)
+	}
+}
+
+func TestEventsSendChangeEvent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/change/enqueue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Authorization", "")
+		testBody(t, r, `{"routing_key":"R0UT1NGK3Y","payload":{"summary":"Build Success: Increase snapshot create timeout to 30 seconds","source":"acme-build-pipeline-tool-default-i-9999","timestamp":"2020-07-17T08:42:58Z","custom_details":{"build_state":"passed"}},"links":[{"href":"https://acme.pagerduty.dev/build/2","text":"View more details in Acme!"}]}`)
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"status": "success", "message": "Change event processed", "dedup_key": "2b2e2c4d7a1f4b4a9a0c1e8a3f2d1c0b"}`))
+	})
+
+	id, _, err := client.Events.SendChangeEvent("R0UT1NGK3Y", &ChangeEvent{
+		Summary:       "Build Success: Increase snapshot create timeout to 30 seconds",
+		Source:        "acme-build-pipeline-tool-default-i-9999",
+		Timestamp:     time.Date(2020, 7, 17, 10, 42, 58, 0, time.FixedZone("CEST", 2*60*60)).UTC(),
+		CustomDetails: map[string]string{"build_state": "passed"},
+		Links:         []*V2Link{{Href: "https://acme.pagerduty.dev/build/2", Text: "View more details in Acme!"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "2b2e2c4d7a1f4b4a9a0c1e8a3f2d1c0b" {
+		t.Errorf("message ID = %q, want %q", id, "2b2e2c4d7a1f4b4a9a0c1e8a3f2d1c0b")
+	}
+}
+
+func TestEventsSendChangeEventValidation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if _, _, err := client.Events.SendChangeEvent("", &ChangeEvent{Summary: "deploy"}); err == nil {
+		t.Error("expected an error for a missing routing key, got nil")
+	}
+	if _, _, err := client.Events.SendChangeEvent("R0UT1NGK3Y", &ChangeEvent{Source: "ci"}); err == nil {
+		t.Error("expected an error for a missing summary, got nil")
+	}
+}
@@ -1,6 +1,9 @@
 package pagerduty
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // ExtensionService handles the communication with extension related methods
 // of the PagerDuty API.
@@ -47,10 +50,15 @@ type ExtensionPayload struct {
 
 // List lists existing extensions.
 func (s *ExtensionService) List(o *ListExtensionsOptions) (*ListExtensionsResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists existing extensions.
+func (s *ExtensionService) ListContext(ctx context.Context, o *ListExtensionsOptions) (*ListExtensionsResponse, *Response, error) {
 	u := "/extensions"
 	v := new(ListExtensionsResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/extensions"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -60,10 +68,15 @@ func (s *ExtensionService) List(o *ListExtensionsOptions) (*ListExtensionsRespon
 
 // Create creates a new extension.
 func (s *ExtensionService) Create(extension *Extension) (*Extension, *Response, error) {
+	return s.CreateContext(context.Background(), extension)
+}
+
+// CreateContext creates a new extension.
+func (s *ExtensionService) CreateContext(ctx context.Context, extension *Extension) (*Extension, *Response, error) {
 	u := "/extensions"
 	v := new(ExtensionPayload)
 
-	resp, err := s.client.newRequestDo("POST", u, nil, &ExtensionPayload{Extension: extension}, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &ExtensionPayload{Extension: extension}, v, WithRoute("/extensions"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -73,16 +86,26 @@ func (s *ExtensionService) Create(extension *Extension) (*Extension, *Response,
 
 // Delete removes an existing extension.
 func (s *ExtensionService) Delete(id string) (*Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext removes an existing extension.
+func (s *ExtensionService) DeleteContext(ctx context.Context, id string) (*Response, error) {
 	u := fmt.Sprintf("/extensions/%s", id)
-	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/extensions/{id}"))
 }
 
 // Get retrieves information about an extension.
 func (s *ExtensionService) Get(id string) (*Extension, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext retrieves information about an extension.
+func (s *ExtensionService) GetContext(ctx context.Context, id string) (*Extension, *Response, error) {
 	u := fmt.Sprintf("/extensions/%s", id)
 	v := new(ExtensionPayload)
 
-	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/extensions/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -92,9 +115,14 @@ func (s *ExtensionService) Get(id string) (*Extension, *Response, error) {
 
 // Update updates an existing extension.
 func (s *ExtensionService) Update(id string, extension *Extension) (*Extension, *Response, error) {
+	return s.UpdateContext(context.Background(), id, extension)
+}
+
+// UpdateContext updates an existing extension.
+func (s *ExtensionService) UpdateContext(ctx context.Context, id string, extension *Extension) (*Extension, *Response, error) {
 	u := fmt.Sprintf("/extensions/%s", id)
 	v := new(ExtensionPayload)
-	resp, err := s.client.newRequestDo("PUT", u, nil, &ExtensionPayload{Extension: extension}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &ExtensionPayload{Extension: extension}, &v, WithRoute("/extensions/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
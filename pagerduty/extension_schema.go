@@ -1,6 +1,9 @@
 package pagerduty
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // ExtensionSchemaService handles the communication with extension schemas related methods
 // of the PagerDuty API.
@@ -47,10 +50,15 @@ type ExtensionSchemaPayload struct {
 
 // List lists extension schemas.
 func (s *ExtensionSchemaService) List(o *ListExtensionSchemasOptions) (*ListExtensionSchemasResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists extension schemas.
+func (s *ExtensionSchemaService) ListContext(ctx context.Context, o *ListExtensionSchemasOptions) (*ListExtensionSchemasResponse, *Response, error) {
 	u := "/extension_schemas"
 	v := new(ListExtensionSchemasResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/extension_schemas"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -60,10 +68,15 @@ func (s *ExtensionSchemaService) List(o *ListExtensionSchemasOptions) (*ListExte
 
 // Get retrieves information about an extension schema.
 func (s *ExtensionSchemaService) Get(id string) (*ExtensionSchema, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext retrieves information about an extension schema.
+func (s *ExtensionSchemaService) GetContext(ctx context.Context, id string) (*ExtensionSchema, *Response, error) {
 	u := fmt.Sprintf("/extension_schemas/%s", id)
 	v := new(ExtensionSchemaPayload)
 
-	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/extension_schemas/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
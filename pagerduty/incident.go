@@ -1,8 +1,10 @@
 package pagerduty
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
-	"log"
+	"net/http"
 )
 
 // IncidentService handles the communication with incident
@@ -34,6 +36,31 @@ type Incident struct {
 	EscalationPolicy     *EscalationPolicyReference  `json:"escalation_policy,omitempty"`
 	Teams                []*TeamReference            `json:"teams,omitempty"`
 	Urgency              string                      `json:"urgency,omitempty"`
+	EscalationLevel      int                         `json:"escalation_level,omitempty"`
+	Priority             *PriorityReference          `json:"priority,omitempty"`
+	Body                 *IncidentBody               `json:"body,omitempty"`
+	ConferenceBridge     *ConferenceBridge           `json:"conference_bridge,omitempty"`
+	ResolveReason        *ResolveReason              `json:"resolve_reason,omitempty"`
+	IncidentType         *IncidentTypeReference      `json:"incident_type,omitempty"`
+}
+
+// IncidentBody represents the details of an incident.
+type IncidentBody struct {
+	Type    string `json:"type,omitempty"`
+	Details string `json:"details,omitempty"`
+}
+
+// ConferenceBridge represents the conference bridge of an incident.
+type ConferenceBridge struct {
+	ConferenceNumber string `json:"conference_number,omitempty"`
+	ConferenceURL    string `json:"conference_url,omitempty"`
+}
+
+// ResolveReason represents the reason an incident was resolved, such as
+// being merged into another incident.
+type ResolveReason struct {
+	Type     string             `json:"type,omitempty"`
+	Incident *IncidentReference `json:"incident,omitempty"`
 }
 
 type AlertCounts struct {
@@ -48,12 +75,12 @@ type PendingAction struct {
 }
 
 type IncidentAssignment struct {
-	At       string        `json:"at"`
+	At       string        `json:"at,omitempty"`
 	Assignee UserReference `json:"assignee"`
 }
 
 type IncidentAcknowledgement struct {
-	At           string                     `json:"at"`
+	At           string                     `json:"at,omitempty"`
 	Acknowledger IncidentAttributeReference `json:"acknowledger"`
 }
 
@@ -62,16 +89,77 @@ type IncidentPayload struct {
 	Incident *Incident `json:"incident,omitempty"`
 }
 
+// CreateIncident represents an incident to create with
+// IncidentService.Create. Title and Service are required. The incident is
+// routed either through the EscalationPolicy or to the Assignments given,
+// not both. IncidentKey deduplicates incidents of the same service.
+// IncidentType, referenced by name, creates an incident of a custom
+// incident type.
+type CreateIncident struct {
+	Title            string                     `json:"title,omitempty"`
+	IncidentKey      string                     `json:"incident_key,omitempty"`
+	Service          *ServiceReference          `json:"service,omitempty"`
+	EscalationPolicy *EscalationPolicyReference `json:"escalation_policy,omitempty"`
+	Assignments      []*IncidentAssignment      `json:"assignments,omitempty"`
+	Urgency          string                     `json:"urgency,omitempty"`
+	Priority         *PriorityReference         `json:"priority,omitempty"`
+	Body             *IncidentBody              `json:"body,omitempty"`
+	ConferenceBridge *ConferenceBridge          `json:"conference_bridge,omitempty"`
+	IncidentType     *IncidentTypeReference     `json:"incident_type,omitempty"`
+}
+
+// createIncidentPayload represents an incident to create.
+type createIncidentPayload struct {
+	Incident *createIncident `json:"incident"`
+}
+
+type createIncident struct {
+	Type string `json:"type"`
+	*CreateIncident
+}
+
 // ManageIncidentsPayload represents a payload with a list of incidents data.
 type ManageIncidentsPayload struct {
-	Incidents []*Incident `json:"incidents,omitempty"`
+	Incidents []*ManageIncident `json:"incidents,omitempty"`
+}
+
+// ManageIncident represents the changes to an existing incident, made with
+// IncidentService.ManageIncidents or Update. Only the fields set are
+// changed. ID identifies the incident in ManageIncidents.
+type ManageIncident struct {
+	ID               string                     `json:"id,omitempty"`
+	Status           string                     `json:"status,omitempty"`
+	Resolution       string                     `json:"resolution,omitempty"`
+	Title            string                     `json:"title,omitempty"`
+	Urgency          string                     `json:"urgency,omitempty"`
+	Priority         *PriorityReference         `json:"priority,omitempty"`
+	EscalationLevel  int                        `json:"escalation_level,omitempty"`
+	EscalationPolicy *EscalationPolicyReference `json:"escalation_policy,omitempty"`
+	Assignments      []*IncidentAssignment      `json:"assignments,omitempty"`
+	ConferenceBridge *ConferenceBridge          `json:"conference_bridge,omitempty"`
 }
 
-// ListIncidentsOptions represents options when listing incidents.
+// MarshalJSON encodes the changes as an incident reference, as expected by
+// the API.
+func (i ManageIncident) MarshalJSON() ([]byte, error) {
+	type manageIncident ManageIncident
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		manageIncident
+	}{"incident_reference", manageIncident(i)})
+}
+
+// manageIncidentPayload represents the changes to a single incident.
+type manageIncidentPayload struct {
+	Incident *ManageIncident `json:"incident"`
+}
+
+// ListIncidentsOptions represents options when listing incidents. DateRange
+// "all" ignores Since and Until, Include expands e.g. "acknowledgers",
+// "assignees" or "first_trigger_log_entries" in the returned incidents.
 type ListIncidentsOptions struct {
-	Limit       int      `url:"limit,omitempty"`
-	Offset      int      `url:"offset,omitempty"`
-	Total       int      `url:"total,omitempty"`
+	ListOptions
+
 	DateRange   string   `url:"date_range,omitempty"`
 	IncidentKey string   `url:"incident_key,omitempty"`
 	Include     []string `url:"include,omitempty,brackets"`
@@ -86,6 +174,12 @@ type ListIncidentsOptions struct {
 	UserIDs     []string `url:"user_ids,omitempty,brackets"`
 }
 
+// GetIncidentOptions represents options when retrieving an incident. Include
+// expands e.g. "assignees", "acknowledgers" or "conference_bridge".
+type GetIncidentOptions struct {
+	Include []string `url:"include,omitempty,brackets"`
+}
+
 // ManageIncidentsOptions represents options when listing incidents.
 type ManageIncidentsOptions struct {
 	Limit  int `url:"limit,omitempty"`
@@ -95,10 +189,7 @@ type ManageIncidentsOptions struct {
 
 // ListIncidentsResponse represents a list response of incidents.
 type ListIncidentsResponse struct {
-	Limit     int         `json:"limit,omitempty"`
-	More      bool        `json:"more,omitempty"`
-	Offset    int         `json:"offset,omitempty"`
-	Total     int         `json:"total,omitempty"`
+	PaginationMeta
 	Incidents []*Incident `json:"incidents,omitempty"`
 }
 
@@ -106,10 +197,15 @@ type ManageIncidentsResponse ListIncidentsResponse
 
 // List lists existing incidents.
 func (s *IncidentService) List(o *ListIncidentsOptions) (*ListIncidentsResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists existing incidents.
+func (s *IncidentService) ListContext(ctx context.Context, o *ListIncidentsOptions) (*ListIncidentsResponse, *Response, error) {
 	u := "/incidents"
 	v := new(ListIncidentsResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/incidents"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -119,44 +215,236 @@ func (s *IncidentService) List(o *ListIncidentsOptions) (*ListIncidentsResponse,
 
 // ListAll lists all result pages for incidents list.
 func (s *IncidentService) ListAll(o *ListIncidentsOptions) ([]*Incident, error) {
-	var incidents = make([]*Incident, 0, 25)
-	more := true
-	offset := 0
-
-	for more {
-		log.Printf("==== Getting incidents at offset %d", offset)
-		v := new(ListIncidentsResponse)
-		_, err := s.client.newRequestDo("GET", "/incidents", o, nil, &v)
+	return s.ListAllContext(context.Background(), o)
+}
+
+// ListAllContext lists all result pages for incidents list. The options of
+// the caller are left untouched. Incidents listed before an error are
+// returned along with it.
+func (s *IncidentService) ListAllContext(ctx context.Context, o *ListIncidentsOptions) ([]*Incident, error) {
+	incidents := make([]*Incident, 0, 25)
+
+	it := s.IterContext(ctx, o)
+	for it.Next() {
+		incidents = append(incidents, it.Value())
+	}
+
+	return incidents, it.Err()
+}
+
+// maxManageIncidents is the maximum number of incidents PagerDuty accepts in
+// a single request to manage incidents.
+const maxManageIncidents = 250
+
+// ManageIncidents updates existing incidents on behalf of the user whose
+// email is from, sent as the From header required by PagerDuty, e.g. to
+// change their Status, Priority, EscalationLevel, Assignments or Resolution.
+// An empty from falls back to Config.DefaultFromEmail.
+//
+// More incidents than PagerDuty accepts at once are updated in several
+// requests. If some of them fail, the incidents updated by the others are
+// returned along with a *BulkError identifying the incidents that were not
+// updated.
+func (s *IncidentService) ManageIncidents(from string, incidents []*ManageIncident, o *ManageIncidentsOptions, reqOptions ...RequestOptions) (*ManageIncidentsResponse, *Response, error) {
+	return s.ManageIncidentsContext(context.Background(), from, incidents, o, reqOptions...)
+}
+
+// ManageIncidentsContext updates existing incidents on behalf of the user
+// whose email is from, sent as the From header required by PagerDuty, e.g.
+// to change their Status, Priority, EscalationLevel, Assignments or
+// Resolution. An empty from falls back to Config.DefaultFromEmail.
+//
+// More incidents than PagerDuty accepts at once are updated in several
+// requests. If some of them fail, the incidents updated by the others are
+// returned along with a *BulkError identifying the incidents that were not
+// updated.
+func (s *IncidentService) ManageIncidentsContext(ctx context.Context, from string, incidents []*ManageIncident, o *ManageIncidentsOptions, reqOptions ...RequestOptions) (*ManageIncidentsResponse, *Response, error) {
+	reqOptions, err := s.client.fromOptions("managing incidents", from, reqOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u := "/incidents"
+	result := new(ManageIncidentsResponse)
+	var resp *Response
+
+	err = updateInChunks(incidents, maxManageIncidents, func(i *ManageIncident) string { return i.ID }, func(chunk []*ManageIncident) error {
+		v := new(ManageIncidentsResponse)
+		r, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, o, &ManageIncidentsPayload{Incidents: chunk}, &v, withRouteOptions("/incidents", reqOptions)...)
 		if err != nil {
-			return incidents, err
+			return err
 		}
-		incidents = append(incidents, v.Incidents...)
-		more = v.More
-		offset += v.Limit
-		o.Offset = offset
+		resp = r
+		result.Incidents = append(result.Incidents, v.Incidents...)
+		return nil
+	})
+	if err != nil {
+		return result, resp, err
 	}
-	return incidents, nil
+
+	return result, resp, nil
 }
 
-// ManageIncidents updates existing incidents.
-func (s *IncidentService) ManageIncidents(incidents []*Incident, o *ManageIncidentsOptions) (*ManageIncidentsResponse, *Response, error) {
-	u := "/incidents"
-	v := new(ManageIncidentsResponse)
+// Update updates an existing incident on behalf of the user whose email is
+// from, sent as the From header required by PagerDuty, e.g. to change its
+// Status, Priority, EscalationLevel, Assignments or Resolution. An empty from
+// falls back to Config.DefaultFromEmail.
+func (s *IncidentService) Update(id, from string, incident *ManageIncident, reqOptions ...RequestOptions) (*Incident, *Response, error) {
+	return s.UpdateContext(context.Background(), id, from, incident, reqOptions...)
+}
 
-	resp, err := s.client.newRequestDo("PUT", u, o, &ManageIncidentsPayload{Incidents: incidents}, &v)
+// UpdateContext updates an existing incident on behalf of the user whose
+// email is from, sent as the From header required by PagerDuty, e.g. to
+// change its Status, Priority, EscalationLevel, Assignments or Resolution.
+// An empty from falls back to Config.DefaultFromEmail.
+func (s *IncidentService) UpdateContext(ctx context.Context, id, from string, incident *ManageIncident, reqOptions ...RequestOptions) (*Incident, *Response, error) {
+	reqOptions, err := s.client.fromOptions("updating an incident", from, reqOptions)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	u := fmt.Sprintf("/incidents/%s", id)
+	v := new(IncidentPayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &manageIncidentPayload{Incident: incident}, &v, withRouteOptions("/incidents/{id}", reqOptions)...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Incident, resp, nil
+}
+
+// Acknowledge acknowledges an incident on behalf of the user whose email is
+// from, sent as the From header required by PagerDuty, returning the updated
+// incident. An empty from falls back to Config.DefaultFromEmail. If the
+// incident is already resolved, the error is a *ConflictError.
+func (s *IncidentService) Acknowledge(from, id string, reqOptions ...RequestOptions) (*Incident, *Response, error) {
+	return s.AcknowledgeContext(context.Background(), from, id, reqOptions...)
+}
+
+// AcknowledgeContext acknowledges an incident on behalf of the user whose
+// email is from, sent as the From header required by PagerDuty, returning
+// the updated incident. An empty from falls back to Config.DefaultFromEmail.
+// If the incident is already resolved, the error is a *ConflictError.
+func (s *IncidentService) AcknowledgeContext(ctx context.Context, from, id string, reqOptions ...RequestOptions) (*Incident, *Response, error) {
+	return s.setStatusContext(ctx, from, id, &ManageIncident{Status: "acknowledged"}, reqOptions)
+}
+
+// Resolve resolves an incident with an optional resolution note on behalf of
+// the user whose email is from, sent as the From header required by
+// PagerDuty, returning the updated incident. An empty from falls back to
+// Config.DefaultFromEmail. If the incident is already resolved, the error is
+// a *ConflictError, which callers can treat as success.
+func (s *IncidentService) Resolve(from, id, resolution string, reqOptions ...RequestOptions) (*Incident, *Response, error) {
+	return s.ResolveContext(context.Background(), from, id, resolution, reqOptions...)
+}
+
+// ResolveContext resolves an incident with an optional resolution note on
+// behalf of the user whose email is from, sent as the From header required
+// by PagerDuty, returning the updated incident. An empty from falls back to
+// Config.DefaultFromEmail. If the incident is already resolved, the error is
+// a *ConflictError, which callers can treat as success.
+func (s *IncidentService) ResolveContext(ctx context.Context, from, id, resolution string, reqOptions ...RequestOptions) (*Incident, *Response, error) {
+	return s.setStatusContext(ctx, from, id, &ManageIncident{Status: "resolved", Resolution: resolution}, reqOptions)
+}
+
+// setStatusContext updates the status of an incident, reporting an incident
+// that is already resolved with a *ConflictError.
+func (s *IncidentService) setStatusContext(ctx context.Context, from, id string, incident *ManageIncident, reqOptions []RequestOptions) (*Incident, *Response, error) {
+	v, resp, err := s.UpdateContext(ctx, id, from, incident, reqOptions...)
+	if err != nil {
+		if hasStatusCode(err, http.StatusConflict) || hasErrorMessage(err, "Incident Already Resolved") {
+			err = &ConflictError{ID: id, Err: err}
+		}
+		return nil, resp, err
+	}
+
 	return v, resp, nil
 }
 
-// Create an incident
-func (s *IncidentService) Create(incident *Incident) (*Incident, *Response, error) {
+// Escalate escalates an incident to the given level of its escalation
+// policy, starting at 1, on behalf of the user whose email is from, sent as
+// the From header required by PagerDuty, returning the updated incident. An
+// empty from falls back to Config.DefaultFromEmail.
+func (s *IncidentService) Escalate(from, id string, level int, reqOptions ...RequestOptions) (*Incident, *Response, error) {
+	return s.EscalateContext(context.Background(), from, id, level, reqOptions...)
+}
+
+// EscalateContext escalates an incident to the given level of its
+// escalation policy, starting at 1, on behalf of the user whose email is
+// from, sent as the From header required by PagerDuty, returning the
+// updated incident. An empty from falls back to Config.DefaultFromEmail.
+func (s *IncidentService) EscalateContext(ctx context.Context, from, id string, level int, reqOptions ...RequestOptions) (*Incident, *Response, error) {
+	if level < 1 {
+		return nil, nil, fmt.Errorf("invalid escalation level %d, levels start at 1", level)
+	}
+
+	return s.UpdateContext(ctx, id, from, &ManageIncident{EscalationLevel: level}, reqOptions...)
+}
+
+// Reassign assigns an incident to the users with the given IDs instead of
+// its current assignees, on behalf of the user whose email is from, sent as
+// the From header required by PagerDuty, returning the updated incident. An
+// empty from falls back to Config.DefaultFromEmail.
+func (s *IncidentService) Reassign(from, id string, userIDs []string, reqOptions ...RequestOptions) (*Incident, *Response, error) {
+	return s.ReassignContext(context.Background(), from, id, userIDs, reqOptions...)
+}
+
+// ReassignContext assigns an incident to the users with the given IDs
+// instead of its current assignees, on behalf of the user whose email is
+// from, sent as the From header required by PagerDuty, returning the
+// updated incident. An empty from falls back to Config.DefaultFromEmail.
+func (s *IncidentService) ReassignContext(ctx context.Context, from, id string, userIDs []string, reqOptions ...RequestOptions) (*Incident, *Response, error) {
+	if len(userIDs) == 0 {
+		return nil, nil, fmt.Errorf("at least one user is required to reassign an incident")
+	}
+
+	assignments := make([]*IncidentAssignment, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if userID == "" {
+			return nil, nil, fmt.Errorf("an empty user ID was provided to reassign an incident")
+		}
+		assignments = append(assignments, &IncidentAssignment{Assignee: UserReference{ID: userID, Type: "user_reference"}})
+	}
+
+	return s.UpdateContext(ctx, id, from, &ManageIncident{Assignments: assignments}, reqOptions...)
+}
+
+// Create creates an incident on behalf of the user whose email is from, sent
+// as the From header required by PagerDuty. An empty from falls back to
+// Config.DefaultFromEmail.
+//
+// The returned incident holds the ID and IncidentNumber of the new incident.
+// An invalid incident results in an *APIError listing the problems in its
+// Errors field.
+func (s *IncidentService) Create(from string, incident *CreateIncident, reqOptions ...RequestOptions) (*Incident, *Response, error) {
+	return s.CreateContext(context.Background(), from, incident, reqOptions...)
+}
+
+// CreateContext creates an incident on behalf of the user whose email is
+// from, sent as the From header required by PagerDuty. An empty from falls
+// back to Config.DefaultFromEmail.
+func (s *IncidentService) CreateContext(ctx context.Context, from string, incident *CreateIncident, reqOptions ...RequestOptions) (*Incident, *Response, error) {
+	reqOptions, err := s.client.fromOptions("creating an incident", from, reqOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+	if incident == nil {
+		return nil, nil, fmt.Errorf("an incident is required")
+	}
+	if incident.EscalationPolicy != nil && len(incident.Assignments) > 0 {
+		return nil, nil, fmt.Errorf("an incident can be created with an escalation policy or assignments, not both")
+	}
+
+	if incident.IncidentType != nil {
+		reqOptions = append(reqOptions[:len(reqOptions):len(reqOptions)], WithEarlyAccess(incidentTypesEarlyAccess))
+	}
+
 	u := "/incidents"
+	p := &createIncidentPayload{Incident: &createIncident{Type: "incident", CreateIncident: incident}}
 	v := new(IncidentPayload)
 
-	resp, err := s.client.newRequestDo("POST", u, nil, &IncidentPayload{Incident: incident}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, p, &v, withRouteOptions("/incidents", reqOptions)...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -164,15 +452,93 @@ func (s *IncidentService) Create(incident *Incident) (*Incident, *Response, erro
 	return v.Incident, resp, nil
 }
 
-// Get retrieves information about an incident.
-func (s *IncidentService) Get(id string) (*Incident, *Response, error) {
+// Get retrieves information about an incident, identified by its ID or its
+// incident number.
+func (s *IncidentService) Get(id string, o *GetIncidentOptions) (*Incident, *Response, error) {
+	return s.GetContext(context.Background(), id, o)
+}
+
+// GetContext retrieves information about an incident, identified by its ID
+// or its incident number.
+func (s *IncidentService) GetContext(ctx context.Context, id string, o *GetIncidentOptions) (*Incident, *Response, error) {
 	u := fmt.Sprintf("/incidents/%s", id)
 	v := new(IncidentPayload)
 
-	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/incidents/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
 
 	return v.Incident, resp, nil
 }
+
+type listIncidentsOptionsGen struct {
+	options *ListIncidentsOptions
+}
+
+func (o *listIncidentsOptionsGen) currentOffset() int {
+	return o.options.Offset
+}
+
+func (o *listIncidentsOptionsGen) changeOffset(i int) {
+	o.options.Offset = i
+}
+
+func (o *listIncidentsOptionsGen) buildStruct() interface{} {
+	return o.options
+}
+
+// ListPages lists existing incidents, calling fn with every page of results until
+// there are no more pages or fn returns an error.
+func (s *IncidentService) ListPages(o *ListIncidentsOptions, fn func([]*Incident) error) error {
+	return s.ListPagesContext(context.Background(), o, fn)
+}
+
+// ListPagesContext lists existing incidents, calling fn with every page of results until
+// there are no more pages or fn returns an error.
+func (s *IncidentService) ListPagesContext(ctx context.Context, o *ListIncidentsOptions, fn func([]*Incident) error) error {
+	opts := ListIncidentsOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	responseHandler := func(response *Response) (PaginationMeta, *Response, error) {
+		var result ListIncidentsResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return PaginationMeta{}, response, err
+		}
+
+		if err := fn(result.Incidents); err != nil {
+			return PaginationMeta{}, response, err
+		}
+
+		return result.PaginationMeta, response, nil
+	}
+
+	return s.client.newRequestPagedGetQueryDoContext(ctx, "/incidents", responseHandler, &listIncidentsOptionsGen{options: &opts}, WithRoute("/incidents"))
+}
+
+// Iter returns an Iterator over existing incidents, which requests pages of
+// results as they are needed.
+func (s *IncidentService) Iter(o *ListIncidentsOptions) *Iterator[*Incident] {
+	return s.IterContext(context.Background(), o)
+}
+
+// IterContext returns an Iterator over existing incidents, which requests
+// pages of results as they are needed.
+func (s *IncidentService) IterContext(ctx context.Context, o *ListIncidentsOptions) *Iterator[*Incident] {
+	opts := ListIncidentsOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	return Iterate(opts.ListOptions, func(lo ListOptions) ([]*Incident, PaginationMeta, *Response, error) {
+		opts.ListOptions = lo
+		v, resp, err := s.ListContext(ctx, &opts)
+		if err != nil {
+			return nil, PaginationMeta{}, resp, err
+		}
+		return v.Incidents, v.PaginationMeta, resp, nil
+	})
+}
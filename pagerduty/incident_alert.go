@@ -0,0 +1,178 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Alert represents an alert of an incident.
+type Alert struct {
+	ID                   string                      `json:"id,omitempty"`
+	Type                 string                      `json:"type,omitempty"`
+	Summary              string                      `json:"summary,omitempty"`
+	Self                 string                      `json:"self,omitempty"`
+	HTMLURL              string                      `json:"html_url,omitempty"`
+	CreatedAt            string                      `json:"created_at,omitempty"`
+	Status               string                      `json:"status,omitempty"`
+	AlertKey             string                      `json:"alert_key,omitempty"`
+	Severity             string                      `json:"severity,omitempty"`
+	Suppressed           bool                        `json:"suppressed,omitempty"`
+	Service              *ServiceReference           `json:"service,omitempty"`
+	Incident             *IncidentReference          `json:"incident,omitempty"`
+	FirstTriggerLogEntry *IncidentAttributeReference `json:"first_trigger_log_entry,omitempty"`
+	Body                 *AlertBody                  `json:"body,omitempty"`
+}
+
+// AlertBody represents the body of an alert. Details holds the details of
+// the event that triggered the alert as received.
+type AlertBody struct {
+	Type    string          `json:"type,omitempty"`
+	Details json.RawMessage `json:"details,omitempty"`
+}
+
+// AlertPayload represents an alert.
+type AlertPayload struct {
+	Alert *Alert `json:"alert,omitempty"`
+}
+
+// ManageAlertsPayload represents a payload with a list of alerts data.
+type ManageAlertsPayload struct {
+	Alerts []*Alert `json:"alerts,omitempty"`
+}
+
+// ListAlertsOptions represents options when listing the alerts of an
+// incident.
+type ListAlertsOptions struct {
+	ListOptions
+
+	AlertKey string   `url:"alert_key,omitempty"`
+	Include  []string `url:"include,omitempty,brackets"`
+	SortBy   string   `url:"sort_by,omitempty"`
+	Statuses []string `url:"statuses,omitempty,brackets"`
+}
+
+// ListAlertsResponse represents a list response of alerts.
+type ListAlertsResponse struct {
+	PaginationMeta
+	Alerts []*Alert `json:"alerts,omitempty"`
+}
+
+// ManageAlertsResponse represents the alerts updated by ManageAlerts.
+type ManageAlertsResponse ListAlertsResponse
+
+// maxManageAlerts is the maximum number of alerts PagerDuty accepts in a
+// single request to manage alerts.
+const maxManageAlerts = 250
+
+// ListAlerts lists the alerts of an incident.
+func (s *IncidentService) ListAlerts(incidentID string, o *ListAlertsOptions) (*ListAlertsResponse, *Response, error) {
+	return s.ListAlertsContext(context.Background(), incidentID, o)
+}
+
+// ListAlertsContext lists the alerts of an incident.
+func (s *IncidentService) ListAlertsContext(ctx context.Context, incidentID string, o *ListAlertsOptions) (*ListAlertsResponse, *Response, error) {
+	u := fmt.Sprintf("/incidents/%s/alerts", incidentID)
+	v := new(ListAlertsResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/incidents/{id}/alerts"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// GetAlert retrieves information about an alert of an incident.
+func (s *IncidentService) GetAlert(incidentID, alertID string) (*Alert, *Response, error) {
+	return s.GetAlertContext(context.Background(), incidentID, alertID)
+}
+
+// GetAlertContext retrieves information about an alert of an incident.
+func (s *IncidentService) GetAlertContext(ctx context.Context, incidentID, alertID string) (*Alert, *Response, error) {
+	u := fmt.Sprintf("/incidents/%s/alerts/%s", incidentID, alertID)
+	v := new(AlertPayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/incidents/{id}/alerts/{id}"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Alert, resp, nil
+}
+
+// UpdateAlert updates an alert of an incident on behalf of the user whose
+// email is from: setting its Status to "resolved" resolves it, setting its
+// Incident moves it to that incident. An empty from falls back to
+// Config.DefaultFromEmail.
+func (s *IncidentService) UpdateAlert(from, incidentID, alertID string, alert *Alert, reqOptions ...RequestOptions) (*Alert, *Response, error) {
+	return s.UpdateAlertContext(context.Background(), from, incidentID, alertID, alert, reqOptions...)
+}
+
+// UpdateAlertContext updates an alert of an incident on behalf of the user
+// whose email is from: setting its Status to "resolved" resolves it, setting
+// its Incident moves it to that incident. An empty from falls back to
+// Config.DefaultFromEmail.
+func (s *IncidentService) UpdateAlertContext(ctx context.Context, from, incidentID, alertID string, alert *Alert, reqOptions ...RequestOptions) (*Alert, *Response, error) {
+	reqOptions, err := s.client.fromOptions("updating an alert", from, reqOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("/incidents/%s/alerts/%s", incidentID, alertID)
+	v := new(AlertPayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &AlertPayload{Alert: alert}, &v, withRouteOptions("/incidents/{id}/alerts/{id}", reqOptions)...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Alert, resp, nil
+}
+
+// ManageAlerts updates alerts of an incident on behalf of the user whose
+// email is from, see UpdateAlert. An empty from falls back to
+// Config.DefaultFromEmail.
+//
+// More alerts than PagerDuty accepts at once are updated in several
+// requests. If some of them fail, the alerts updated by the others are
+// returned along with a *BulkError identifying the alerts that were not
+// updated.
+func (s *IncidentService) ManageAlerts(from, incidentID string, alerts []*Alert, reqOptions ...RequestOptions) (*ManageAlertsResponse, *Response, error) {
+	return s.ManageAlertsContext(context.Background(), from, incidentID, alerts, reqOptions...)
+}
+
+// ManageAlertsContext updates alerts of an incident on behalf of the user
+// whose email is from, see UpdateAlert. An empty from falls back to
+// Config.DefaultFromEmail.
+//
+// More alerts than PagerDuty accepts at once are updated in several
+// requests. If some of them fail, the alerts updated by the others are
+// returned along with a *BulkError identifying the alerts that were not
+// updated.
+func (s *IncidentService) ManageAlertsContext(ctx context.Context, from, incidentID string, alerts []*Alert, reqOptions ...RequestOptions) (*ManageAlertsResponse, *Response, error) {
+	reqOptions, err := s.client.fromOptions("managing alerts", from, reqOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("/incidents/%s/alerts", incidentID)
+	result := new(ManageAlertsResponse)
+	var resp *Response
+
+	err = updateInChunks(alerts, maxManageAlerts, func(a *Alert) string { return a.ID }, func(chunk []*Alert) error {
+		v := new(ManageAlertsResponse)
+		r, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &ManageAlertsPayload{Alerts: chunk}, &v, withRouteOptions("/incidents/{id}/alerts", reqOptions)...)
+		if err != nil {
+			return err
+		}
+		resp = r
+		result.Alerts = append(result.Alerts, v.Alerts...)
+		return nil
+	})
+	if err != nil {
+		return result, resp, err
+	}
+
+	return result, resp, nil
+}
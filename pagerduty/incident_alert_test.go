@@ -0,0 +1,138 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIncidentsListAlerts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if want := "alert_key=baf7cf21b1da41b4b0221008339ff357&sort_by=created_at%3Adesc&statuses%5B%5D=triggered"; r.URL.RawQuery != want {
+			t.Errorf("query = %q, want %q", r.URL.RawQuery, want)
+		}
+		w.Write([]byte(`{"alerts": [{"id": "PT4KHLK", "type": "alert", "status": "triggered", "severity": "critical", "alert_key": "baf7cf21b1da41b4b0221008339ff357", "created_at": "2015-10-06T21:30:42Z", "service": {"id": "PIJ90N7", "type": "service_reference"}, "body": {"type": "alert_body", "details": {"customKey": "Server is on fire!", "nested": {"count": 3}}}}], "limit": 25, "more": false}`))
+	})
+
+	resp, _, err := client.Incidents.ListAlerts("PT4KHLK", &ListAlertsOptions{
+		AlertKey: "baf7cf21b1da41b4b0221008339ff357",
+		SortBy:   "created_at:desc",
+		Statuses: []string{"triggered"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListAlertsResponse{
+		PaginationMeta: PaginationMeta{Limit: 25},
+		Alerts: []*Alert{
+			{
+				ID:        "PT4KHLK",
+				Type:      "alert",
+				Status:    "triggered",
+				Severity:  "critical",
+				AlertKey:  "baf7cf21b1da41b4b0221008339ff357",
+				CreatedAt: "2015-10-06T21:30:42Z",
+				Service:   &ServiceReference{ID: "PIJ90N7", Type: "service_reference"},
+				Body: &AlertBody{
+					Type:    "alert_body",
+					Details: json.RawMessage(`{"customKey": "Server is on fire!", "nested": {"count": 3}}`),
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentsGetAlert(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK/alerts/PXPGF42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"alert": {"id": "PXPGF42", "type": "alert", "status": "resolved"}}`))
+	})
+
+	resp, _, err := client.Incidents.GetAlert("PT4KHLK", "PXPGF42")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Alert{ID: "PXPGF42", Type: "alert", Status: "resolved"}
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentsUpdateAlert(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK/alerts/PXPGF42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "From", "user@example.com")
+		testBody(t, r, `{"alert":{"type":"alert","incident":{"id":"PEYSGVF","type":"incident_reference"}}}`)
+		w.Write([]byte(`{"alert": {"id": "PXPGF42", "type": "alert", "incident": {"id": "PEYSGVF", "type": "incident_reference"}}}`))
+	})
+
+	resp, _, err := client.Incidents.UpdateAlert("user@example.com", "PT4KHLK", "PXPGF42", &Alert{
+		Type:     "alert",
+		Incident: &IncidentReference{ID: "PEYSGVF", Type: "incident_reference"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Incident == nil || resp.Incident.ID != "PEYSGVF" {
+		t.Errorf("returned %#v, want an alert moved to PEYSGVF", resp)
+	}
+}
+
+func TestIncidentsManageAlertsPartialFailure(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var input []*Alert
+	for i := 0; i < maxManageAlerts+1; i++ {
+		input = append(input, &Alert{ID: fmt.Sprintf("A%d", i), Type: "alert", Status: "resolved"})
+	}
+
+	calls := 0
+	mux.HandleFunc("/incidents/PT4KHLK/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "From", "user@example.com")
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": {"message": "Invalid Input Provided", "code": 2001}}`))
+			return
+		}
+		v := new(ManageAlertsPayload)
+		json.NewDecoder(r.Body).Decode(v)
+		json.NewEncoder(w).Encode(v)
+	})
+
+	resp, _, err := client.Incidents.ManageAlerts("user@example.com", "PT4KHLK", input)
+	var bulkErr *BulkError
+	if !errors.As(err, &bulkErr) {
+		t.Fatalf("got error %v, want a *BulkError", err)
+	}
+	if len(bulkErr.Failed) != maxManageAlerts {
+		t.Errorf("%d alerts failed, want %d", len(bulkErr.Failed), maxManageAlerts)
+	}
+	if _, ok := bulkErr.Failed["A250"]; ok {
+		t.Error("alert A250 of the successful request was reported as failed")
+	}
+	if len(resp.Alerts) != 1 || resp.Alerts[0].ID != "A250" {
+		t.Errorf("returned %#v, want alert A250", resp.Alerts)
+	}
+}
@@ -0,0 +1,116 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// businessImpactEarlyAccess is the early access feature required by the
+// business service impact endpoints.
+const businessImpactEarlyAccess = "business-impact-early-access"
+
+// Relations of a business service to an incident.
+const (
+	BusinessServiceImpacted    = "impacted"
+	BusinessServiceNotImpacted = "not_impacted"
+)
+
+// ImpactedBusinessService represents a business service impacted by an
+// incident. Status is the status of the business service, e.g. "impacted".
+type ImpactedBusinessService struct {
+	BusinessServiceReference
+	Name   string `json:"name,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+// ListImpactedBusinessServicesOptions represents options when listing the
+// business services impacted by an incident.
+type ListImpactedBusinessServicesOptions struct {
+	ListOptions
+}
+
+// ListImpactedBusinessServicesResponse represents a list response of
+// impacted business services.
+type ListImpactedBusinessServicesResponse struct {
+	PaginationMeta
+	Services []*ImpactedBusinessService `json:"services,omitempty"`
+}
+
+type businessServiceImpactPayload struct {
+	Relation string `json:"relation"`
+}
+
+// ListImpactedBusinessServices lists the business services impacted by an
+// incident.
+func (s *IncidentService) ListImpactedBusinessServices(incidentID string, o *ListImpactedBusinessServicesOptions) (*ListImpactedBusinessServicesResponse, *Response, error) {
+	return s.ListImpactedBusinessServicesContext(context.Background(), incidentID, o)
+}
+
+// ListImpactedBusinessServicesContext lists the business services impacted
+// by an incident.
+func (s *IncidentService) ListImpactedBusinessServicesContext(ctx context.Context, incidentID string, o *ListImpactedBusinessServicesOptions) (*ListImpactedBusinessServicesResponse, *Response, error) {
+	u := fmt.Sprintf("/incidents/%s/business_services/impacts", incidentID)
+	v := new(ListImpactedBusinessServicesResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithEarlyAccess(businessImpactEarlyAccess), WithRoute("/incidents/{id}/business_services/impacts"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// IterImpactedBusinessServices returns an Iterator over the business
+// services impacted by an incident, which requests pages of results as they
+// are needed.
+func (s *IncidentService) IterImpactedBusinessServices(incidentID string, o *ListImpactedBusinessServicesOptions) *Iterator[*ImpactedBusinessService] {
+	return s.IterImpactedBusinessServicesContext(context.Background(), incidentID, o)
+}
+
+// IterImpactedBusinessServicesContext returns an Iterator over the business
+// services impacted by an incident, which requests pages of results as they
+// are needed.
+func (s *IncidentService) IterImpactedBusinessServicesContext(ctx context.Context, incidentID string, o *ListImpactedBusinessServicesOptions) *Iterator[*ImpactedBusinessService] {
+	opts := ListImpactedBusinessServicesOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	return Iterate(opts.ListOptions, func(lo ListOptions) ([]*ImpactedBusinessService, PaginationMeta, *Response, error) {
+		opts.ListOptions = lo
+		v, resp, err := s.ListImpactedBusinessServicesContext(ctx, incidentID, &opts)
+		if err != nil {
+			return nil, PaginationMeta{}, resp, err
+		}
+		return v.Services, v.PaginationMeta, resp, nil
+	})
+}
+
+// AddImpactedBusinessService marks a business service as impacted by an
+// incident.
+func (s *IncidentService) AddImpactedBusinessService(incidentID, businessServiceID string) (*Response, error) {
+	return s.AddImpactedBusinessServiceContext(context.Background(), incidentID, businessServiceID)
+}
+
+// AddImpactedBusinessServiceContext marks a business service as impacted by
+// an incident.
+func (s *IncidentService) AddImpactedBusinessServiceContext(ctx context.Context, incidentID, businessServiceID string) (*Response, error) {
+	return s.setBusinessServiceImpactContext(ctx, incidentID, businessServiceID, BusinessServiceImpacted)
+}
+
+// RemoveImpactedBusinessService marks a business service as not impacted by
+// an incident.
+func (s *IncidentService) RemoveImpactedBusinessService(incidentID, businessServiceID string) (*Response, error) {
+	return s.RemoveImpactedBusinessServiceContext(context.Background(), incidentID, businessServiceID)
+}
+
+// RemoveImpactedBusinessServiceContext marks a business service as not
+// impacted by an incident.
+func (s *IncidentService) RemoveImpactedBusinessServiceContext(ctx context.Context, incidentID, businessServiceID string) (*Response, error) {
+	return s.setBusinessServiceImpactContext(ctx, incidentID, businessServiceID, BusinessServiceNotImpacted)
+}
+
+func (s *IncidentService) setBusinessServiceImpactContext(ctx context.Context, incidentID, businessServiceID, relation string) (*Response, error) {
+	u := fmt.Sprintf("/incidents/%s/business_services/%s/impacts", incidentID, businessServiceID)
+	return s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &businessServiceImpactPayload{Relation: relation}, nil, WithEarlyAccess(businessImpactEarlyAccess), WithRoute("/incidents/{id}/business_services/{id}/impacts"))
+}
@@ -0,0 +1,99 @@
+package pagerduty
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIncidentsListImpactedBusinessServices(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK/business_services/impacts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "X-Early-Access", "business-impact-early-access")
+		w.Write([]byte(`{"services": [{"id": "PD1234", "type": "business_service", "name": "Checkout", "status": "impacted"}], "limit": 25, "more": false}`))
+	})
+
+	resp, _, err := client.Incidents.ListImpactedBusinessServices("PT4KHLK", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListImpactedBusinessServicesResponse{
+		PaginationMeta: PaginationMeta{Limit: 25},
+		Services: []*ImpactedBusinessService{
+			{
+				BusinessServiceReference: BusinessServiceReference{ID: "PD1234", Type: "business_service"},
+				Name:                     "Checkout",
+				Status:                   "impacted",
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentsIterImpactedBusinessServices(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK/business_services/impacts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("offset") {
+		case "":
+			w.Write([]byte(`{"services": [{"id": "1"}], "limit": 1, "offset": 0, "more": true}`))
+		case "1":
+			w.Write([]byte(`{"services": [{"id": "2"}], "limit": 1, "offset": 1, "more": false}`))
+		default:
+			t.Errorf("unexpected offset %q", r.URL.Query().Get("offset"))
+		}
+	})
+
+	var ids []string
+	it := client.Incidents.IterImpactedBusinessServices("PT4KHLK", &ListImpactedBusinessServicesOptions{ListOptions: ListOptions{Limit: 1}})
+	for it.Next() {
+		ids = append(ids, it.Value().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"1", "2"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("got IDs %v, want %v", ids, want)
+	}
+}
+
+func TestIncidentsAddImpactedBusinessService(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK/business_services/PD1234/impacts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "X-Early-Access", "business-impact-early-access")
+		testBody(t, r, `{"relation":"impacted"}`)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Incidents.AddImpactedBusinessService("PT4KHLK", "PD1234"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIncidentsRemoveImpactedBusinessService(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK/business_services/PD1234/impacts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"relation":"not_impacted"}`)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Incidents.RemoveImpactedBusinessService("PT4KHLK", "PD1234"); err != nil {
+		t.Fatal(err)
+	}
+}
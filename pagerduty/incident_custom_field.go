@@ -131,7 +131,7 @@ func (s *IncidentCustomFieldService) ListContext(ctx context.Context, o *ListInc
 		o = &ListIncidentCustomFieldOptions{}
 	}
 
-	resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, v, WithRoute("/incidents/custom_fields"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -144,7 +144,7 @@ func (s *IncidentCustomFieldService) GetContext(ctx context.Context, id string,
 	u := fmt.Sprintf("/incidents/custom_fields/%s", id)
 	v := new(IncidentCustomFieldPayload)
 
-	resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, v, WithRoute("/incidents/custom_fields/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -157,7 +157,7 @@ func (s *IncidentCustomFieldService) CreateContext(ctx context.Context, field *I
 	u := "/incidents/custom_fields"
 	v := new(IncidentCustomFieldPayload)
 
-	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, &IncidentCustomFieldPayload{Field: field}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &IncidentCustomFieldPayload{Field: field}, &v, WithRoute("/incidents/custom_fields"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -168,7 +168,7 @@ func (s *IncidentCustomFieldService) CreateContext(ctx context.Context, field *I
 // DeleteContext removes an existing custom field.
 func (s *IncidentCustomFieldService) DeleteContext(ctx context.Context, id string) (*Response, error) {
 	u := fmt.Sprintf("/incidents/custom_fields/%s", id)
-	return s.client.newRequestDoContext(ctx, "DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/incidents/custom_fields/{id}"))
 }
 
 // UpdateContext updates an existing custom field.
@@ -176,7 +176,7 @@ func (s *IncidentCustomFieldService) UpdateContext(ctx context.Context, id strin
 	u := fmt.Sprintf("/incidents/custom_fields/%s", id)
 	v := new(IncidentCustomFieldPayload)
 
-	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, &IncidentCustomFieldPayload{Field: field}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &IncidentCustomFieldPayload{Field: field}, &v, WithRoute("/incidents/custom_fields/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
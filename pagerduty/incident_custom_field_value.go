@@ -0,0 +1,202 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// CustomFieldValue represents the value of a custom field on an incident.
+// Value holds the value as received, null when unset; use the typed
+// accessors to decode it, or NewCustomFieldValue to build one to set.
+type CustomFieldValue struct {
+	ID          string                       `json:"id,omitempty"`
+	Name        string                       `json:"name,omitempty"`
+	DisplayName string                       `json:"display_name,omitempty"`
+	Type        string                       `json:"type,omitempty"`
+	Description string                       `json:"description,omitempty"`
+	DataType    IncidentCustomFieldDataType  `json:"data_type,omitempty"`
+	FieldType   IncidentCustomFieldFieldType `json:"field_type,omitempty"`
+	Value       json.RawMessage              `json:"value,omitempty"`
+}
+
+// NewCustomFieldValue returns the value of the custom field with the given
+// name to set on an incident. value is a string, integer, float64, bool or
+// time.Time, a slice of one of these for multi-value fields, or nil to
+// clear the field.
+func NewCustomFieldValue(name string, value interface{}) (*CustomFieldValue, error) {
+	if t, ok := value.(time.Time); ok {
+		value = t.Format(time.RFC3339)
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("encoding value of custom field %q: %w", name, err)
+	}
+
+	return &CustomFieldValue{Name: name, Value: b}, nil
+}
+
+// IsNull reports whether the custom field has no value.
+func (v *CustomFieldValue) IsNull() bool {
+	return len(v.Value) == 0 || string(v.Value) == "null"
+}
+
+// StringValue returns the value of a single value string or url field.
+func (v *CustomFieldValue) StringValue() (string, error) {
+	var s string
+	err := v.decode(&s, false, IncidentCustomFieldDataTypeString, IncidentCustomFieldDataTypeUrl)
+	return s, err
+}
+
+// StringValues returns the values of a multi-value string or url field.
+func (v *CustomFieldValue) StringValues() ([]string, error) {
+	var s []string
+	err := v.decode(&s, true, IncidentCustomFieldDataTypeString, IncidentCustomFieldDataTypeUrl)
+	return s, err
+}
+
+// IntValue returns the value of a single value integer field.
+func (v *CustomFieldValue) IntValue() (int64, error) {
+	var i int64
+	err := v.decode(&i, false, IncidentCustomFieldDataTypeInt)
+	return i, err
+}
+
+// IntValues returns the values of a multi-value integer field.
+func (v *CustomFieldValue) IntValues() ([]int64, error) {
+	var i []int64
+	err := v.decode(&i, true, IncidentCustomFieldDataTypeInt)
+	return i, err
+}
+
+// FloatValue returns the value of a single value float field.
+func (v *CustomFieldValue) FloatValue() (float64, error) {
+	var f float64
+	err := v.decode(&f, false, IncidentCustomFieldDataTypeFloat)
+	return f, err
+}
+
+// FloatValues returns the values of a multi-value float field.
+func (v *CustomFieldValue) FloatValues() ([]float64, error) {
+	var f []float64
+	err := v.decode(&f, true, IncidentCustomFieldDataTypeFloat)
+	return f, err
+}
+
+// BoolValue returns the value of a boolean field.
+func (v *CustomFieldValue) BoolValue() (bool, error) {
+	var b bool
+	err := v.decode(&b, false, IncidentCustomFieldDataTypeBool)
+	return b, err
+}
+
+// DateTimeValue returns the value of a datetime field, or the zero time when
+// it has no value.
+func (v *CustomFieldValue) DateTimeValue() (time.Time, error) {
+	var s string
+	if err := v.decode(&s, false, IncidentCustomFieldDataTypeDateTime); err != nil || s == "" {
+		return time.Time{}, err
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("decoding value of custom field %q: %w", v.Name, err)
+	}
+
+	return t, nil
+}
+
+// decode decodes the value into dst, after checking the data and field types
+// of the custom field when they are known. A null value leaves dst as is.
+func (v *CustomFieldValue) decode(dst interface{}, multi bool, dataTypes ...IncidentCustomFieldDataType) error {
+	if v.DataType.IsKnown() {
+		known := false
+		for _, t := range dataTypes {
+			known = known || v.DataType == t
+		}
+		if !known {
+			return fmt.Errorf("custom field %q has data type %s, not %s", v.Name, v.DataType, dataTypes[0])
+		}
+	}
+	if v.FieldType.IsKnown() && v.FieldType.IsMultiValue() != multi {
+		return fmt.Errorf("custom field %q is a %s field", v.Name, v.FieldType)
+	}
+	if v.IsNull() {
+		return nil
+	}
+
+	if err := json.Unmarshal(v.Value, dst); err != nil {
+		return fmt.Errorf("decoding value of custom field %q: %w", v.Name, err)
+	}
+
+	return nil
+}
+
+// CustomFieldValuesPayload represents a payload with the values of the
+// custom fields of an incident.
+type CustomFieldValuesPayload struct {
+	CustomFields []*CustomFieldValue `json:"custom_fields"`
+}
+
+type setCustomFieldValue struct {
+	ID    string          `json:"id,omitempty"`
+	Name  string          `json:"name,omitempty"`
+	Value json.RawMessage `json:"value"`
+}
+
+type setCustomFieldValuesPayload struct {
+	CustomFields []*setCustomFieldValue `json:"custom_fields"`
+}
+
+// GetCustomFieldValues retrieves the values of the custom fields of an
+// incident.
+func (s *IncidentService) GetCustomFieldValues(incidentID string) ([]*CustomFieldValue, *Response, error) {
+	return s.GetCustomFieldValuesContext(context.Background(), incidentID)
+}
+
+// GetCustomFieldValuesContext retrieves the values of the custom fields of
+// an incident.
+func (s *IncidentService) GetCustomFieldValuesContext(ctx context.Context, incidentID string) ([]*CustomFieldValue, *Response, error) {
+	u := fmt.Sprintf("/incidents/%s/custom_fields/values", incidentID)
+	v := new(CustomFieldValuesPayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/incidents/{id}/custom_fields/values"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.CustomFields, resp, nil
+}
+
+// SetCustomFieldValues sets the values of the given custom fields of an
+// incident, identified by ID or name, and leaves the other fields as they
+// are. It returns the values of all the custom fields of the incident.
+func (s *IncidentService) SetCustomFieldValues(incidentID string, values []*CustomFieldValue) ([]*CustomFieldValue, *Response, error) {
+	return s.SetCustomFieldValuesContext(context.Background(), incidentID, values)
+}
+
+// SetCustomFieldValuesContext sets the values of the given custom fields of
+// an incident, identified by ID or name, and leaves the other fields as they
+// are. It returns the values of all the custom fields of the incident.
+func (s *IncidentService) SetCustomFieldValuesContext(ctx context.Context, incidentID string, values []*CustomFieldValue) ([]*CustomFieldValue, *Response, error) {
+	p := &setCustomFieldValuesPayload{CustomFields: make([]*setCustomFieldValue, 0, len(values))}
+	for _, value := range values {
+		if value.ID == "" && value.Name == "" {
+			return nil, nil, errors.New("a custom field ID or name is required to set its value")
+		}
+		p.CustomFields = append(p.CustomFields, &setCustomFieldValue{ID: value.ID, Name: value.Name, Value: value.Value})
+	}
+
+	u := fmt.Sprintf("/incidents/%s/custom_fields/values", incidentID)
+	v := new(CustomFieldValuesPayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, p, &v, WithRoute("/incidents/{id}/custom_fields/values"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.CustomFields, resp, nil
+}
@@ -0,0 +1,150 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const customFieldValuesJSON = `{"custom_fields": [
+	{"id": "P1", "name": "region", "data_type": "string", "field_type": "single_value", "value": "eu-west-1"},
+	{"id": "P2", "name": "runbook", "data_type": "url", "field_type": "single_value", "value": "https://example.com/runbook"},
+	{"id": "P3", "name": "customers", "data_type": "integer", "field_type": "single_value", "value": 42},
+	{"id": "P4", "name": "error_rate", "data_type": "float", "field_type": "single_value", "value": 0.25},
+	{"id": "P5", "name": "customer_facing", "data_type": "boolean", "field_type": "single_value", "value": true},
+	{"id": "P6", "name": "started_at", "data_type": "datetime", "field_type": "single_value", "value": "2023-04-01T12:30:00Z"},
+	{"id": "P7", "name": "components", "data_type": "string", "field_type": "multi_value_fixed", "value": ["api", "web"]},
+	{"id": "P8", "name": "ports", "data_type": "integer", "field_type": "multi_value", "value": [80, 443]},
+	{"id": "P9", "name": "weights", "data_type": "float", "field_type": "multi_value", "value": [0.5, 1.5]},
+	{"id": "P10", "name": "owner", "data_type": "string", "field_type": "single_value", "value": null}
+]}`
+
+func checkCustomFieldValues(t *testing.T, values []*CustomFieldValue) {
+	t.Helper()
+
+	if len(values) != 10 {
+		t.Fatalf("got %d values, want 10", len(values))
+	}
+
+	check := func(name string, got, want interface{}, err error) {
+		t.Helper()
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+		} else if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s = %#v, want %#v", name, got, want)
+		}
+	}
+
+	s, err := values[0].StringValue()
+	check("region", s, "eu-west-1", err)
+	s, err = values[1].StringValue()
+	check("runbook", s, "https://example.com/runbook", err)
+	i, err := values[2].IntValue()
+	check("customers", i, int64(42), err)
+	f, err := values[3].FloatValue()
+	check("error_rate", f, 0.25, err)
+	b, err := values[4].BoolValue()
+	check("customer_facing", b, true, err)
+	d, err := values[5].DateTimeValue()
+	check("started_at", d, time.Date(2023, 4, 1, 12, 30, 0, 0, time.UTC), err)
+	ss, err := values[6].StringValues()
+	check("components", ss, []string{"api", "web"}, err)
+	is, err := values[7].IntValues()
+	check("ports", is, []int64{80, 443}, err)
+	fs, err := values[8].FloatValues()
+	check("weights", fs, []float64{0.5, 1.5}, err)
+
+	if !values[9].IsNull() {
+		t.Error("owner has a value, want null")
+	}
+	s, err = values[9].StringValue()
+	check("owner", s, "", err)
+}
+
+func TestIncidentsGetCustomFieldValues(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK/custom_fields/values", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(customFieldValuesJSON))
+	})
+
+	values, _, err := client.Incidents.GetCustomFieldValues("PT4KHLK")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkCustomFieldValues(t, values)
+}
+
+func TestIncidentsSetCustomFieldValues(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK/custom_fields/values", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"custom_fields":[{"name":"region","value":"eu-west-1"},{"name":"runbook","value":"https://example.com/runbook"},{"name":"customers","value":42},{"name":"error_rate","value":0.25},{"name":"customer_facing","value":true},{"name":"started_at","value":"2023-04-01T12:30:00Z"},{"name":"components","value":["api","web"]},{"name":"ports","value":[80,443]},{"name":"weights","value":[0.5,1.5]},{"name":"owner","value":null}]}`)
+		w.Write([]byte(customFieldValuesJSON))
+	})
+
+	set := []struct {
+		name  string
+		value interface{}
+	}{
+		{"region", "eu-west-1"},
+		{"runbook", "https://example.com/runbook"},
+		{"customers", 42},
+		{"error_rate", 0.25},
+		{"customer_facing", true},
+		{"started_at", time.Date(2023, 4, 1, 12, 30, 0, 0, time.UTC)},
+		{"components", []string{"api", "web"}},
+		{"ports", []int{80, 443}},
+		{"weights", []float64{0.5, 1.5}},
+		{"owner", nil},
+	}
+
+	var values []*CustomFieldValue
+	for _, v := range set {
+		value, err := NewCustomFieldValue(v.name, v.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, value)
+	}
+
+	values, _, err := client.Incidents.SetCustomFieldValues("PT4KHLK", values)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkCustomFieldValues(t, values)
+}
+
+func TestIncidentsSetCustomFieldValuesInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, _, err := client.Incidents.SetCustomFieldValues("PT4KHLK", []*CustomFieldValue{{Value: json.RawMessage(`"eu-west-1"`)}})
+	if err == nil {
+		t.Fatal("expected an error for a value without a custom field ID or name")
+	}
+}
+
+func TestCustomFieldValueTypeMismatch(t *testing.T) {
+	v := &CustomFieldValue{
+		Name:      "customers",
+		DataType:  IncidentCustomFieldDataTypeInt,
+		FieldType: IncidentCustomFieldFieldTypeSingleValue,
+		Value:     json.RawMessage(`42`),
+	}
+
+	if _, err := v.StringValue(); err == nil {
+		t.Error("expected an error reading an integer field as a string")
+	}
+	if _, err := v.IntValues(); err == nil {
+		t.Error("expected an error reading a single value field as multiple values")
+	}
+}
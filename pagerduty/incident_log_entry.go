@@ -0,0 +1,133 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Types of the log entries of an incident.
+const (
+	LogEntryTypeTrigger     = "trigger_log_entry"
+	LogEntryTypeAcknowledge = "acknowledge_log_entry"
+	LogEntryTypeAssign      = "assign_log_entry"
+	LogEntryTypeEscalate    = "escalate_log_entry"
+	LogEntryTypeAnnotate    = "annotate_log_entry"
+	LogEntryTypeResolve     = "resolve_log_entry"
+)
+
+// LogEntry represents a log entry of an incident. Type is one of the
+// LogEntryType constants, or another type of log entry; the fields that
+// apply depend on it, e.g. Assignees for assign and escalate log entries.
+type LogEntry struct {
+	ID        string                      `json:"id,omitempty"`
+	Type      string                      `json:"type,omitempty"`
+	Summary   string                      `json:"summary,omitempty"`
+	Self      string                      `json:"self,omitempty"`
+	HTMLURL   string                      `json:"html_url,omitempty"`
+	CreatedAt string                      `json:"created_at,omitempty"`
+	Agent     *IncidentAttributeReference `json:"agent,omitempty"`
+	Channel   *LogEntryChannel            `json:"channel,omitempty"`
+	Service   *ServiceReference           `json:"service,omitempty"`
+	Incident  *IncidentReference          `json:"incident,omitempty"`
+	Teams     []*TeamReference            `json:"teams,omitempty"`
+	Assignees []*UserReference            `json:"assignees,omitempty"`
+}
+
+// LogEntryChannel represents the channel through which the action of a log
+// entry happened, e.g. "api", "web_trigger" or "email". Details holds the
+// details of the channel as received, which vary by type; they are only
+// included when requested with the "channels" include.
+type LogEntryChannel struct {
+	Type    string          `json:"type,omitempty"`
+	Summary string          `json:"summary,omitempty"`
+	Details json.RawMessage `json:"details,omitempty"`
+}
+
+// ListLogEntriesOptions represents options when listing the log entries of
+// an incident. IsOverview only lists the most important changes, Include
+// "channels" adds the details of the channels.
+type ListLogEntriesOptions struct {
+	ListOptions
+
+	Include    []string `url:"include,omitempty,brackets"`
+	IsOverview bool     `url:"is_overview,omitempty"`
+	Since      string   `url:"since,omitempty"`
+	TimeZone   string   `url:"time_zone,omitempty"`
+	Until      string   `url:"until,omitempty"`
+}
+
+// ListLogEntriesResponse represents a list response of log entries.
+type ListLogEntriesResponse struct {
+	PaginationMeta
+	LogEntries []*LogEntry `json:"log_entries,omitempty"`
+}
+
+// ListLogEntries lists the log entries of an incident.
+func (s *IncidentService) ListLogEntries(incidentID string, o *ListLogEntriesOptions) (*ListLogEntriesResponse, *Response, error) {
+	return s.ListLogEntriesContext(context.Background(), incidentID, o)
+}
+
+// ListLogEntriesContext lists the log entries of an incident.
+func (s *IncidentService) ListLogEntriesContext(ctx context.Context, incidentID string, o *ListLogEntriesOptions) (*ListLogEntriesResponse, *Response, error) {
+	u := fmt.Sprintf("/incidents/%s/log_entries", incidentID)
+	v := new(ListLogEntriesResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/incidents/{id}/log_entries"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+type listLogEntriesOptionsGen struct {
+	options *ListLogEntriesOptions
+}
+
+func (o *listLogEntriesOptionsGen) currentOffset() int {
+	return o.options.Offset
+}
+
+func (o *listLogEntriesOptionsGen) changeOffset(i int) {
+	o.options.Offset = i
+}
+
+func (o *listLogEntriesOptionsGen) buildStruct() interface{} {
+	return o.options
+}
+
+// ListAllLogEntries lists all result pages of the log entries of an
+// incident.
+func (s *IncidentService) ListAllLogEntries(incidentID string, o *ListLogEntriesOptions) ([]*LogEntry, error) {
+	return s.ListAllLogEntriesContext(context.Background(), incidentID, o)
+}
+
+// ListAllLogEntriesContext lists all result pages of the log entries of an
+// incident.
+func (s *IncidentService) ListAllLogEntriesContext(ctx context.Context, incidentID string, o *ListLogEntriesOptions) ([]*LogEntry, error) {
+	opts := ListLogEntriesOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	var logEntries []*LogEntry
+	responseHandler := func(response *Response) (PaginationMeta, *Response, error) {
+		var result ListLogEntriesResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return PaginationMeta{}, response, err
+		}
+
+		logEntries = append(logEntries, result.LogEntries...)
+
+		return result.PaginationMeta, response, nil
+	}
+
+	u := fmt.Sprintf("/incidents/%s/log_entries", incidentID)
+	if err := s.client.newRequestPagedGetQueryDoContext(ctx, u, responseHandler, &listLogEntriesOptionsGen{options: &opts}, WithRoute("/incidents/{id}/log_entries")); err != nil {
+		return nil, err
+	}
+
+	return logEntries, nil
+}
@@ -0,0 +1,94 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIncidentsListLogEntries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK/log_entries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if want := "include%5B%5D=channels&is_overview=true&since=2015-10-06T00%3A00%3A00Z&time_zone=UTC&until=2015-10-07T00%3A00%3A00Z"; r.URL.RawQuery != want {
+			t.Errorf("query = %q, want %q", r.URL.RawQuery, want)
+		}
+		w.Write([]byte(`{"log_entries": [{"id": "Q02JTSNZWHSEKV", "type": "trigger_log_entry", "summary": "Triggered through the API", "created_at": "2015-10-06T21:30:42Z", "agent": {"id": "PIJ90N7", "type": "generic_email_inbound_integration_reference"}, "channel": {"type": "api", "summary": "The server is on fire.", "details": {"ping time": "1500ms", "load avg": 0.75}}, "incident": {"id": "PT4KHLK", "type": "incident_reference"}}, {"id": "Q1IVLR2ZVCM0LA", "type": "assign_log_entry", "created_at": "2015-10-06T21:30:43Z", "channel": {"type": "auto"}, "assignees": [{"id": "PXPGF42", "type": "user_reference"}]}], "limit": 25, "more": false}`))
+	})
+
+	resp, _, err := client.Incidents.ListLogEntries("PT4KHLK", &ListLogEntriesOptions{
+		Include:    []string{"channels"},
+		IsOverview: true,
+		Since:      "2015-10-06T00:00:00Z",
+		Until:      "2015-10-07T00:00:00Z",
+		TimeZone:   "UTC",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListLogEntriesResponse{
+		PaginationMeta: PaginationMeta{Limit: 25},
+		LogEntries: []*LogEntry{
+			{
+				ID:        "Q02JTSNZWHSEKV",
+				Type:      LogEntryTypeTrigger,
+				Summary:   "Triggered through the API",
+				CreatedAt: "2015-10-06T21:30:42Z",
+				Agent:     &IncidentAttributeReference{ID: "PIJ90N7", Type: "generic_email_inbound_integration_reference"},
+				Channel: &LogEntryChannel{
+					Type:    "api",
+					Summary: "The server is on fire.",
+					Details: json.RawMessage(`{"ping time": "1500ms", "load avg": 0.75}`),
+				},
+				Incident: &IncidentReference{ID: "PT4KHLK", Type: "incident_reference"},
+			},
+			{
+				ID:        "Q1IVLR2ZVCM0LA",
+				Type:      LogEntryTypeAssign,
+				CreatedAt: "2015-10-06T21:30:43Z",
+				Channel:   &LogEntryChannel{Type: "auto"},
+				Assignees: []*UserReference{{ID: "PXPGF42", Type: "user_reference"}},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentsListAllLogEntries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK/log_entries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "is_overview", "true")
+		switch r.URL.Query().Get("offset") {
+		case "":
+			w.Write([]byte(`{"log_entries": [{"id": "1"}], "limit": 1, "offset": 0, "more": true}`))
+		case "1":
+			w.Write([]byte(`{"log_entries": [{"id": "2"}], "limit": 1, "offset": 1, "more": false}`))
+		default:
+			t.Errorf("unexpected offset %q", r.URL.Query().Get("offset"))
+		}
+	})
+
+	o := &ListLogEntriesOptions{IsOverview: true}
+	resp, err := client.Incidents.ListAllLogEntries("PT4KHLK", o)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*LogEntry{{ID: "1"}, {ID: "2"}}
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+	if o.Offset != 0 {
+		t.Error("the options passed in were modified")
+	}
+}
@@ -0,0 +1,88 @@
+package pagerduty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// IncidentNote represents a note on an incident.
+type IncidentNote struct {
+	ID        string               `json:"id,omitempty"`
+	User      *UserReference       `json:"user,omitempty"`
+	Channel   *IncidentNoteChannel `json:"channel,omitempty"`
+	Content   string               `json:"content,omitempty"`
+	CreatedAt time.Time            `json:"created_at"`
+}
+
+// IncidentNoteChannel represents the channel a note was added through, such
+// as the web UI or the API.
+type IncidentNoteChannel struct {
+	Summary string `json:"summary,omitempty"`
+}
+
+type incidentNotePayload struct {
+	Note *IncidentNote `json:"note"`
+}
+
+type createIncidentNote struct {
+	Content string `json:"content"`
+}
+
+type createIncidentNotePayload struct {
+	Note *createIncidentNote `json:"note"`
+}
+
+// ListIncidentNotesResponse represents a list response of incident notes.
+type ListIncidentNotesResponse struct {
+	Notes []*IncidentNote `json:"notes,omitempty"`
+}
+
+// ListNotes lists the notes of an incident, oldest first.
+func (s *IncidentService) ListNotes(incidentID string) ([]*IncidentNote, *Response, error) {
+	return s.ListNotesContext(context.Background(), incidentID)
+}
+
+// ListNotesContext lists the notes of an incident, oldest first.
+func (s *IncidentService) ListNotesContext(ctx context.Context, incidentID string) ([]*IncidentNote, *Response, error) {
+	u := fmt.Sprintf("/incidents/%s/notes", incidentID)
+	v := new(ListIncidentNotesResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/incidents/{id}/notes"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Notes, resp, nil
+}
+
+// CreateNote adds a note to an incident on behalf of the user whose email is
+// from, sent as the From header required by PagerDuty. An empty from falls
+// back to Config.DefaultFromEmail.
+func (s *IncidentService) CreateNote(from, incidentID, content string, reqOptions ...RequestOptions) (*IncidentNote, *Response, error) {
+	return s.CreateNoteContext(context.Background(), from, incidentID, content, reqOptions...)
+}
+
+// CreateNoteContext adds a note to an incident on behalf of the user whose
+// email is from, sent as the From header required by PagerDuty. An empty
+// from falls back to Config.DefaultFromEmail.
+func (s *IncidentService) CreateNoteContext(ctx context.Context, from, incidentID, content string, reqOptions ...RequestOptions) (*IncidentNote, *Response, error) {
+	reqOptions, err := s.client.fromOptions("adding an incident note", from, reqOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+	if content == "" {
+		return nil, nil, errors.New("the content of an incident note must not be empty")
+	}
+
+	u := fmt.Sprintf("/incidents/%s/notes", incidentID)
+	v := new(incidentNotePayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &createIncidentNotePayload{Note: &createIncidentNote{Content: content}}, &v, withRouteOptions("/incidents/{id}/notes", reqOptions)...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Note, resp, nil
+}
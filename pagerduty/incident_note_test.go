@@ -0,0 +1,78 @@
+package pagerduty
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestIncidentsListNotes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK/notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"notes": [{"id": "PWL7QXS", "user": {"id": "PXPGF42", "type": "user_reference", "summary": "Earline Greenholt"}, "channel": {"summary": "The PagerDuty website or APIs"}, "content": "Firefighters are on the scene.", "created_at": "2013-03-06T15:28:51-05:00"}]}`))
+	})
+
+	resp, _, err := client.Incidents.ListNotes("PT4KHLK")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*IncidentNote{
+		{
+			ID:        "PWL7QXS",
+			User:      &UserReference{ID: "PXPGF42", Type: "user_reference", Summary: "Earline Greenholt"},
+			Channel:   &IncidentNoteChannel{Summary: "The PagerDuty website or APIs"},
+			Content:   "Firefighters are on the scene.",
+			CreatedAt: time.Date(2013, 3, 6, 20, 28, 51, 0, time.UTC),
+		},
+	}
+
+	if len(resp) != 1 || !resp[0].CreatedAt.Equal(want[0].CreatedAt) {
+		t.Fatalf("returned %#v; want %#v", resp, want)
+	}
+	resp[0].CreatedAt = want[0].CreatedAt
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentsCreateNote(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK/notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "From", "user@example.com")
+		testBody(t, r, `{"note":{"content":"Firefighters are on the scene."}}`)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"note": {"id": "PWL7QXS", "content": "Firefighters are on the scene.", "created_at": "2013-03-06T15:28:51-05:00"}}`))
+	})
+
+	resp, _, err := client.Incidents.CreateNote("user@example.com", "PT4KHLK", "Firefighters are on the scene.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.ID != "PWL7QXS" || resp.Content != "Firefighters are on the scene." {
+		t.Errorf("returned %#v", resp)
+	}
+}
+
+func TestIncidentsCreateNoteValidation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK/notes", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request for an invalid note")
+	})
+
+	if _, _, err := client.Incidents.CreateNote("", "PT4KHLK", "Firefighters are on the scene."); err == nil {
+		t.Error("expected a missing From header error, got nil")
+	}
+	if _, _, err := client.Incidents.CreateNote("user@example.com", "PT4KHLK", ""); err == nil {
+		t.Error("expected an empty content error, got nil")
+	}
+}
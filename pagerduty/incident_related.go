@@ -0,0 +1,105 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// relatedIncidentsEarlyAccess is the early access feature required by the
+// related incidents endpoint.
+const relatedIncidentsEarlyAccess = "related-incidents-preview"
+
+// RelatedIncident represents an incident related to another incident, with
+// the relationships that link them.
+type RelatedIncident struct {
+	Incident      *Incident               `json:"incident,omitempty"`
+	Relationships []*IncidentRelationship `json:"relationships,omitempty"`
+}
+
+// IncidentRelationship represents how two incidents are related, e.g. type
+// "machine_learning_inferred" or "service_dependency".
+type IncidentRelationship struct {
+	Type     string                        `json:"type,omitempty"`
+	Metadata *IncidentRelationshipMetadata `json:"metadata,omitempty"`
+}
+
+// IncidentRelationshipMetadata represents the details of a relationship,
+// e.g. GroupingClassification "similar_contents" or "prior_feedback" for
+// inferred relationships.
+type IncidentRelationshipMetadata struct {
+	GroupingClassification string                        `json:"grouping_classification,omitempty"`
+	UserFeedback           *IncidentRelationshipFeedback `json:"user_feedback,omitempty"`
+}
+
+// IncidentRelationshipFeedback represents the feedback of users on an
+// inferred relationship.
+type IncidentRelationshipFeedback struct {
+	PositiveFeedbackCount int `json:"positive_feedback_count"`
+	NegativeFeedbackCount int `json:"negative_feedback_count"`
+}
+
+// ListRelatedIncidentsResponse represents a list response of related
+// incidents.
+type ListRelatedIncidentsResponse struct {
+	RelatedIncidents []*RelatedIncident `json:"related_incidents,omitempty"`
+}
+
+// ListRelatedIncidents lists the incidents related to an incident. The
+// endpoint is in early access, the request opts into it.
+func (s *IncidentService) ListRelatedIncidents(incidentID string) (*ListRelatedIncidentsResponse, *Response, error) {
+	return s.ListRelatedIncidentsContext(context.Background(), incidentID)
+}
+
+// ListRelatedIncidentsContext lists the incidents related to an incident.
+// The endpoint is in early access, the request opts into it.
+func (s *IncidentService) ListRelatedIncidentsContext(ctx context.Context, incidentID string) (*ListRelatedIncidentsResponse, *Response, error) {
+	u := fmt.Sprintf("/incidents/%s/related_incidents", incidentID)
+	v := new(ListRelatedIncidentsResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithEarlyAccess(relatedIncidentsEarlyAccess), WithRoute("/incidents/{id}/related_incidents"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// PastIncident represents a past incident similar to another incident. Score
+// is how similar the incidents are, higher is more similar.
+type PastIncident struct {
+	Incident *Incident `json:"incident,omitempty"`
+	Score    float64   `json:"score,omitempty"`
+}
+
+// ListPastIncidentsOptions represents options when listing past incidents.
+// The endpoint does not paginate, Limit caps the number of incidents and
+// Total asks for the total number of past incidents.
+type ListPastIncidentsOptions struct {
+	Limit int  `url:"limit,omitempty"`
+	Total bool `url:"total,omitempty"`
+}
+
+// ListPastIncidentsResponse represents a list response of past incidents.
+type ListPastIncidentsResponse struct {
+	PastIncidents []*PastIncident `json:"past_incidents,omitempty"`
+	Limit         int             `json:"limit,omitempty"`
+	Total         int             `json:"total,omitempty"`
+}
+
+// ListPastIncidents lists the past incidents similar to an incident.
+func (s *IncidentService) ListPastIncidents(incidentID string, o *ListPastIncidentsOptions) (*ListPastIncidentsResponse, *Response, error) {
+	return s.ListPastIncidentsContext(context.Background(), incidentID, o)
+}
+
+// ListPastIncidentsContext lists the past incidents similar to an incident.
+func (s *IncidentService) ListPastIncidentsContext(ctx context.Context, incidentID string, o *ListPastIncidentsOptions) (*ListPastIncidentsResponse, *Response, error) {
+	u := fmt.Sprintf("/incidents/%s/past_incidents", incidentID)
+	v := new(ListPastIncidentsResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/incidents/{id}/past_incidents"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
@@ -0,0 +1,76 @@
+package pagerduty
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIncidentsListRelatedIncidents(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK/related_incidents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "X-Early-Access", "related-incidents-preview")
+		w.Write([]byte(`{"related_incidents": [{"incident": {"id": "PR2P3RW", "title": "Disk is full"}, "relationships": [{"type": "machine_learning_inferred", "metadata": {"grouping_classification": "similar_contents", "user_feedback": {"positive_feedback_count": 12, "negative_feedback_count": 3}}}]}]}`))
+	})
+
+	resp, _, err := client.Incidents.ListRelatedIncidents("PT4KHLK")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListRelatedIncidentsResponse{
+		RelatedIncidents: []*RelatedIncident{
+			{
+				Incident: &Incident{ID: "PR2P3RW", Title: "Disk is full"},
+				Relationships: []*IncidentRelationship{
+					{
+						Type: "machine_learning_inferred",
+						Metadata: &IncidentRelationshipMetadata{
+							GroupingClassification: "similar_contents",
+							UserFeedback: &IncidentRelationshipFeedback{
+								PositiveFeedbackCount: 12,
+								NegativeFeedbackCount: 3,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentsListPastIncidents(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK/past_incidents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "limit", "5")
+		testQueryValue(t, r, "total", "true")
+		w.Write([]byte(`{"past_incidents": [{"incident": {"id": "PR2P3RW", "title": "Disk is full"}, "score": 46.8249}], "limit": 5, "total": 1}`))
+	})
+
+	resp, _, err := client.Incidents.ListPastIncidents("PT4KHLK", &ListPastIncidentsOptions{Limit: 5, Total: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListPastIncidentsResponse{
+		PastIncidents: []*PastIncident{
+			{Incident: &Incident{ID: "PR2P3RW", Title: "Disk is full"}, Score: 46.8249},
+		},
+		Limit: 5,
+		Total: 1,
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
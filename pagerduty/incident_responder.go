@@ -0,0 +1,135 @@
+package pagerduty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Types of the targets of a responder request.
+const (
+	ResponderTargetUser             = "user_reference"
+	ResponderTargetEscalationPolicy = "escalation_policy_reference"
+)
+
+// States of a requested responder.
+const (
+	ResponderStatePending  = "pending"
+	ResponderStateJoined   = "joined"
+	ResponderStateDeclined = "declined"
+)
+
+// ResponderRequest represents a request for additional responders on an
+// incident, made on behalf of the user with the ID RequesterID.
+type ResponderRequest struct {
+	RequesterID string
+	Message     string
+	Targets     []*ResponderTarget
+}
+
+// ResponderTarget represents a user or an escalation policy asked to respond
+// to an incident. Type is ResponderTargetUser or
+// ResponderTargetEscalationPolicy.
+type ResponderTarget struct {
+	ID   string
+	Type string
+}
+
+type responderTargetReference struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type responderTargetReferenceWrapper struct {
+	Target *responderTargetReference `json:"responder_request_target"`
+}
+
+type createResponderRequest struct {
+	RequesterID string                             `json:"requester_id"`
+	Message     string                             `json:"message,omitempty"`
+	Targets     []*responderTargetReferenceWrapper `json:"responder_request_targets"`
+}
+
+// IncidentResponderRequest represents a request for additional responders
+// on an incident.
+type IncidentResponderRequest struct {
+	Incident    *IncidentReference               `json:"incident,omitempty"`
+	Requester   *UserReference                   `json:"requester,omitempty"`
+	RequestedAt string                           `json:"requested_at,omitempty"`
+	Message     string                           `json:"message,omitempty"`
+	Targets     []*ResponderRequestTargetWrapper `json:"responder_request_targets,omitempty"`
+}
+
+// ResponderRequestTargetWrapper is a wrapper around ResponderRequestTarget.
+type ResponderRequestTargetWrapper struct {
+	Target *ResponderRequestTarget `json:"responder_request_target,omitempty"`
+}
+
+// ResponderRequestTarget represents a user or an escalation policy asked to
+// respond to an incident, with the state of every responder it resulted in.
+type ResponderRequestTarget struct {
+	ID                  string               `json:"id,omitempty"`
+	Type                string               `json:"type,omitempty"`
+	Summary             string               `json:"summary,omitempty"`
+	IncidentsResponders []*IncidentResponder `json:"incidents_responders,omitempty"`
+}
+
+// IncidentResponder represents a user asked to respond to an incident. State
+// is one of ResponderStatePending, ResponderStateJoined or
+// ResponderStateDeclined.
+type IncidentResponder struct {
+	State       string             `json:"state,omitempty"`
+	User        *UserReference     `json:"user,omitempty"`
+	Incident    *IncidentReference `json:"incident,omitempty"`
+	UpdatedAt   string             `json:"updated_at,omitempty"`
+	Message     string             `json:"message,omitempty"`
+	Requester   *UserReference     `json:"requester,omitempty"`
+	RequestedAt string             `json:"requested_at,omitempty"`
+}
+
+type incidentResponderRequestPayload struct {
+	ResponderRequest *IncidentResponderRequest `json:"responder_request"`
+}
+
+// RequestResponders asks additional users or escalation policies to respond
+// to an incident on behalf of the user whose email is from, sent as the From
+// header required by PagerDuty. An empty from falls back to
+// Config.DefaultFromEmail.
+func (s *IncidentService) RequestResponders(from, incidentID string, req *ResponderRequest, reqOptions ...RequestOptions) (*IncidentResponderRequest, *Response, error) {
+	return s.RequestRespondersContext(context.Background(), from, incidentID, req, reqOptions...)
+}
+
+// RequestRespondersContext asks additional users or escalation policies to
+// respond to an incident on behalf of the user whose email is from, sent as
+// the From header required by PagerDuty. An empty from falls back to
+// Config.DefaultFromEmail.
+func (s *IncidentService) RequestRespondersContext(ctx context.Context, from, incidentID string, req *ResponderRequest, reqOptions ...RequestOptions) (*IncidentResponderRequest, *Response, error) {
+	reqOptions, err := s.client.fromOptions("requesting responders", from, reqOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+	if req == nil || req.RequesterID == "" {
+		return nil, nil, errors.New("a responder request requires a requester ID")
+	}
+	if len(req.Targets) == 0 {
+		return nil, nil, errors.New("a responder request requires at least one target")
+	}
+
+	p := &createResponderRequest{RequesterID: req.RequesterID, Message: req.Message}
+	for _, t := range req.Targets {
+		if t.Type != ResponderTargetUser && t.Type != ResponderTargetEscalationPolicy {
+			return nil, nil, fmt.Errorf("unsupported responder target type %q", t.Type)
+		}
+		p.Targets = append(p.Targets, &responderTargetReferenceWrapper{Target: &responderTargetReference{ID: t.ID, Type: t.Type}})
+	}
+
+	u := fmt.Sprintf("/incidents/%s/responder_requests", incidentID)
+	v := new(incidentResponderRequestPayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, p, &v, withRouteOptions("/incidents/{id}/responder_requests", reqOptions)...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.ResponderRequest, resp, nil
+}
@@ -0,0 +1,85 @@
+package pagerduty
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIncidentsRequestResponders(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK/responder_requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "From", "user@example.com")
+		testBody(t, r, `{"requester_id":"PL1JMK5","message":"Please help with issue - join bridge at +1(234)-567-8910","responder_request_targets":[{"responder_request_target":{"id":"PJ25ZYX","type":"user_reference"}},{"responder_request_target":{"id":"PT20YPA","type":"escalation_policy_reference"}}]}`)
+		w.Write([]byte(`{"responder_request": {"incident": {"id": "PT4KHLK", "type": "incident_reference"}, "requester": {"id": "PL1JMK5", "type": "user_reference"}, "requested_at": "2018-08-22T22:55:37Z", "message": "Please help with issue - join bridge at +1(234)-567-8910", "responder_request_targets": [{"responder_request_target": {"id": "PJ25ZYX", "type": "user", "summary": "Alan Kay", "incidents_responders": [{"state": "pending", "user": {"id": "PJ25ZYX", "type": "user_reference"}, "incident": {"id": "PT4KHLK", "type": "incident_reference"}, "updated_at": "2018-08-22T22:55:37Z"}]}}]}}`))
+	})
+
+	resp, _, err := client.Incidents.RequestResponders("user@example.com", "PT4KHLK", &ResponderRequest{
+		RequesterID: "PL1JMK5",
+		Message:     "Please help with issue - join bridge at +1(234)-567-8910",
+		Targets: []*ResponderTarget{
+			{ID: "PJ25ZYX", Type: ResponderTargetUser},
+			{ID: "PT20YPA", Type: ResponderTargetEscalationPolicy},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &IncidentResponderRequest{
+		Incident:    &IncidentReference{ID: "PT4KHLK", Type: "incident_reference"},
+		Requester:   &UserReference{ID: "PL1JMK5", Type: "user_reference"},
+		RequestedAt: "2018-08-22T22:55:37Z",
+		Message:     "Please help with issue - join bridge at +1(234)-567-8910",
+		Targets: []*ResponderRequestTargetWrapper{
+			{
+				Target: &ResponderRequestTarget{
+					ID:      "PJ25ZYX",
+					Type:    "user",
+					Summary: "Alan Kay",
+					IncidentsResponders: []*IncidentResponder{
+						{
+							State:     ResponderStatePending,
+							User:      &UserReference{ID: "PJ25ZYX", Type: "user_reference"},
+							Incident:  &IncidentReference{ID: "PT4KHLK", Type: "incident_reference"},
+							UpdatedAt: "2018-08-22T22:55:37Z",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentsRequestRespondersValidation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK/responder_requests", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request for an invalid responder request")
+	})
+
+	cases := []struct {
+		name string
+		req  *ResponderRequest
+	}{
+		{"no requester", &ResponderRequest{Targets: []*ResponderTarget{{ID: "PJ25ZYX", Type: ResponderTargetUser}}}},
+		{"no targets", &ResponderRequest{RequesterID: "PL1JMK5"}},
+		{"unknown target type", &ResponderRequest{RequesterID: "PL1JMK5", Targets: []*ResponderTarget{{ID: "PQ9K7I8", Type: "team_reference"}}}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, _, err := client.Incidents.RequestResponders("user@example.com", "PT4KHLK", tc.req); err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package pagerduty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Types of the subscribers of incident status updates.
+const (
+	SubscriberTypeUser = "user"
+	SubscriberTypeTeam = "team"
+)
+
+// StatusUpdate represents a status update of an incident.
+type StatusUpdate struct {
+	ID          string         `json:"id,omitempty"`
+	Message     string         `json:"message,omitempty"`
+	Subject     string         `json:"subject,omitempty"`
+	HTMLMessage string         `json:"html_message,omitempty"`
+	CreatedAt   string         `json:"created_at,omitempty"`
+	Sender      *UserReference `json:"sender,omitempty"`
+}
+
+// StatusUpdateOptions represents options when creating a status update.
+// Subject and HTMLMessage customize the email sent to subscribers.
+type StatusUpdateOptions struct {
+	Subject     string
+	HTMLMessage string
+}
+
+type createStatusUpdate struct {
+	Message     string `json:"message"`
+	Subject     string `json:"subject,omitempty"`
+	HTMLMessage string `json:"html_message,omitempty"`
+}
+
+type statusUpdatePayload struct {
+	StatusUpdate *StatusUpdate `json:"status_update"`
+}
+
+// StatusUpdateSubscriber represents a subscriber of the status updates of an
+// incident. Type is SubscriberTypeUser or SubscriberTypeTeam.
+type StatusUpdateSubscriber struct {
+	ID                      string `json:"subscriber_id,omitempty"`
+	Type                    string `json:"subscriber_type,omitempty"`
+	HasIndirectSubscription bool   `json:"has_indirect_subscription,omitempty"`
+}
+
+// StatusUpdateSubscribers returns the subscribers of the given type, one of
+// SubscriberTypeUser or SubscriberTypeTeam, with the given IDs, to subscribe
+// to or unsubscribe from the status updates of an incident.
+func StatusUpdateSubscribers(subscriberType string, ids ...string) []*StatusUpdateSubscriber {
+	subscribers := make([]*StatusUpdateSubscriber, 0, len(ids))
+	for _, id := range ids {
+		subscribers = append(subscribers, &StatusUpdateSubscriber{ID: id, Type: subscriberType})
+	}
+	return subscribers
+}
+
+// Results of subscribing a subscriber to the status updates of an incident.
+const (
+	SubscriptionResultSuccess      = "success"
+	SubscriptionResultDuplicate    = "duplicate"
+	SubscriptionResultUnauthorized = "unauthorized"
+)
+
+// StatusUpdateSubscription represents the result of subscribing a subscriber
+// to the status updates of an incident. Result is one of the
+// SubscriptionResult constants, e.g. SubscriptionResultDuplicate when the
+// subscriber was already subscribed.
+type StatusUpdateSubscription struct {
+	AccountID        string `json:"account_id,omitempty"`
+	SubscriberID     string `json:"subscriber_id,omitempty"`
+	SubscriberType   string `json:"subscriber_type,omitempty"`
+	SubscribableID   string `json:"subscribable_id,omitempty"`
+	SubscribableType string `json:"subscribable_type,omitempty"`
+	Result           string `json:"result,omitempty"`
+}
+
+// IsSubscribed reports whether the subscriber is subscribed after the call,
+// either because it was subscribed or because it already was.
+func (s *StatusUpdateSubscription) IsSubscribed() bool {
+	return s.Result == SubscriptionResultSuccess || s.Result == SubscriptionResultDuplicate
+}
+
+// ListStatusUpdateSubscribersResponse represents a list response of the
+// subscribers of the status updates of an incident.
+type ListStatusUpdateSubscribersResponse struct {
+	Subscribers         []*StatusUpdateSubscriber `json:"subscribers,omitempty"`
+	AccountIsSubscribed bool                      `json:"account_is_subscribed,omitempty"`
+}
+
+// UnsubscribeStatusUpdatesResponse represents the result of unsubscribing
+// subscribers from the status updates of an incident.
+type UnsubscribeStatusUpdatesResponse struct {
+	DeletedCount      int `json:"deleted_count"`
+	UnauthorizedCount int `json:"unauthorized_count"`
+	NonExistentCount  int `json:"non_existent_count"`
+}
+
+type statusUpdateSubscribersPayload struct {
+	Subscribers []*StatusUpdateSubscriber `json:"subscribers"`
+}
+
+type statusUpdateSubscriptionsPayload struct {
+	Subscriptions []*StatusUpdateSubscription `json:"subscriptions"`
+}
+
+// CreateStatusUpdate sends a status update about an incident to its
+// subscribers on behalf of the user whose email is from, sent as the From
+// header required by PagerDuty. An empty from falls back to
+// Config.DefaultFromEmail.
+func (s *IncidentService) CreateStatusUpdate(from, incidentID, message string, o *StatusUpdateOptions, reqOptions ...RequestOptions) (*StatusUpdate, *Response, error) {
+	return s.CreateStatusUpdateContext(context.Background(), from, incidentID, message, o, reqOptions...)
+}
+
+// CreateStatusUpdateContext sends a status update about an incident to its
+// subscribers on behalf of the user whose email is from, sent as the From
+// header required by PagerDuty. An empty from falls back to
+// Config.DefaultFromEmail.
+func (s *IncidentService) CreateStatusUpdateContext(ctx context.Context, from, incidentID, message string, o *StatusUpdateOptions, reqOptions ...RequestOptions) (*StatusUpdate, *Response, error) {
+	reqOptions, err := s.client.fromOptions("creating a status update", from, reqOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+	if message == "" {
+		return nil, nil, errors.New("the message of a status update must not be empty")
+	}
+
+	p := &createStatusUpdate{Message: message}
+	if o != nil {
+		p.Subject = o.Subject
+		p.HTMLMessage = o.HTMLMessage
+	}
+
+	u := fmt.Sprintf("/incidents/%s/status_updates", incidentID)
+	v := new(statusUpdatePayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, p, &v, withRouteOptions("/incidents/{id}/status_updates", reqOptions)...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.StatusUpdate, resp, nil
+}
+
+// ListStatusUpdateSubscribers lists the subscribers of the status updates of
+// an incident.
+func (s *IncidentService) ListStatusUpdateSubscribers(incidentID string) (*ListStatusUpdateSubscribersResponse, *Response, error) {
+	return s.ListStatusUpdateSubscribersContext(context.Background(), incidentID)
+}
+
+// ListStatusUpdateSubscribersContext lists the subscribers of the status
+// updates of an incident.
+func (s *IncidentService) ListStatusUpdateSubscribersContext(ctx context.Context, incidentID string) (*ListStatusUpdateSubscribersResponse, *Response, error) {
+	u := fmt.Sprintf("/incidents/%s/status_updates/subscribers", incidentID)
+	v := new(ListStatusUpdateSubscribersResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/incidents/{id}/status_updates/subscribers"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// SubscribeStatusUpdates subscribes users and teams to the status updates of
+// an incident, see StatusUpdateSubscribers to build them from IDs. The result
+// of every subscription is reported separately, a subscriber that could not
+// be subscribed does not fail the call; see IsSubscribed.
+func (s *IncidentService) SubscribeStatusUpdates(incidentID string, subscribers []*StatusUpdateSubscriber) ([]*StatusUpdateSubscription, *Response, error) {
+	return s.SubscribeStatusUpdatesContext(context.Background(), incidentID, subscribers)
+}
+
+// SubscribeStatusUpdatesContext subscribes users and teams to the status
+// updates of an incident, see StatusUpdateSubscribers to build them from
+// IDs. The result of every subscription is reported separately, a subscriber
+// that could not be subscribed does not fail the call; see IsSubscribed.
+func (s *IncidentService) SubscribeStatusUpdatesContext(ctx context.Context, incidentID string, subscribers []*StatusUpdateSubscriber) ([]*StatusUpdateSubscription, *Response, error) {
+	if err := validateStatusUpdateSubscribers(subscribers); err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("/incidents/%s/status_updates/subscribers", incidentID)
+	v := new(statusUpdateSubscriptionsPayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &statusUpdateSubscribersPayload{Subscribers: subscribers}, &v, WithRoute("/incidents/{id}/status_updates/subscribers"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Subscriptions, resp, nil
+}
+
+// UnsubscribeStatusUpdates unsubscribes users and teams from the status
+// updates of an incident.
+func (s *IncidentService) UnsubscribeStatusUpdates(incidentID string, subscribers []*StatusUpdateSubscriber) (*UnsubscribeStatusUpdatesResponse, *Response, error) {
+	return s.UnsubscribeStatusUpdatesContext(context.Background(), incidentID, subscribers)
+}
+
+// UnsubscribeStatusUpdatesContext unsubscribes users and teams from the
+// status updates of an incident.
+func (s *IncidentService) UnsubscribeStatusUpdatesContext(ctx context.Context, incidentID string, subscribers []*StatusUpdateSubscriber) (*UnsubscribeStatusUpdatesResponse, *Response, error) {
+	if err := validateStatusUpdateSubscribers(subscribers); err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("/incidents/%s/status_updates/unsubscribe", incidentID)
+	v := new(UnsubscribeStatusUpdatesResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &statusUpdateSubscribersPayload{Subscribers: subscribers}, &v, WithRoute("/incidents/{id}/status_updates/unsubscribe"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+func validateStatusUpdateSubscribers(subscribers []*StatusUpdateSubscriber) error {
+	if len(subscribers) == 0 {
+		return errors.New("at least one subscriber is required")
+	}
+	for _, subscriber := range subscribers {
+		if subscriber == nil || subscriber.ID == "" {
+			return errors.New("a subscriber requires an ID")
+		}
+		if subscriber.Type != SubscriberTypeUser && subscriber.Type != SubscriberTypeTeam {
+			return fmt.Errorf("subscriber %s has unsupported type %q", subscriber.ID, subscriber.Type)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package pagerduty
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIncidentsCreateStatusUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK/status_updates", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "From", "user@example.com")
+		testBody(t, r, `{"message":"The server fire is spreading.","subject":"Major incident update","html_message":"\u003cp\u003eThe server fire is spreading.\u003c/p\u003e"}`)
+		w.Write([]byte(`{"status_update": {"id": "PWL7QXS", "message": "The server fire is spreading.", "subject": "Major incident update", "created_at": "2013-03-06T15:28:51-05:00", "sender": {"id": "PXPGF42", "type": "user_reference"}}}`))
+	})
+
+	resp, _, err := client.Incidents.CreateStatusUpdate("user@example.com", "PT4KHLK", "The server fire is spreading.", &StatusUpdateOptions{
+		Subject:     "Major incident update",
+		HTMLMessage: "<p>The server fire is spreading.</p>",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &StatusUpdate{
+		ID:        "PWL7QXS",
+		Message:   "The server fire is spreading.",
+		Subject:   "Major incident update",
+		CreatedAt: "2013-03-06T15:28:51-05:00",
+		Sender:    &UserReference{ID: "PXPGF42", Type: "user_reference"},
+	}
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+
+	if _, _, err := client.Incidents.CreateStatusUpdate("user@example.com", "PT4KHLK", "", nil); err == nil {
+		t.Error("expected an error for an empty message, got nil")
+	}
+}
+
+func TestIncidentsStatusUpdateSubscribers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK/status_updates/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.Write([]byte(`{"subscribers": [{"subscriber_id": "PD1234", "subscriber_type": "user", "has_indirect_subscription": false}], "account_is_subscribed": true}`))
+		case "POST":
+			testBody(t, r, `{"subscribers":[{"subscriber_id":"PD1234","subscriber_type":"user"},{"subscriber_id":"PQ9K7I8","subscriber_type":"team"}]}`)
+			w.Write([]byte(`{"subscriptions": [{"account_id": "PACC123", "subscriber_id": "PD1234", "subscriber_type": "user", "subscribable_id": "PT4KHLK", "subscribable_type": "incident", "result": "success"}, {"account_id": "PACC123", "subscriber_id": "PQ9K7I8", "subscriber_type": "team", "subscribable_id": "PT4KHLK", "subscribable_type": "incident", "result": "duplicate"}]}`))
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	mux.HandleFunc("/incidents/PT4KHLK/status_updates/unsubscribe", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"subscribers":[{"subscriber_id":"PD1234","subscriber_type":"user"}]}`)
+		w.Write([]byte(`{"deleted_count": 1, "unauthorized_count": 0, "non_existent_count": 0}`))
+	})
+
+	list, _, err := client.Incidents.ListStatusUpdateSubscribers("PT4KHLK")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantList := &ListStatusUpdateSubscribersResponse{
+		Subscribers:         []*StatusUpdateSubscriber{{ID: "PD1234", Type: SubscriberTypeUser}},
+		AccountIsSubscribed: true,
+	}
+	if !reflect.DeepEqual(list, wantList) {
+		t.Errorf("returned %#v; want %#v", list, wantList)
+	}
+
+	subscriptions, _, err := client.Incidents.SubscribeStatusUpdates("PT4KHLK", []*StatusUpdateSubscriber{
+		{ID: "PD1234", Type: SubscriberTypeUser},
+		{ID: "PQ9K7I8", Type: SubscriberTypeTeam},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subscriptions) != 2 || subscriptions[0].Result != "success" || subscriptions[1].Result != "duplicate" {
+		t.Errorf("returned %#v, want a success and a duplicate result", subscriptions)
+	}
+
+	unsubscribed, _, err := client.Incidents.UnsubscribeStatusUpdates("PT4KHLK", []*StatusUpdateSubscriber{{ID: "PD1234", Type: SubscriberTypeUser}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&UnsubscribeStatusUpdatesResponse{DeletedCount: 1}); !reflect.DeepEqual(unsubscribed, want) {
+		t.Errorf("returned %#v; want %#v", unsubscribed, want)
+	}
+}
+
+func TestIncidentsSubscribeStatusUpdatesByID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK/status_updates/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"subscribers":[{"subscriber_id":"PQ9K7I8","subscriber_type":"team"},{"subscriber_id":"PD1234","subscriber_type":"user"},{"subscriber_id":"PD5678","subscriber_type":"user"}]}`)
+		w.Write([]byte(`{"subscriptions": [{"subscriber_id": "PQ9K7I8", "subscriber_type": "team", "result": "success"}, {"subscriber_id": "PD1234", "subscriber_type": "user", "result": "duplicate"}, {"subscriber_id": "PD5678", "subscriber_type": "user", "result": "unauthorized"}]}`))
+	})
+
+	subscribers := append(StatusUpdateSubscribers(SubscriberTypeTeam, "PQ9K7I8"), StatusUpdateSubscribers(SubscriberTypeUser, "PD1234", "PD5678")...)
+	subscriptions, _, err := client.Incidents.SubscribeStatusUpdates("PT4KHLK", subscribers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var failed []string
+	for _, subscription := range subscriptions {
+		if !subscription.IsSubscribed() {
+			failed = append(failed, subscription.SubscriberID)
+		}
+	}
+	if want := []string{"PD5678"}; !reflect.DeepEqual(failed, want) {
+		t.Errorf("failed subscribers = %v, want %v", failed, want)
+	}
+}
+
+func TestIncidentsSubscribeStatusUpdatesInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, subscribers := range [][]*StatusUpdateSubscriber{
+		nil,
+		StatusUpdateSubscribers(SubscriberTypeUser, ""),
+		StatusUpdateSubscribers("escalation_policy", "PT4KHLK"),
+	} {
+		if _, _, err := client.Incidents.SubscribeStatusUpdates("PT4KHLK", subscribers); err == nil {
+			t.Errorf("expected an error subscribing %#v", subscribers)
+		}
+		if _, _, err := client.Incidents.UnsubscribeStatusUpdates("PT4KHLK", subscribers); err == nil {
+			t.Errorf("expected an error unsubscribing %#v", subscribers)
+		}
+	}
+}
@@ -2,8 +2,11 @@ package pagerduty
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -34,6 +37,59 @@ func TestIncidentsList(t *testing.T) {
 	}
 }
 
+func TestIncidentsListFilters(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		want := "date_range=all&include%5B%5D=assignees&include%5B%5D=acknowledgers&limit=10&service_ids%5B%5D=PIJ90N7&sort_by%5B%5D=created_at%3Adesc&statuses%5B%5D=triggered&statuses%5B%5D=acknowledged&team_ids%5B%5D=PQ9K7I8&total=true&urgencies%5B%5D=high&user_ids%5B%5D=PXPGF42"
+		if r.URL.RawQuery != want {
+			t.Errorf("query = %q, want %q", r.URL.RawQuery, want)
+		}
+		w.Write([]byte(`{"incidents": [{"id": "PT4KHLK", "status": "acknowledged", "urgency": "high", "created_at": "2015-10-06T21:30:42Z", "last_status_change_at": "2015-10-06T21:38:23Z", "priority": {"id": "P53ZZH5", "type": "priority_reference"}, "assignments": [{"at": "2015-11-10T00:31:52Z", "assignee": {"id": "PXPGF42", "type": "user_reference"}}], "acknowledgements": [{"at": "2015-11-10T00:32:52Z", "acknowledger": {"id": "PXPGF42", "type": "user_reference"}}]}], "limit": 10, "offset": 0, "more": false, "total": 1}`))
+	})
+
+	resp, _, err := client.Incidents.List(&ListIncidentsOptions{
+		ListOptions: ListOptions{Limit: 10, Total: true},
+		DateRange:   "all",
+		Include:     []string{"assignees", "acknowledgers"},
+		ServiceIDs:  []string{"PIJ90N7"},
+		SortBy:      []string{"created_at:desc"},
+		Statuses:    []string{"triggered", "acknowledged"},
+		TeamIDs:     []string{"PQ9K7I8"},
+		Urgencies:   []string{"high"},
+		UserIDs:     []string{"PXPGF42"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListIncidentsResponse{
+		PaginationMeta: PaginationMeta{Limit: 10, Total: 1},
+		Incidents: []*Incident{
+			{
+				ID:                 "PT4KHLK",
+				Status:             "acknowledged",
+				Urgency:            "high",
+				CreatedAt:          "2015-10-06T21:30:42Z",
+				LastStatusChangeAt: "2015-10-06T21:38:23Z",
+				Priority:           &PriorityReference{ID: "P53ZZH5", Type: "priority_reference"},
+				Assignments: []*IncidentAssignment{
+					{At: "2015-11-10T00:31:52Z", Assignee: UserReference{ID: "PXPGF42", Type: "user_reference"}},
+				},
+				Acknowledgements: []*IncidentAcknowledgement{
+					{At: "2015-11-10T00:32:52Z", Acknowledger: IncidentAttributeReference{ID: "PXPGF42", Type: "user_reference"}},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
 func TestIncidentsListAll(t *testing.T) {
 	setup()
 	defer teardown()
@@ -75,14 +131,72 @@ func TestIncidentsListAll(t *testing.T) {
 	}
 }
 
+func TestIncidentsListAllNilOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("offset") {
+		case "", "0":
+			w.Write([]byte(`{"incidents":[{"id":"P1D3Z4B"},{"id":"Z1D3K79"}],"limit":2,"offset":0,"more":true}`))
+		case "2":
+			w.Write([]byte(`{"incidents":[{"id":"U1D3NS1"}],"limit":2,"offset":2,"more":false}`))
+		default:
+			t.Errorf("unexpected offset %q", r.URL.Query().Get("offset"))
+		}
+	})
+
+	resp, err := client.Incidents.ListAll(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []*Incident{{ID: "P1D3Z4B"}, {ID: "Z1D3K79"}, {ID: "U1D3NS1"}}; !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentsListAllLimitExceeded(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		offset := r.URL.Query().Get("offset")
+		if offset == "" {
+			offset = "0"
+		}
+		w.Write([]byte(fmt.Sprintf(`{"incidents":[{"id":"P1"}],"limit":100,"offset":%s,"more":true}`, offset)))
+	})
+
+	opts := &ListIncidentsOptions{ListOptions: ListOptions{Limit: 100}}
+	resp, err := client.Incidents.ListAll(opts)
+
+	var limitErr *PaginationLimitError
+	if !errors.As(err, &limitErr) {
+		t.Fatalf("got %v; want *PaginationLimitError", err)
+	}
+	if limitErr.Offset != 10000 {
+		t.Errorf("got offset %d; want 10000", limitErr.Offset)
+	}
+	if len(resp) != 100 {
+		t.Errorf("got %d incidents; want the 100 listed before the limit", len(resp))
+	}
+	if opts.Offset != 0 {
+		t.Errorf("caller options changed to offset %d", opts.Offset)
+	}
+}
+
 func TestIncidentsManage(t *testing.T) {
 	setup()
 	defer teardown()
 
-	input := []*Incident{{ID: "P1D3Z4B"}}
+	input := []*ManageIncident{{ID: "P1D3Z4B"}}
 
 	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "PUT")
+		testHeader(t, r, "From", "user@example.com")
 		payload := &ManageIncidentsPayload{Incidents: input}
 		v := new(ManageIncidentsPayload)
 		json.NewDecoder(r.Body).Decode(v)
@@ -92,7 +206,7 @@ func TestIncidentsManage(t *testing.T) {
 		w.Write([]byte(`{"incidents": [{"id": "P1D3Z4B"}]}`))
 	})
 
-	resp, _, err := client.Incidents.ManageIncidents(input, &ManageIncidentsOptions{})
+	resp, _, err := client.Incidents.ManageIncidents("user@example.com", input, &ManageIncidentsOptions{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -110,27 +224,129 @@ func TestIncidentsManage(t *testing.T) {
 	}
 }
 
+func TestIncidentsManageChunked(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var input []*ManageIncident
+	for i := 0; i < maxManageIncidents+2; i++ {
+		input = append(input, &ManageIncident{ID: fmt.Sprintf("P%d", i), Status: "resolved"})
+	}
+
+	calls := 0
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		calls++
+		v := new(ManageIncidentsPayload)
+		json.NewDecoder(r.Body).Decode(v)
+		if calls == 1 && len(v.Incidents) != maxManageIncidents || calls == 2 && len(v.Incidents) != 2 {
+			t.Errorf("request %d updated %d incidents", calls, len(v.Incidents))
+		}
+		json.NewEncoder(w).Encode(v)
+	})
+
+	resp, _, err := client.Incidents.ManageIncidents("user@example.com", input, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2", calls)
+	}
+	if len(resp.Incidents) != len(input) {
+		t.Errorf("returned %d incidents, want %d", len(resp.Incidents), len(input))
+	}
+}
+
+func TestIncidentsManagePartialFailure(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var input []*ManageIncident
+	for i := 0; i < maxManageIncidents+2; i++ {
+		input = append(input, &ManageIncident{ID: fmt.Sprintf("P%d", i), Status: "acknowledged"})
+	}
+
+	calls := 0
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 2 {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": {"message": "Not Found", "code": 2100}}`))
+			return
+		}
+		v := new(ManageIncidentsPayload)
+		json.NewDecoder(r.Body).Decode(v)
+		json.NewEncoder(w).Encode(v)
+	})
+
+	resp, _, err := client.Incidents.ManageIncidents("user@example.com", input, nil)
+	var bulkErr *BulkError
+	if !errors.As(err, &bulkErr) {
+		t.Fatalf("got error %v, want a *BulkError", err)
+	}
+	if want := []string{"P250", "P251"}; !reflect.DeepEqual(bulkErr.FailedIDs(), want) {
+		t.Errorf("FailedIDs() = %q, want %q", bulkErr.FailedIDs(), want)
+	}
+	if !IsNotFound(err) {
+		t.Errorf("IsNotFound(%v) = false, want true", err)
+	}
+	if len(resp.Incidents) != maxManageIncidents {
+		t.Errorf("returned %d incidents, want %d", len(resp.Incidents), maxManageIncidents)
+	}
+}
+
+func TestIncidentsUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "From", "user@example.com")
+		testBody(t, r, `{"incident":{"type":"incident_reference","escalation_level":2}}`)
+		w.Write([]byte(`{"incident": {"id": "PT4KHLK", "type": "incident", "escalation_level": 2}}`))
+	})
+
+	resp, _, err := client.Incidents.Update("PT4KHLK", "user@example.com", &ManageIncident{EscalationLevel: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Incident{ID: "PT4KHLK", Type: "incident", EscalationLevel: 2}
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentsManageRequiresFrom(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request without a From header")
+	})
+
+	_, _, err := client.Incidents.ManageIncidents("", []*ManageIncident{{ID: "P1D3Z4B", Status: "resolved"}}, nil)
+	if err == nil || !strings.Contains(err.Error(), "From header") {
+		t.Fatalf("expected a missing From header error, got %v", err)
+	}
+}
+
 func TestIncidentsCreate(t *testing.T) {
 	setup()
 	defer teardown()
 
-	input := &Incident{
-		Type:  "incident",
+	input := &CreateIncident{
 		Title: "test incident",
 	}
 
 	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
-		payload := &IncidentPayload{Incident: input}
-		v := new(IncidentPayload)
-		json.NewDecoder(r.Body).Decode(v)
-		if !reflect.DeepEqual(v, payload) {
-			t.Errorf("Request body = %+v, want %+v", v, payload)
-		}
+		testHeader(t, r, "From", "user@example.com")
+		testBody(t, r, `{"incident":{"type":"incident","title":"test incident"}}`)
 		w.Write([]byte(`{"incident": {"id": "1", "type": "incident", "title": "test incident"}}`))
 	})
 
-	resp, _, err := client.Incidents.Create(input)
+	resp, _, err := client.Incidents.Create("user@example.com", input)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -146,6 +362,118 @@ func TestIncidentsCreate(t *testing.T) {
 	}
 }
 
+func TestIncidentsCreateFull(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "From", "user@example.com")
+		testBody(t, r, `{"incident":{"type":"incident","title":"The server is on fire.","incident_key":"baf7cf21b1da41b4b0221008339ff357","service":{"id":"PWIXJZS","type":"service_reference"},"assignments":[{"assignee":{"id":"PXPGF42","type":"user_reference"}}],"urgency":"high","priority":{"id":"P53ZZH5","type":"priority_reference"},"body":{"type":"incident_body","details":"A disk is getting full on this machine."},"conference_bridge":{"conference_number":"+1-415-555-1212,,,,1234#","conference_url":"https://example.com/acb-123"}}}`)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"incident": {"id": "PT4KHLK", "type": "incident", "incident_number": 1234, "title": "The server is on fire."}}`))
+	})
+
+	resp, _, err := client.Incidents.Create("user@example.com", &CreateIncident{
+		Title:       "The server is on fire.",
+		IncidentKey: "baf7cf21b1da41b4b0221008339ff357",
+		Service:     &ServiceReference{ID: "PWIXJZS", Type: "service_reference"},
+		Assignments: []*IncidentAssignment{
+			{Assignee: UserReference{ID: "PXPGF42", Type: "user_reference"}},
+		},
+		Urgency:  "high",
+		Priority: &PriorityReference{ID: "P53ZZH5", Type: "priority_reference"},
+		Body:     &IncidentBody{Type: "incident_body", Details: "A disk is getting full on this machine."},
+		ConferenceBridge: &ConferenceBridge{
+			ConferenceNumber: "+1-415-555-1212,,,,1234#",
+			ConferenceURL:    "https://example.com/acb-123",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.ID != "PT4KHLK" || resp.IncidentNumber != 1234 {
+		t.Errorf("returned incident %q number %d, want PT4KHLK number 1234", resp.ID, resp.IncidentNumber)
+	}
+}
+
+func TestIncidentsCreateInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error": {"message": "Invalid Input Provided", "code": 2001, "errors": ["Service can't be blank", "Title can't be blank"]}}`))
+	})
+
+	_, _, err := client.Incidents.Create("user@example.com", &CreateIncident{})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("got error %v, want an *APIError", err)
+	}
+	if want := []string{"Service can't be blank", "Title can't be blank"}; !reflect.DeepEqual(apiErr.Errors, want) {
+		t.Errorf("Errors = %q, want %q", apiErr.Errors, want)
+	}
+}
+
+func TestIncidentsCreateEscalationPolicyAndAssignments(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request for an invalid incident")
+	})
+
+	_, _, err := client.Incidents.Create("user@example.com", &CreateIncident{
+		Title:            "The server is on fire.",
+		EscalationPolicy: &EscalationPolicyReference{ID: "PT20YPA", Type: "escalation_policy_reference"},
+		Assignments:      []*IncidentAssignment{{Assignee: UserReference{ID: "PXPGF42", Type: "user_reference"}}},
+	})
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestIncidentsCreateRequiresFrom(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request without a From header")
+	})
+
+	_, _, err := client.Incidents.Create("", &CreateIncident{Title: "test incident"})
+	if err == nil || !strings.Contains(err.Error(), "From header") {
+		t.Fatalf("expected a missing From header error, got %v", err)
+	}
+}
+
+func TestIncidentsCreateDefaultFromEmail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Config.DefaultFromEmail = "default@example.com"
+
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "From", "default@example.com")
+		w.Write([]byte(`{"incident": {"id": "1"}}`))
+	})
+	mux.HandleFunc("/incidents/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "From", "")
+		w.Write([]byte(`{"incident": {"id": "1"}}`))
+	})
+
+	if _, _, err := client.Incidents.Create("", &CreateIncident{Title: "test incident"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Incidents.Get("1", nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestIncidentsGet(t *testing.T) {
 	setup()
 	defer teardown()
@@ -155,7 +483,7 @@ func TestIncidentsGet(t *testing.T) {
 		w.Write([]byte(`{"incident": {"id": "1", "type": "incident", "title": "test incident"}}`))
 	})
 
-	resp, _, err := client.Incidents.Get("1")
+	resp, _, err := client.Incidents.Get("1", nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -170,3 +498,237 @@ func TestIncidentsGet(t *testing.T) {
 		t.Errorf("returned %#v; want %#v", resp, want)
 	}
 }
+
+func TestIncidentsGetInclude(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/1234", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if want := "include%5B%5D=assignees&include%5B%5D=conference_bridge"; r.URL.RawQuery != want {
+			t.Errorf("query = %q, want %q", r.URL.RawQuery, want)
+		}
+		w.Write([]byte(`{"incident": {"id": "PT4KHLK", "incident_number": 1234, "body": {"type": "incident_body", "details": "A disk is getting full on this machine."}, "conference_bridge": {"conference_number": "+1-415-555-1212,,,,1234#", "conference_url": "https://example.com/acb-123"}, "resolve_reason": {"type": "merge_resolve_reason", "incident": {"id": "PXPGF42", "type": "incident_reference"}}}}`))
+	})
+
+	resp, _, err := client.Incidents.Get("1234", &GetIncidentOptions{Include: []string{"assignees", "conference_bridge"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Incident{
+		ID:             "PT4KHLK",
+		IncidentNumber: 1234,
+		Body:           &IncidentBody{Type: "incident_body", Details: "A disk is getting full on this machine."},
+		ConferenceBridge: &ConferenceBridge{
+			ConferenceNumber: "+1-415-555-1212,,,,1234#",
+			ConferenceURL:    "https://example.com/acb-123",
+		},
+		ResolveReason: &ResolveReason{
+			Type:     "merge_resolve_reason",
+			Incident: &IncidentReference{ID: "PXPGF42", Type: "incident_reference"},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentsGetNotFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PNOTFND", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error": {"message": "Not Found", "code": 2100}}`))
+	})
+
+	if _, _, err := client.Incidents.Get("PNOTFND", nil); !IsNotFound(err) {
+		t.Fatalf("got error %v, want a not found error", err)
+	}
+}
+
+func TestIncidentsAcknowledge(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "From", "user@example.com")
+		testBody(t, r, `{"incident":{"type":"incident_reference","status":"acknowledged"}}`)
+		w.Write([]byte(`{"incident": {"id": "PT4KHLK", "status": "acknowledged"}}`))
+	})
+
+	resp, _, err := client.Incidents.Acknowledge("user@example.com", "PT4KHLK")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Incident{ID: "PT4KHLK", Status: "acknowledged"}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentsAcknowledgeRequiresFrom(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request without a From email")
+	})
+
+	_, _, err := client.Incidents.Acknowledge("", "PT4KHLK")
+	if err == nil || !strings.Contains(err.Error(), "From header") {
+		t.Fatalf("expected a missing From header error, got %v", err)
+	}
+}
+
+func TestIncidentsResolve(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "From", "user@example.com")
+		testBody(t, r, `{"incident":{"type":"incident_reference","status":"resolved","resolution":"Restarted the server."}}`)
+		w.Write([]byte(`{"incident": {"id": "PT4KHLK", "status": "resolved", "resolution": "Restarted the server."}}`))
+	})
+
+	resp, _, err := client.Incidents.Resolve("user@example.com", "PT4KHLK", "Restarted the server.")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Incident{ID: "PT4KHLK", Status: "resolved", Resolution: "Restarted the server."}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentsResolveAlreadyResolved(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"incident":{"type":"incident_reference","status":"resolved"}}`)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error": {"code": 2001, "message": "Invalid Input Provided", "errors": ["Incident Already Resolved"]}}`))
+	})
+
+	_, _, err := client.Incidents.Resolve("user@example.com", "PT4KHLK", "")
+
+	var conflictErr *ConflictError
+	if !errors.As(err, &conflictErr) {
+		t.Fatalf("got error %v, want a *ConflictError", err)
+	}
+	if conflictErr.ID != "PT4KHLK" {
+		t.Errorf("conflict ID = %q, want %q", conflictErr.ID, "PT4KHLK")
+	}
+	if !IsConflict(err) {
+		t.Error("IsConflict returned false")
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("got error %v, want the underlying API error", err)
+	}
+}
+
+func TestIncidentsAcknowledgeConflict(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"error": {"code": 2001, "message": "Incident is resolved"}}`))
+	})
+
+	_, _, err := client.Incidents.Acknowledge("user@example.com", "PT4KHLK")
+
+	var conflictErr *ConflictError
+	if !errors.As(err, &conflictErr) {
+		t.Fatalf("got error %v, want a *ConflictError", err)
+	}
+}
+
+func TestIncidentsResolveOtherError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error": {"code": 2001, "message": "Invalid Input Provided", "errors": ["Resolution is too long"]}}`))
+	})
+
+	_, _, err := client.Incidents.Resolve("user@example.com", "PT4KHLK", "")
+	if err == nil || IsConflict(err) {
+		t.Errorf("got error %v, want a non conflict error", err)
+	}
+}
+
+func TestIncidentsEscalate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "From", "user@example.com")
+		testBody(t, r, `{"incident":{"type":"incident_reference","escalation_level":2}}`)
+		w.Write([]byte(`{"incident": {"id": "PT4KHLK", "escalation_level": 2}}`))
+	})
+
+	resp, _, err := client.Incidents.Escalate("user@example.com", "PT4KHLK", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Incident{ID: "PT4KHLK", EscalationLevel: 2}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+
+	if _, _, err := client.Incidents.Escalate("user@example.com", "PT4KHLK", 0); err == nil {
+		t.Error("expected an error for escalation level 0")
+	}
+}
+
+func TestIncidentsReassign(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PT4KHLK", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "From", "user@example.com")
+		testBody(t, r, `{"incident":{"type":"incident_reference","assignments":[{"assignee":{"id":"PXPGF42","type":"user_reference"}},{"assignee":{"id":"PAM4FGS","type":"user_reference"}}]}}`)
+		w.Write([]byte(`{"incident": {"id": "PT4KHLK", "assignments": [{"at": "2015-11-10T00:31:52Z", "assignee": {"id": "PXPGF42", "type": "user_reference"}}, {"at": "2015-11-10T00:31:52Z", "assignee": {"id": "PAM4FGS", "type": "user_reference"}}]}}`))
+	})
+
+	resp, _, err := client.Incidents.Reassign("user@example.com", "PT4KHLK", []string{"PXPGF42", "PAM4FGS"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Incident{
+		ID: "PT4KHLK",
+		Assignments: []*IncidentAssignment{
+			{At: "2015-11-10T00:31:52Z", Assignee: UserReference{ID: "PXPGF42", Type: "user_reference"}},
+			{At: "2015-11-10T00:31:52Z", Assignee: UserReference{ID: "PAM4FGS", Type: "user_reference"}},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+
+	for _, userIDs := range [][]string{nil, {""}} {
+		if _, _, err := client.Incidents.Reassign("user@example.com", "PT4KHLK", userIDs); err == nil {
+			t.Errorf("expected an error reassigning to %q", userIDs)
+		}
+	}
+}
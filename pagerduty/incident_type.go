@@ -0,0 +1,159 @@
+package pagerduty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// incidentTypesEarlyAccess is the early access feature required by the
+// incident types endpoints.
+const incidentTypesEarlyAccess = "incident-types-early-access"
+
+// Filters of incident types when listing them.
+const (
+	IncidentTypeFilterEnabled  = "enabled"
+	IncidentTypeFilterDisabled = "disabled"
+	IncidentTypeFilterAll      = "all"
+)
+
+// IncidentTypeService handles the communication with incident type
+// related methods of the PagerDuty API.
+type IncidentTypeService service
+
+// IncidentType represents an incident type. ParentType is the ID or name of
+// the parent type when creating an incident type, Parent references it once
+// created. Incident types are created enabled.
+type IncidentType struct {
+	ID          string                 `json:"id,omitempty"`
+	Type        string                 `json:"type,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+	DisplayName string                 `json:"display_name,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Enabled     bool                   `json:"enabled,omitempty"`
+	ParentType  string                 `json:"parent_type,omitempty"`
+	Parent      *IncidentTypeReference `json:"parent,omitempty"`
+	CreatedAt   string                 `json:"created_at,omitempty"`
+	UpdatedAt   string                 `json:"updated_at,omitempty"`
+}
+
+// IncidentTypeReference represents a reference to an incident type, which
+// can be made by name.
+type IncidentTypeReference struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+// UpdateIncidentType represents the changes to an incident type, fields
+// left nil are not changed.
+type UpdateIncidentType struct {
+	DisplayName *string `json:"display_name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+// IncidentTypePayload represents payload with an incident type object.
+type IncidentTypePayload struct {
+	IncidentType *IncidentType `json:"incident_type,omitempty"`
+}
+
+type updateIncidentTypePayload struct {
+	IncidentType *UpdateIncidentType `json:"incident_type"`
+}
+
+// ListIncidentTypesOptions represents options when listing incident types.
+// Filter is one of the IncidentTypeFilter constants, only enabled types are
+// listed by default.
+type ListIncidentTypesOptions struct {
+	Filter string `url:"filter,omitempty"`
+}
+
+// ListIncidentTypesResponse represents a list response of incident types.
+type ListIncidentTypesResponse struct {
+	IncidentTypes []*IncidentType `json:"incident_types,omitempty"`
+}
+
+// List lists existing incident types.
+func (s *IncidentTypeService) List(o *ListIncidentTypesOptions) (*ListIncidentTypesResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists existing incident types.
+func (s *IncidentTypeService) ListContext(ctx context.Context, o *ListIncidentTypesOptions) (*ListIncidentTypesResponse, *Response, error) {
+	u := "/incidents/types"
+	v := new(ListIncidentTypesResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithEarlyAccess(incidentTypesEarlyAccess), WithRoute("/incidents/types"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// Get retrieves information about an incident type by ID or name.
+func (s *IncidentTypeService) Get(id string) (*IncidentType, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext retrieves information about an incident type by ID or name.
+func (s *IncidentTypeService) GetContext(ctx context.Context, id string) (*IncidentType, *Response, error) {
+	u := fmt.Sprintf("/incidents/types/%s", id)
+	v := new(IncidentTypePayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithEarlyAccess(incidentTypesEarlyAccess), WithRoute("/incidents/types/{id}"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.IncidentType, resp, nil
+}
+
+// Create creates a new incident type. Name, DisplayName and ParentType are
+// required.
+func (s *IncidentTypeService) Create(incidentType *IncidentType) (*IncidentType, *Response, error) {
+	return s.CreateContext(context.Background(), incidentType)
+}
+
+// CreateContext creates a new incident type. Name, DisplayName and
+// ParentType are required.
+func (s *IncidentTypeService) CreateContext(ctx context.Context, incidentType *IncidentType) (*IncidentType, *Response, error) {
+	if incidentType == nil || incidentType.Name == "" || incidentType.DisplayName == "" || incidentType.ParentType == "" {
+		return nil, nil, errors.New("an incident type requires a name, a display name and a parent type")
+	}
+
+	u := "/incidents/types"
+	v := new(IncidentTypePayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &IncidentTypePayload{IncidentType: incidentType}, &v, WithEarlyAccess(incidentTypesEarlyAccess), WithRoute("/incidents/types"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.IncidentType, resp, nil
+}
+
+// Update updates an existing incident type by ID or name, e.g. to enable or
+// disable it or change its display name.
+func (s *IncidentTypeService) Update(id string, update *UpdateIncidentType) (*IncidentType, *Response, error) {
+	return s.UpdateContext(context.Background(), id, update)
+}
+
+// UpdateContext updates an existing incident type by ID or name, e.g. to
+// enable or disable it or change its display name.
+func (s *IncidentTypeService) UpdateContext(ctx context.Context, id string, update *UpdateIncidentType) (*IncidentType, *Response, error) {
+	if update == nil {
+		return nil, nil, errors.New("an incident type update is required")
+	}
+
+	u := fmt.Sprintf("/incidents/types/%s", id)
+	v := new(IncidentTypePayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &updateIncidentTypePayload{IncidentType: update}, &v, WithEarlyAccess(incidentTypesEarlyAccess), WithRoute("/incidents/types/{id}"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.IncidentType, resp, nil
+}
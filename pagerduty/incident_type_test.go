@@ -0,0 +1,147 @@
+package pagerduty
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIncidentTypesList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/types", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "X-Early-Access", "incident-types-early-access")
+		testQueryValue(t, r, "filter", "all")
+		w.Write([]byte(`{"incident_types": [{"id": "P1", "type": "incident_type", "name": "base_incident", "display_name": "Base Incident", "enabled": true}, {"id": "P2", "type": "incident_type", "name": "security_incident", "display_name": "Security Incident", "parent": {"id": "P1", "type": "incident_type_reference"}}]}`))
+	})
+
+	resp, _, err := client.IncidentTypes.List(&ListIncidentTypesOptions{Filter: IncidentTypeFilterAll})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListIncidentTypesResponse{
+		IncidentTypes: []*IncidentType{
+			{ID: "P1", Type: "incident_type", Name: "base_incident", DisplayName: "Base Incident", Enabled: true},
+			{ID: "P2", Type: "incident_type", Name: "security_incident", DisplayName: "Security Incident", Parent: &IncidentTypeReference{ID: "P1", Type: "incident_type_reference"}},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentTypesGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/types/security_incident", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "X-Early-Access", "incident-types-early-access")
+		w.Write([]byte(`{"incident_type": {"id": "P2", "name": "security_incident", "display_name": "Security Incident", "enabled": true}}`))
+	})
+
+	resp, _, err := client.IncidentTypes.Get("security_incident")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &IncidentType{ID: "P2", Name: "security_incident", DisplayName: "Security Incident", Enabled: true}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentTypesCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/types", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "X-Early-Access", "incident-types-early-access")
+		testBody(t, r, `{"incident_type":{"name":"security_incident","display_name":"Security Incident","description":"A security incident","parent_type":"base_incident"}}`)
+		w.Write([]byte(`{"incident_type": {"id": "P2", "name": "security_incident", "display_name": "Security Incident", "description": "A security incident", "enabled": true, "parent": {"id": "P1", "type": "incident_type_reference"}}}`))
+	})
+
+	resp, _, err := client.IncidentTypes.Create(&IncidentType{
+		Name:        "security_incident",
+		DisplayName: "Security Incident",
+		Description: "A security incident",
+		ParentType:  "base_incident",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &IncidentType{
+		ID:          "P2",
+		Name:        "security_incident",
+		DisplayName: "Security Incident",
+		Description: "A security incident",
+		Enabled:     true,
+		Parent:      &IncidentTypeReference{ID: "P1", Type: "incident_type_reference"},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+
+	if _, _, err := client.IncidentTypes.Create(&IncidentType{Name: "security_incident"}); err == nil {
+		t.Error("expected an error for an incident type without display name and parent type")
+	}
+}
+
+func TestIncidentTypesUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/types/P2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "X-Early-Access", "incident-types-early-access")
+		testBody(t, r, `{"incident_type":{"display_name":"Security","enabled":false}}`)
+		w.Write([]byte(`{"incident_type": {"id": "P2", "name": "security_incident", "display_name": "Security"}}`))
+	})
+
+	displayName, enabled := "Security", false
+	resp, _, err := client.IncidentTypes.Update("P2", &UpdateIncidentType{DisplayName: &displayName, Enabled: &enabled})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &IncidentType{ID: "P2", Name: "security_incident", DisplayName: "Security"}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentsCreateWithIncidentType(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "X-Early-Access", "incident-types-early-access")
+		testBody(t, r, `{"incident":{"type":"incident","title":"Leaked credentials","service":{"id":"PIJ90N7","type":"service_reference"},"incident_type":{"name":"security_incident"}}}`)
+		w.Write([]byte(`{"incident": {"id": "PT4KHLK", "incident_type": {"name": "security_incident"}}}`))
+	})
+
+	resp, _, err := client.Incidents.Create("user@example.com", &CreateIncident{
+		Title:        "Leaked credentials",
+		Service:      &ServiceReference{ID: "PIJ90N7", Type: "service_reference"},
+		IncidentType: &IncidentTypeReference{Name: "security_incident"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Incident{ID: "PT4KHLK", IncidentType: &IncidentTypeReference{Name: "security_incident"}}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
@@ -115,7 +115,7 @@ func (s *IncidentWorkflowService) ListContext(ctx context.Context, o *ListIncide
 	}
 
 	if o.Limit != 0 {
-		resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, &v)
+		resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/incident_workflows"))
 		if err != nil {
 			return nil, nil, err
 		}
@@ -126,18 +126,18 @@ func (s *IncidentWorkflowService) ListContext(ctx context.Context, o *ListIncide
 
 		// Create a handler closure capable of parsing data from the workflows endpoint
 		// and appending resultant response plays to the return slice.
-		responseHandler := func(response *Response) (ListResp, *Response, error) {
+		responseHandler := func(response *Response) (PaginationMeta, *Response, error) {
 			var result ListIncidentWorkflowResponse
 
 			if err := s.client.DecodeJSON(response, &result); err != nil {
-				return ListResp{}, response, err
+				return PaginationMeta{}, response, err
 			}
 
 			workflows = append(workflows, result.IncidentWorkflows...)
 
 			// Return stats on the current page. Caller can use this information to
 			// adjust for requesting additional pages.
-			return ListResp{
+			return PaginationMeta{
 				More:   result.More,
 				Offset: result.Offset,
 				Limit:  result.Limit,
@@ -145,7 +145,7 @@ func (s *IncidentWorkflowService) ListContext(ctx context.Context, o *ListIncide
 		}
 		err := s.client.newRequestPagedGetQueryDoContext(ctx, u, responseHandler, &listIncidentWorkflowOptionsGen{
 			options: o,
-		})
+		}, WithRoute("/incident_workflows"))
 		if err != nil {
 			return nil, nil, err
 		}
@@ -165,7 +165,7 @@ func (s *IncidentWorkflowService) GetContext(ctx context.Context, id string) (*I
 	u := fmt.Sprintf("/incident_workflows/%s", id)
 	v := new(IncidentWorkflowPayload)
 
-	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, v, WithRoute("/incident_workflows/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -183,7 +183,7 @@ func (s *IncidentWorkflowService) CreateContext(ctx context.Context, iw *Inciden
 	u := "/incident_workflows"
 	v := new(IncidentWorkflowPayload)
 
-	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, &IncidentWorkflowPayload{IncidentWorkflow: iw}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &IncidentWorkflowPayload{IncidentWorkflow: iw}, &v, WithRoute("/incident_workflows"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -199,7 +199,7 @@ func (s *IncidentWorkflowService) Delete(id string) (*Response, error) {
 // DeleteContext removes an existing incident workflow.
 func (s *IncidentWorkflowService) DeleteContext(ctx context.Context, id string) (*Response, error) {
 	u := fmt.Sprintf("/incident_workflows/%s", id)
-	return s.client.newRequestDoContext(ctx, "DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/incident_workflows/{id}"))
 }
 
 // Update updates an existing incident workflow.
@@ -212,7 +212,7 @@ func (s *IncidentWorkflowService) UpdateContext(ctx context.Context, id string,
 	u := fmt.Sprintf("/incident_workflows/%s", id)
 	v := new(IncidentWorkflowPayload)
 
-	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, &IncidentWorkflowPayload{IncidentWorkflow: iw}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &IncidentWorkflowPayload{IncidentWorkflow: iw}, &v, WithRoute("/incident_workflows/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -81,7 +81,7 @@ func (s *IncidentWorkflowTriggerService) ListContext(ctx context.Context, o *Lis
 	}
 
 	if o.Limit != 0 {
-		resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, &v)
+		resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/incident_workflows/triggers"))
 		if err != nil {
 			return nil, nil, err
 		}
@@ -110,7 +110,7 @@ func (s *IncidentWorkflowTriggerService) ListContext(ctx context.Context, o *Lis
 		}
 		err := s.client.newRequestCursorPagedGetQueryDoContext(ctx, u, responseHandler, &listIncidentWorkflowTriggerOptionsGen{
 			options: o,
-		})
+		}, WithRoute("/incident_workflows/triggers"))
 		if err != nil {
 			return nil, nil, err
 		}
@@ -130,7 +130,7 @@ func (s *IncidentWorkflowTriggerService) GetContext(ctx context.Context, id stri
 	u := fmt.Sprintf("/incident_workflows/triggers/%s", id)
 	v := new(IncidentWorkflowTriggerPayload)
 
-	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, v, WithRoute("/incident_workflows/triggers/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -148,7 +148,7 @@ func (s *IncidentWorkflowTriggerService) CreateContext(ctx context.Context, t *I
 	u := "/incident_workflows/triggers"
 	v := new(IncidentWorkflowTriggerPayload)
 
-	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, &t, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &t, &v, WithRoute("/incident_workflows/triggers"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -164,7 +164,7 @@ func (s *IncidentWorkflowTriggerService) Delete(id string) (*Response, error) {
 // DeleteContext removes an existing incident workflow trigger.
 func (s *IncidentWorkflowTriggerService) DeleteContext(ctx context.Context, id string) (*Response, error) {
 	u := fmt.Sprintf("/incident_workflows/triggers/%s", id)
-	return s.client.newRequestDoContext(ctx, "DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/incident_workflows/triggers/{id}"))
 }
 
 // Update updates an existing incident workflow trigger.
@@ -177,7 +177,7 @@ func (s *IncidentWorkflowTriggerService) UpdateContext(ctx context.Context, id s
 	u := fmt.Sprintf("/incident_workflows/triggers/%s", id)
 	v := new(IncidentWorkflowTriggerPayload)
 
-	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, &t, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &t, &v, WithRoute("/incident_workflows/triggers/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
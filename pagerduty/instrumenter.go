@@ -0,0 +1,72 @@
+package pagerduty
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Instrumenter observes every attempt of every API call, e.g. to record
+// request metrics. See Config.Instrumenter.
+type Instrumenter interface {
+	// ObserveRequest is called after every attempt, including retries.
+	// route is the templated path of the request, such as "/users/{id}", to
+	// keep metric label cardinality low. status is zero when no response was
+	// received, attempt starts at one.
+	ObserveRequest(method, route string, status, attempt int, duration time.Duration, err error)
+}
+
+// nopInstrumenter is the Instrumenter used when Config.Instrumenter is nil.
+type nopInstrumenter struct{}
+
+func (nopInstrumenter) ObserveRequest(method, route string, status, attempt int, duration time.Duration, err error) {
+}
+
+type routeContextKey struct{}
+
+// WithRoute returns a request option that sets the templated route reported
+// to the Instrumenter, such as "/users/{id}". Service methods set the route
+// of their endpoint; set it on requests made with Client.Do, whose route is
+// otherwise guessed from their path.
+func WithRoute(route string) RequestOptions {
+	return RequestOptions{
+		Type:  "route",
+		Value: route,
+	}
+}
+
+// withRouteOptions returns options preceded by a WithRoute(route) option, so
+// that a route passed by the caller takes precedence.
+func withRouteOptions(route string, options []RequestOptions) []RequestOptions {
+	return append([]RequestOptions{WithRoute(route)}, options...)
+}
+
+// routeLiteral matches path segments that are part of a route rather than
+// an identifier: PagerDuty routes are lower case snake_case, while IDs are
+// upper case (PXPGF42), numeric or UUIDs.
+var routeLiteral = regexp.MustCompile(`^[a-z_]+$`)
+
+// route returns the templated route of req, set with WithRoute, or else
+// guessed by replacing the segments of its path that do not look like a
+// route literal with "{id}".
+func (c *Client) route(req *http.Request) string {
+	if route, ok := req.Context().Value(routeContextKey{}).(string); ok {
+		return route
+	}
+
+	path := strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(c.baseURL.Path, "/"))
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		if s != "" && !routeLiteral.MatchString(s) {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// withRoute attaches a templated route to ctx.
+func withRoute(ctx context.Context, route string) context.Context {
+	return context.WithValue(ctx, routeContextKey{}, route)
+}
@@ -0,0 +1,174 @@
+package pagerduty
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// promInstrumenter shows how to record Prometheus style metrics: a counter
+// and a latency histogram labeled by method, route and status code. With
+// the Prometheus client, the maps would be a CounterVec and a HistogramVec.
+type promInstrumenter struct {
+	mu        sync.Mutex
+	requests  map[[3]string]int
+	durations map[[3]string][]time.Duration
+	attempts  []int
+}
+
+func newPromInstrumenter() *promInstrumenter {
+	return &promInstrumenter{
+		requests:  make(map[[3]string]int),
+		durations: make(map[[3]string][]time.Duration),
+	}
+}
+
+func (p *promInstrumenter) ObserveRequest(method, route string, status, attempt int, duration time.Duration, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	labels := [3]string{method, route, strconv.Itoa(status)}
+	p.requests[labels]++
+	p.durations[labels] = append(p.durations[labels], duration)
+	p.attempts = append(p.attempts, attempt)
+}
+
+func TestInstrumenter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	p := newPromInstrumenter()
+	client.instrumenter = p
+
+	mux.HandleFunc("/users/PXPGF42/contact_methods/PXPGF43", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"contact_method": {"id": "PXPGF43"}}`))
+	})
+	mux.HandleFunc("/users/P1D3Z4B/contact_methods/PZMO0JF", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"contact_method": {"id": "PZMO0JF"}}`))
+	})
+
+	if _, _, err := client.Users.GetContactMethod("PXPGF42", "PXPGF43"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Users.GetContactMethod("P1D3Z4B", "PZMO0JF"); err != nil {
+		t.Fatal(err)
+	}
+
+	labels := [3]string{"GET", "/users/{id}/contact_methods/{id}", "200"}
+	if got := p.requests[labels]; got != 2 {
+		t.Errorf("requests%v = %d, want 2 (got %v)", labels, got, p.requests)
+	}
+}
+
+func TestInstrumenterServiceRoutes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	p := newPromInstrumenter()
+	client.instrumenter = p
+
+	mux.HandleFunc("/users/abc_def", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"user": {"id": "abc_def"}}`))
+	})
+	mux.HandleFunc("/incidents/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"incident": {"id": "1"}}`))
+	})
+	mux.HandleFunc("/v2/enqueue", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"status": "success", "dedup_key": "foo"}`))
+	})
+
+	if _, _, err := client.Users.Get("abc_def", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Incidents.Update("1", "foo@bar.com", &ManageIncident{}, WithRoute("/incidents/{incident_id}")); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Events.EnqueueEvent("foo", &V2Event{EventAction: EventActionResolve, DedupKey: "foo"}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, labels := range [][3]string{
+		{"GET", "/users/{id}", "200"},
+		{"PUT", "/incidents/{incident_id}", "200"},
+		{"POST", "/v2/enqueue", "202"},
+	} {
+		if got := p.requests[labels]; got != 1 {
+			t.Errorf("requests%v = %d, want 1 (got %v)", labels, got, p.requests)
+		}
+	}
+}
+
+func TestInstrumenterRetries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	p := newPromInstrumenter()
+	client.instrumenter = p
+	useFakeClock(client)
+
+	calls := 0
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"error": {"code": 2020, "message": "Rate Limit Exceeded"}}`))
+			return
+		}
+		w.Write([]byte(`{"abilities": []}`))
+	})
+
+	if _, _, err := client.Abilities.List(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := p.requests[[3]string{"GET", "/abilities", "429"}]; got != 2 {
+		t.Errorf("429 requests = %d, want 2", got)
+	}
+	if got := p.requests[[3]string{"GET", "/abilities", "200"}]; got != 1 {
+		t.Errorf("200 requests = %d, want 1", got)
+	}
+	if len(p.attempts) != 3 || p.attempts[0] != 1 || p.attempts[2] != 3 {
+		t.Errorf("attempts = %v, want [1 2 3]", p.attempts)
+	}
+}
+
+func TestRoute(t *testing.T) {
+	c, err := NewClient(&Config{BaseURL: "https://example.com/pd/", Token: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		path string
+		want string
+	}{
+		{"/users/me", "/users/me"},
+		{"/users/PXPGF42", "/users/{id}"},
+		{"/teams/P1/users/P2", "/teams/{id}/users/{id}"},
+		{"/event_orchestrations/services/PXPGF42", "/event_orchestrations/services/{id}"},
+		{"/event_orchestrations/9c3b-41d2/cache_variables", "/event_orchestrations/{id}/cache_variables"},
+		{"/pd/schedules/PI7DH85/overrides", "/schedules/{id}/overrides"},
+	}
+
+	for _, tc := range cases {
+		req, err := http.NewRequest("GET", "https://example.com"+tc.path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := c.route(req); got != tc.want {
+			t.Errorf("route(%q) = %q, want %q", tc.path, got, tc.want)
+		}
+	}
+
+	req, err := c.newRequestContext(context.Background(), "GET", "/users/PXPGF42/sessions/mobile/1", nil, WithRoute("/users/{id}/sessions/{type}/{id}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := c.route(req); got != "/users/{id}/sessions/{type}/{id}" {
+		t.Errorf("route = %q, want the route set by WithRoute", got)
+	}
+}
@@ -0,0 +1,92 @@
+package pagerduty
+
+// ListFetcher requests a single page of an offset paginated list endpoint.
+type ListFetcher[T any] func(ListOptions) ([]T, PaginationMeta, *Response, error)
+
+// Iterator iterates over all records of an offset paginated list endpoint,
+// requesting the next page whenever the current one is used up.
+//
+//	it := client.Users.Iter(&ListUsersOptions{})
+//	for it.Next() {
+//		user := it.Value()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	fetch ListFetcher[T]
+	opts  ListOptions
+
+	page    []T
+	current T
+	more    bool
+	started bool
+	resp    *Response
+	err     error
+}
+
+// Iterate returns an Iterator over the records returned by fetch, starting
+// at opts. Like ListPages, it stops with a *PaginationLimitError when the
+// next page is beyond the maximum offset supported by the API.
+func Iterate[T any](opts ListOptions, fetch ListFetcher[T]) *Iterator[T] {
+	return &Iterator[T]{
+		fetch: fetch,
+		opts:  opts,
+		more:  true,
+	}
+}
+
+// Next advances to the next record, requesting a new page if necessary. It
+// returns false when there are no more records or an error occurred.
+func (it *Iterator[T]) Next() bool {
+	for len(it.page) == 0 {
+		if it.err != nil || !it.more {
+			return false
+		}
+		it.fetchPage()
+	}
+
+	it.current, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+func (it *Iterator[T]) fetchPage() {
+	if it.started && it.opts.Offset+it.opts.Limit > maxPaginationOffset {
+		it.err = &PaginationLimitError{Offset: it.opts.Offset, Limit: it.opts.Limit}
+		return
+	}
+	it.started = true
+
+	page, meta, resp, err := it.fetch(it.opts)
+	it.resp = resp
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	limit := meta.Limit
+	if limit == 0 {
+		limit = len(page)
+	}
+	it.page = page
+	// An empty page would never advance the offset.
+	it.more = meta.More && len(page) > 0
+	it.opts.Offset = meta.Offset + limit
+	it.opts.Limit = limit
+}
+
+// Value returns the current record.
+func (it *Iterator[T]) Value() T {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Response returns the response of the most recently requested page.
+func (it *Iterator[T]) Response() *Response {
+	return it.resp
+}
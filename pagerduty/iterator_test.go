@@ -0,0 +1,107 @@
+package pagerduty
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIterate(t *testing.T) {
+	records := []int{1, 2, 3, 4, 5}
+
+	var requested []ListOptions
+	it := Iterate(ListOptions{Limit: 2}, func(o ListOptions) ([]int, PaginationMeta, *Response, error) {
+		requested = append(requested, o)
+		end := o.Offset + o.Limit
+		if end > len(records) {
+			end = len(records)
+		}
+		return records[o.Offset:end], PaginationMeta{Limit: o.Limit, Offset: o.Offset, More: end < len(records)}, nil, nil
+	})
+
+	var got []int
+	for it.Next() {
+		got = append(got, it.Value())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, records) {
+		t.Errorf("got %v, want %v", got, records)
+	}
+	want := []ListOptions{{Limit: 2}, {Limit: 2, Offset: 2}, {Limit: 2, Offset: 4}}
+	if !reflect.DeepEqual(requested, want) {
+		t.Errorf("requested %v, want %v", requested, want)
+	}
+}
+
+func TestIterateError(t *testing.T) {
+	wantErr := errors.New("boom")
+	calls := 0
+	it := Iterate(ListOptions{}, func(o ListOptions) ([]string, PaginationMeta, *Response, error) {
+		calls++
+		if calls == 2 {
+			return nil, PaginationMeta{}, nil, wantErr
+		}
+		return []string{"a"}, PaginationMeta{Limit: 1, Offset: o.Offset, More: true}, nil, nil
+	})
+
+	n := 0
+	for it.Next() {
+		n++
+	}
+	if n != 1 || !errors.Is(it.Err(), wantErr) {
+		t.Errorf("got %d records and error %v, want 1 record and %v", n, it.Err(), wantErr)
+	}
+	if it.Next() || calls != 2 {
+		t.Errorf("Next continued after an error, calls = %d", calls)
+	}
+}
+
+func TestIteratePaginationLimit(t *testing.T) {
+	it := Iterate(ListOptions{Limit: 100, Offset: 9900}, func(o ListOptions) ([]int, PaginationMeta, *Response, error) {
+		return make([]int, 100), PaginationMeta{Limit: 100, Offset: o.Offset, More: true}, nil, nil
+	})
+
+	n := 0
+	for it.Next() {
+		n++
+	}
+	var limitErr *PaginationLimitError
+	if n != 100 || !errors.As(it.Err(), &limitErr) {
+		t.Errorf("got %d records and error %v, want 100 records and a pagination limit error", n, it.Err())
+	}
+}
+
+func TestUsersIter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "query", "foo")
+		switch r.URL.Query().Get("offset") {
+		case "":
+			w.Write([]byte(`{"users":[{"id":"P1"},{"id":"P2"}],"limit":2,"offset":0,"more":true}`))
+		case "2":
+			w.Write([]byte(`{"users":[{"id":"P3"}],"limit":2,"offset":2,"more":false}`))
+		default:
+			t.Fatalf("unexpected offset %q", r.URL.Query().Get("offset"))
+		}
+	})
+
+	var ids []string
+	it := client.Users.Iter(&ListUsersOptions{Query: "foo"})
+	for it.Next() {
+		ids = append(ids, it.Value().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"P1", "P2", "P3"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("got %v, want %v", ids, want)
+	}
+}
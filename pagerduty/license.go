@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"context"
 	"log"
 )
 
@@ -58,10 +59,15 @@ type ListResponse struct {
 
 // List lists existing Licenses.
 func (s *LicenseService) List() ([]*License, *Response, error) {
+	return s.ListContext(context.Background())
+}
+
+// ListContext lists existing Licenses.
+func (s *LicenseService) ListContext(ctx context.Context) ([]*License, *Response, error) {
 	u := "/licenses"
 	v := new(ListResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/licenses"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -71,10 +77,15 @@ func (s *LicenseService) List() ([]*License, *Response, error) {
 
 // ListAllocations lists existing LicenseAllocations.
 func (s *LicenseService) ListAllocations(o *ListLicenseAllocationsOptions) (*ListLicenseAllocationsResponse, *Response, error) {
+	return s.ListAllocationsContext(context.Background(), o)
+}
+
+// ListAllocationsContext lists existing LicenseAllocations.
+func (s *LicenseService) ListAllocationsContext(ctx context.Context, o *ListLicenseAllocationsOptions) (*ListLicenseAllocationsResponse, *Response, error) {
 	u := "/license_allocations"
 	v := new(ListLicenseAllocationsResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/license_allocations"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -84,12 +95,17 @@ func (s *LicenseService) ListAllocations(o *ListLicenseAllocationsOptions) (*Lis
 
 // ListAllAllocations lists all existing LicenseAllocations for an Account.
 func (s *LicenseService) ListAllAllocations(o *ListLicenseAllocationsOptions) ([]*LicenseAllocation, error) {
+	return s.ListAllAllocationsContext(context.Background(), o)
+}
+
+// ListAllAllocationsContext lists all existing LicenseAllocations for an Account.
+func (s *LicenseService) ListAllAllocationsContext(ctx context.Context, o *ListLicenseAllocationsOptions) ([]*LicenseAllocation, error) {
 	o.More, o.Offset = true, 0
 	var licenseAllocations = make([]*LicenseAllocation, 0, o.Limit)
 
 	for o.More {
 		log.Printf("==== Getting license_allocations at offset %d", o.Offset)
-		v, _, err := s.ListAllocations(o)
+		v, _, err := s.ListAllocationsContext(ctx, o)
 		if err != nil {
 			return licenseAllocations, err
 		}
@@ -1,6 +1,9 @@
 package pagerduty
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // MaintenanceWindowService handles the communication with add-on related methods
 // of the PagerDuty API.
@@ -48,10 +51,15 @@ type MaintenanceWindowPayload struct {
 
 // List lists existing maintenance windows.
 func (s *MaintenanceWindowService) List(o *ListMaintenanceWindowsOptions) (*ListMaintenanceWindowsResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists existing maintenance windows.
+func (s *MaintenanceWindowService) ListContext(ctx context.Context, o *ListMaintenanceWindowsOptions) (*ListMaintenanceWindowsResponse, *Response, error) {
 	u := "/maintenance_windows"
 	v := new(ListMaintenanceWindowsResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/maintenance_windows"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -61,10 +69,15 @@ func (s *MaintenanceWindowService) List(o *ListMaintenanceWindowsOptions) (*List
 
 // Create creates a new maintenancce window.
 func (s *MaintenanceWindowService) Create(maintenanceWindow *MaintenanceWindow) (*MaintenanceWindow, *Response, error) {
+	return s.CreateContext(context.Background(), maintenanceWindow)
+}
+
+// CreateContext creates a new maintenancce window.
+func (s *MaintenanceWindowService) CreateContext(ctx context.Context, maintenanceWindow *MaintenanceWindow) (*MaintenanceWindow, *Response, error) {
 	u := "/maintenance_windows"
 	v := new(MaintenanceWindowPayload)
 
-	resp, err := s.client.newRequestDo("POST", u, nil, &MaintenanceWindowPayload{MaintenanceWindow: maintenanceWindow}, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &MaintenanceWindowPayload{MaintenanceWindow: maintenanceWindow}, v, WithRoute("/maintenance_windows"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -74,16 +87,26 @@ func (s *MaintenanceWindowService) Create(maintenanceWindow *MaintenanceWindow)
 
 // Delete removes an existing maintenance window.
 func (s *MaintenanceWindowService) Delete(id string) (*Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext removes an existing maintenance window.
+func (s *MaintenanceWindowService) DeleteContext(ctx context.Context, id string) (*Response, error) {
 	u := fmt.Sprintf("/maintenance_windows/%s", id)
-	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/maintenance_windows/{id}"))
 }
 
 // Get retrieves information about a maintenance window.
 func (s *MaintenanceWindowService) Get(id string) (*MaintenanceWindow, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext retrieves information about a maintenance window.
+func (s *MaintenanceWindowService) GetContext(ctx context.Context, id string) (*MaintenanceWindow, *Response, error) {
 	u := fmt.Sprintf("/maintenance_windows/%s", id)
 	v := new(MaintenanceWindowPayload)
 
-	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/maintenance_windows/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -93,9 +116,14 @@ func (s *MaintenanceWindowService) Get(id string) (*MaintenanceWindow, *Response
 
 // Update updates an existing maintenance window.
 func (s *MaintenanceWindowService) Update(id string, maintenanceWindow *MaintenanceWindow) (*MaintenanceWindow, *Response, error) {
+	return s.UpdateContext(context.Background(), id, maintenanceWindow)
+}
+
+// UpdateContext updates an existing maintenance window.
+func (s *MaintenanceWindowService) UpdateContext(ctx context.Context, id string, maintenanceWindow *MaintenanceWindow) (*MaintenanceWindow, *Response, error) {
 	u := fmt.Sprintf("/maintenance_windows/%s", id)
 	v := new(MaintenanceWindowPayload)
-	resp, err := s.client.newRequestDo("PUT", u, nil, &MaintenanceWindowPayload{MaintenanceWindow: maintenanceWindow}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &MaintenanceWindowPayload{MaintenanceWindow: maintenanceWindow}, &v, WithRoute("/maintenance_windows/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1,5 +1,7 @@
 package pagerduty
 
+import "context"
+
 // OnCallService handles the communication with team
 // related methods of the PagerDuty API.
 type OnCallService service
@@ -20,10 +22,10 @@ type ListOnCallOptions struct {
 	Offset              int      `url:"offset,omitempty"`
 	Total               bool     `url:"total,omitempty"`
 	Earliest            bool     `url:"earliest,omitempty"`
-	EscalationPolicyIds []string `url:"escalation_policy_ids,omitempty"`
-	Includes            []string `url:"include,omitempty"`
-	ScheduleIds         []string `url:"schedule_ids,omitempty"`
-	UserIds             []string `url:"user_ids,brackets,omitempty"`
+	EscalationPolicyIds []string `url:"escalation_policy_ids,omitempty,brackets"`
+	Includes            []string `url:"include,omitempty,brackets"`
+	ScheduleIds         []string `url:"schedule_ids,omitempty,brackets"`
+	UserIds             []string `url:"user_ids,omitempty,brackets"`
 	Since               string   `url:"since,omitempty"`
 	TimeZone            string   `url:"time_zone,omitempty"`
 	Until               string   `url:"until,omitempty"`
@@ -40,10 +42,15 @@ type ListOnCallResponse struct {
 
 // List lists existing oncalls.
 func (s *OnCallService) List(o *ListOnCallOptions) (*ListOnCallResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists existing oncalls.
+func (s *OnCallService) ListContext(ctx context.Context, o *ListOnCallOptions) (*ListOnCallResponse, *Response, error) {
 	u := "/oncalls"
 	v := new(ListOnCallResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/oncalls"))
 	if err != nil {
 		return nil, nil, err
 	}
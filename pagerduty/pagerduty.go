@@ -2,16 +2,21 @@ package pagerduty
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"math/rand"
+	"net"
 	"net/http"
 	"net/url"
+	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -19,12 +24,25 @@ import (
 	"github.com/spf13/afero"
 )
 
+// defaultUserAgent identifies this library and the Go version it was built
+// with, e.g. "go-pagerduty/0.1.0 Go/1.18.10".
+var defaultUserAgent = "go-pagerduty/" + Version + " Go/" + strings.TrimPrefix(runtime.Version(), "go")
+
 const (
 	defaultBaseURL                    = "https://api.pagerduty.com"
 	defaultAppOauthTokenGenerationURL = "https://identity.pagerduty.com/oauth/token"
-	defaultUserAgent                  = "heimweh/go-pagerduty(terraform)"
+	defaultEventsBaseURL              = "https://events.pagerduty.com"
 	defaultRegion                     = "us"
+	defaultAPIVersion                 = "2"
+	defaultMaxRetries                 = 5
+	defaultRetryMaxWait               = 30 * time.Second
+	retryBaseWait                     = 500 * time.Millisecond
+	maxPaginationOffset               = 10000
+	defaultMaxResponseBytes           = 50 << 20
+	maxErrorBodyBytes                 = 64 << 10
+	maxErrorBodySnippet               = 512
 	jitterPercent                     = 0.3
+	earlyAccessHeader                 = "X-Early-Access"
 )
 
 // AuthTokenType is an enum of available tokens types
@@ -53,21 +71,127 @@ type service struct {
 
 // Config represents the configuration for a PagerDuty client
 type Config struct {
-	BaseURL                   string
-	HTTPClient                *http.Client
-	Token                     string
+	BaseURL    string
+	HTTPClient *http.Client
+	Token      string
+	// UserAgent identifies the caller. It is appended to the default
+	// User-Agent header, which identifies this library.
 	UserAgent                 string
 	Debug                     bool
 	APIAuthTokenType          *AuthTokenType
 	AppOauthScopedTokenParams *persistentconfig.AppOauthScopedTokenParams
 	clientPersistentConfig    *persistentconfig.ClientPersistentConfig
+
+	// OAuthToken is an OAuth access token, sent as "Authorization: Bearer
+	// <token>" instead of the "Token token=<token>" format used for REST API
+	// keys. Exactly one of Token, OAuthToken, TokenSource or
+	// AppOauthScopedTokenParams must be configured.
+	OAuthToken string
+
+	// TokenSource supplies OAuth access tokens per request, for tokens that
+	// expire and need refreshing, see ClientCredentialsTokenSource.
+	TokenSource TokenSource
+
+	// RequestsPerMinute, when positive, limits the rate at which requests,
+	// including retries, are sent by this client. Zero disables limiting.
+	RequestsPerMinute int
+
+	// RateLimiter, when set, is waited on before every request, including
+	// retries, instead of the limiter configured by RequestsPerMinute.
+	RateLimiter RateLimiter
+
+	// ETagCache enables conditional GET requests: the ETag and body of GET
+	// responses are remembered, the ETag is sent as If-None-Match on the
+	// next identical request and a 304 Not Modified reply is served from the
+	// cache. ETagCacheMaxEntries bounds the cache, 1000 entries by default.
+	ETagCache           bool
+	ETagCacheMaxEntries int
+
+	// Instrumenter, when set, is called after every request attempt, e.g.
+	// to record metrics.
+	Instrumenter Instrumenter
+
+	// DefaultFromEmail, when set, is sent as the From header of every
+	// mutating request that does not set one explicitly. Several endpoints
+	// use it to attribute the action to a user.
+	DefaultFromEmail string
+
+	// SkipCredentialCheck allows creating a client without any credentials,
+	// for when authentication is added by a custom HTTPClient transport.
+	SkipCredentialCheck bool
+
+	// Logger, when set, receives the method, URL, headers and body of every
+	// request and response, as well as retry attempts. The Authorization
+	// header is always redacted.
+	Logger Logger
+
+	// EventsBaseURL is the base URL of the Events API used by the Events
+	// service, "https://events.pagerduty.com" by default.
+	EventsBaseURL string
+
+	// APIVersion is the REST API version requested in the Accept header of
+	// every request, "2" by default. Use WithAPIVersion or an Accept header
+	// option to override it for a single request.
+	APIVersion string
+
+	// AbilitiesCacheTTL, when positive, is how long Abilities.List reuses
+	// the abilities of the account before requesting them again. Zero
+	// disables caching.
+	AbilitiesCacheTTL time.Duration
+
+	// DisableCompression stops the client from asking for gzip compressed
+	// responses, e.g. behind proxies that mangle encoded bodies.
+	DisableCompression bool
+
+	// MaxResponseBytes limits the size of a successful response body, after
+	// decompression. Larger responses fail with an error matching
+	// ErrResponseTooLarge. Defaults to 50 MB.
+	MaxResponseBytes int64
+
+	// EarlyAccessFeatures opts every request into the given early access
+	// features of the API, e.g. "status-pages-early-access", through the
+	// X-Early-Access header. Use WithEarlyAccess to opt in per request.
+	EarlyAccessFeatures []string
+
+	// MaxRetries is the maximum number of times a rate limited (HTTP 429)
+	// or, when RetryServerErrors is set, a failed (HTTP 5xx) request is
+	// retried. Zero uses the default of 5, a negative value disables retries.
+	MaxRetries int
+
+	// RetryServerErrors enables retrying idempotent requests (GET, PUT and
+	// DELETE) that failed with a transient server error or a network error,
+	// using exponential backoff with jitter. Requests that never reached the
+	// server because the connection could not be established are retried
+	// regardless of their method.
+	RetryServerErrors bool
+
+	// RetryNonIdempotent also retries POST requests on server and network
+	// errors. A POST may have been processed before the error was returned,
+	// so retrying it can create duplicates. Use the Retryable request option
+	// to opt in for a single request instead.
+	RetryNonIdempotent bool
+
+	// RetryMaxWait caps the backoff between server error retries. Zero uses
+	// the default of 30 seconds.
+	RetryMaxWait time.Duration
+
+	// RetryPolicy, when set, takes precedence over MaxRetries and
+	// RetryMaxWait and can limit the total time spent retrying. It can be
+	// overridden per request with WithRetryPolicy.
+	RetryPolicy *RetryPolicy
 }
 
 // Client manages the communication with the PagerDuty API
 type Client struct {
-	baseURL                          *url.URL
-	client                           *http.Client
-	Config                           *Config
+	baseURL       *url.URL
+	eventsBaseURL *url.URL
+	client        *http.Client
+	userAgent     string
+
+	// Config is the client's copy of the config passed to NewClient. It must
+	// not be modified while requests are in flight, see SetToken.
+	Config *Config
+
 	Abilities                        *AbilityService
 	Addons                           *AddonService
 	EscalationPolicies               *EscalationPolicyService
@@ -104,23 +228,197 @@ type Client struct {
 	CustomFieldSchemas               *CustomFieldSchemaService
 	CustomFieldSchemaAssignments     *CustomFieldSchemaAssignmentService
 	IncidentCustomFields             *IncidentCustomFieldService
+	IncidentTypes                    *IncidentTypeService
+	Audit                            *AuditService
+	Events                           *EventsService
+
+	rateLimiter        RateLimiter
+	etagCache          *etagCache
+	instrumenter       Instrumenter
+	abilitiesCache     *abilitiesCache
+	credentialsMu      sync.RWMutex
+	requestMiddleware  []RequestMiddleware
+	responseMiddleware []ResponseMiddleware
+
+	// now and sleep are replaced in tests to avoid waiting for retries.
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// userAgent returns the User-Agent header for the given Config.UserAgent
+// suffix.
+func userAgent(suffix string) string {
+	if suffix == "" {
+		return defaultUserAgent
+	}
+	return defaultUserAgent + " " + suffix
+}
+
+// clone returns a copy of the config that shares no mutable state with it.
+func (config *Config) clone() *Config {
+	cfg := *config
+	cfg.EarlyAccessFeatures = append([]string(nil), config.EarlyAccessFeatures...)
+	if config.APIAuthTokenType != nil {
+		tokenType := *config.APIAuthTokenType
+		cfg.APIAuthTokenType = &tokenType
+	}
+	if config.AppOauthScopedTokenParams != nil {
+		params := *config.AppOauthScopedTokenParams
+		cfg.AppOauthScopedTokenParams = &params
+	}
+	if config.RetryPolicy != nil {
+		policy := *config.RetryPolicy
+		cfg.RetryPolicy = &policy
+	}
+	return &cfg
+}
+
+// SetToken replaces the token used to authenticate requests, which is safe
+// to do while requests are in flight. It replaces the OAuth token if the
+// client was created with one, the scoped OAuth access token for the scoped
+// token types and the REST API key otherwise. Clients with a TokenSource
+// ignore it.
+func (c *Client) SetToken(token string) {
+	c.credentialsMu.Lock()
+	defer c.credentialsMu.Unlock()
+
+	switch {
+	case c.Config.OAuthToken != "":
+		c.Config.OAuthToken = token
+	case *c.Config.APIAuthTokenType == AuthTokenTypeUseAppCredentials || *c.Config.APIAuthTokenType == AuthTokenTypeScopedOauthToken:
+		c.Config.AppOauthScopedTokenParams.Token = token
+	default:
+		c.Config.Token = token
+	}
+}
+
+// RequestMiddleware is called with every outgoing request, including
+// retries, right before it is sent. It may modify the request, e.g. to add
+// headers, and aborts the call by returning an error.
+type RequestMiddleware func(*http.Request) error
+
+// ResponseMiddleware is called with every response, including error
+// responses, before it is checked for errors and decoded. It aborts the
+// call by returning an error.
+type ResponseMiddleware func(*Response) error
+
+// UseRequestMiddleware registers middleware that runs, in registration
+// order, before every request. Middleware must be registered before the
+// client is used concurrently.
+func (c *Client) UseRequestMiddleware(m ...RequestMiddleware) {
+	c.requestMiddleware = append(c.requestMiddleware, m...)
+}
+
+// UseResponseMiddleware registers middleware that runs, in registration
+// order, after every response. Middleware must be registered before the
+// client is used concurrently.
+func (c *Client) UseResponseMiddleware(m ...ResponseMiddleware) {
+	c.responseMiddleware = append(c.responseMiddleware, m...)
 }
 
 // Response is a wrapper around http.Response
 type Response struct {
 	Response  *http.Response
 	BodyBytes []byte
+
+	// RequestID is the X-Request-Id header of the response, which PagerDuty
+	// support asks for when investigating a request.
+	RequestID string
 }
 
-// RequestOptions is an object to setting options for HTTP requests
+// RequestOptions is an object to setting options for HTTP requests. Type is
+// either "header", to add a request header, or "query", to add a query
+// parameter; any number of options of both types can be combined. The
+// "retry_policy", "retryable", "route" and "timeout" types are created by
+// WithRetryPolicy, Retryable, WithRoute and WithTimeout.
 type RequestOptions struct {
 	Type  string
 	Label string
 	Value string
+
+	// RetryPolicy is set by WithRetryPolicy, for the "retry_policy" type.
+	RetryPolicy *RetryPolicy
+}
+
+// FromHeader returns a request option that sets the From header, used by
+// PagerDuty to attribute an action to the user with the given email address.
+func FromHeader(email string) RequestOptions {
+	return RequestOptions{
+		Type:  "header",
+		Label: "From",
+		Value: email,
+	}
+}
+
+// WithAPIVersion returns a request option that requests the given REST API
+// version instead of Config.APIVersion. Other media types can be requested
+// with a "header" option for the Accept header.
+func WithAPIVersion(version string) RequestOptions {
+	return RequestOptions{
+		Type:  "header",
+		Label: "Accept",
+		Value: apiMediaType(version),
+	}
+}
+
+// apiMediaType returns the media type of the given REST API version.
+func apiMediaType(version string) string {
+	return "application/vnd.pagerduty+json;version=" + version
+}
+
+// WithEarlyAccess returns a request option that opts into the given early
+// access feature of the API, e.g. "status-pages-early-access". See
+// Config.EarlyAccessFeatures to opt every request in.
+func WithEarlyAccess(feature string) RequestOptions {
+	return RequestOptions{
+		Type:  "header",
+		Label: earlyAccessHeader,
+		Value: feature,
+	}
+}
+
+// WithContentType returns a request option that sets the Content-Type
+// header, for a body that is not JSON.
+func WithContentType(contentType string) RequestOptions {
+	return RequestOptions{
+		Type:  "header",
+		Label: "Content-Type",
+		Value: contentType,
+	}
+}
+
+type timeoutContextKey struct{}
+
+// WithTimeout returns a request option that limits a single request,
+// including any retries, to d. It is equivalent to
+// RequestOptions{Type: "timeout", Value: d.String()}. Use IsTimeout to
+// detect a request that exceeded it.
+func WithTimeout(d time.Duration) RequestOptions {
+	return RequestOptions{
+		Type:  "timeout",
+		Value: d.String(),
+	}
 }
 
-// NewClient returns a new PagerDuty API client.
+// fromOptions returns options preceded by a From header for from, the email
+// of the user the action is attributed to. An empty from falls back to
+// Config.DefaultFromEmail; without either, a descriptive error is returned.
+func (c *Client) fromOptions(action, from string, options []RequestOptions) ([]RequestOptions, error) {
+	if from == "" {
+		if c.Config.DefaultFromEmail == "" {
+			return nil, fmt.Errorf("%s requires the From header: pass the email of the user acting or set Config.DefaultFromEmail", action)
+		}
+		return options, nil
+	}
+	return append([]RequestOptions{FromHeader(from)}, options...), nil
+}
+
+// NewClient returns a new PagerDuty API client. The client works on a copy
+// of config, so changes made to config afterwards are not observed; use
+// SetToken to rotate the token of a client in use.
 func NewClient(config *Config) (*Client, error) {
+	config = config.clone()
+
 	if config.HTTPClient == nil {
 		config.HTTPClient = http.DefaultClient
 	}
@@ -129,20 +427,51 @@ func NewClient(config *Config) (*Client, error) {
 		config.BaseURL = defaultBaseURL
 	}
 
-	if config.UserAgent == "" {
-		config.UserAgent = defaultUserAgent
+	if config.EventsBaseURL == "" {
+		config.EventsBaseURL = defaultEventsBaseURL
+	}
+
+	if config.APIVersion == "" {
+		config.APIVersion = defaultAPIVersion
+	}
+
+	if config.MaxRetries == 0 {
+		config.MaxRetries = defaultMaxRetries
+	}
+
+	if config.RetryMaxWait == 0 {
+		config.RetryMaxWait = defaultRetryMaxWait
+	}
+
+	if config.MaxResponseBytes <= 0 {
+		config.MaxResponseBytes = defaultMaxResponseBytes
 	}
 
 	baseURL, err := url.Parse(config.BaseURL)
 	if err != nil {
 		return nil, err
 	}
+	if (baseURL.Scheme != "http" && baseURL.Scheme != "https") || baseURL.Host == "" {
+		return nil, fmt.Errorf("invalid BaseURL %q: must be an absolute http or https URL", config.BaseURL)
+	}
+
+	eventsBaseURL, err := url.Parse(config.EventsBaseURL)
+	if err != nil {
+		return nil, err
+	}
+	if (eventsBaseURL.Scheme != "http" && eventsBaseURL.Scheme != "https") || eventsBaseURL.Host == "" {
+		return nil, fmt.Errorf("invalid EventsBaseURL %q: must be an absolute http or https URL", config.EventsBaseURL)
+	}
 
 	if config.APIAuthTokenType == nil {
 		defaultTokenType := AuthTokenTypeAPIToken
 		config.APIAuthTokenType = &defaultTokenType
 	}
 
+	if err := validateAuthConfig(config); err != nil {
+		return nil, err
+	}
+
 	if *config.APIAuthTokenType == AuthTokenTypeUseAppCredentials {
 		clientPersistentConfig := persistentconfig.ClientPersistentConfig{
 			Fs: afero.NewOsFs(), // Using host file system
@@ -155,9 +484,32 @@ func NewClient(config *Config) (*Client, error) {
 	}
 
 	c := &Client{
-		baseURL: baseURL,
-		client:  config.HTTPClient,
-		Config:  config,
+		baseURL:       baseURL,
+		eventsBaseURL: eventsBaseURL,
+		client:        config.HTTPClient,
+		userAgent:     userAgent(config.UserAgent),
+		Config:        config,
+		now:           time.Now,
+		sleep:         sleepContext,
+	}
+
+	c.instrumenter = config.Instrumenter
+	if c.instrumenter == nil {
+		c.instrumenter = nopInstrumenter{}
+	}
+
+	if config.AbilitiesCacheTTL > 0 {
+		c.abilitiesCache = &abilitiesCache{ttl: config.AbilitiesCacheTTL}
+	}
+
+	if config.ETagCache {
+		c.etagCache = newETagCache(config.ETagCacheMaxEntries)
+	}
+
+	if config.RateLimiter != nil {
+		c.rateLimiter = config.RateLimiter
+	} else if config.RequestsPerMinute > 0 {
+		c.rateLimiter = newIntervalLimiter(config.RequestsPerMinute)
 	}
 
 	c.Abilities = &AbilityService{c}
@@ -196,6 +548,9 @@ func NewClient(config *Config) (*Client, error) {
 	c.CustomFieldSchemas = &CustomFieldSchemaService{c}
 	c.CustomFieldSchemaAssignments = &CustomFieldSchemaAssignmentService{c}
 	c.IncidentCustomFields = &IncidentCustomFieldService{c}
+	c.IncidentTypes = &IncidentTypeService{c}
+	c.Audit = &AuditService{c}
+	c.Events = &EventsService{c}
 
 	InitCache(c)
 	PopulateCache()
@@ -208,36 +563,177 @@ func (c *Client) newRequest(method, url string, body interface{}, options ...Req
 }
 
 func (c *Client) newRequestContext(ctx context.Context, method, url string, body interface{}, options ...RequestOptions) (*http.Request, error) {
-	var buf io.ReadWriter
-	if body != nil {
-		buf = new(bytes.Buffer)
-		err := json.NewEncoder(buf).Encode(body)
-		if err != nil {
-			return nil, err
-		}
+	b, err := encodeBody(body)
+	if err != nil {
+		return nil, requestError("creating request for", method, url, err)
 	}
 
 	if c.Config.Debug {
-		log.Printf("[DEBUG] PagerDuty - Preparing %s request to %s with body: %s", method, url, buf)
+		log.Printf("[DEBUG] PagerDuty - Preparing %s request to %s with body: %s", method, url, b)
 	}
 
 	u := c.baseURL.String() + url
 
-	req, err := http.NewRequestWithContext(ctx, method, u, buf)
+	// The body is passed as a bytes.Reader so that http.NewRequest sets
+	// GetBody, which allows do() to rewind the body when retrying the request.
+	var r io.Reader
+	if b != nil {
+		r = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, r)
 	if err != nil {
-		return nil, err
+		return nil, requestError("creating request for", method, url, err)
 	}
 
 	if len(options) > 0 {
+		values := req.URL.Query()
+		hasQueryOptions := false
 		for _, o := range options {
-			if o.Type == "header" {
+			switch o.Type {
+			case "header":
 				req.Header.Add(o.Label, o.Value)
+			case "query":
+				values.Add(o.Label, o.Value)
+				hasQueryOptions = true
+			case "retry_policy":
+				req = req.WithContext(withRetryPolicy(req.Context(), o.RetryPolicy))
+			case "route":
+				req = req.WithContext(withRoute(req.Context(), o.Value))
+			case "retryable":
+				req = req.WithContext(context.WithValue(req.Context(), retryableContextKey{}, true))
+			case "timeout":
+				d, err := time.ParseDuration(o.Value)
+				if err != nil || d <= 0 {
+					return nil, fmt.Errorf("invalid request timeout %q", o.Value)
+				}
+				req = req.WithContext(context.WithValue(req.Context(), timeoutContextKey{}, d))
+			default:
+				return nil, fmt.Errorf("unsupported request option type %q for %q", o.Type, o.Label)
 			}
 		}
+		if hasQueryOptions {
+			req.URL.RawQuery = values.Encode()
+		}
+	}
+
+	if c.Config.DefaultFromEmail != "" && req.Header.Get("From") == "" && method != "GET" && method != "HEAD" {
+		req.Header.Set("From", c.Config.DefaultFromEmail)
+	}
+	for _, feature := range c.Config.EarlyAccessFeatures {
+		if !hasHeaderValue(req.Header, earlyAccessHeader, feature) {
+			req.Header.Add(earlyAccessHeader, feature)
+		}
+	}
+	if req.Header.Get("Accept") == "" {
+		req.Header.Set("Accept", apiMediaType(c.Config.APIVersion))
+	}
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Add("User-Agent", c.userAgent)
+	if req.Header.Get("Accept-Encoding") == "" {
+		// Asking for an encoding explicitly turns off the transparent
+		// decompression of http.Transport, doOnce decompresses instead.
+		if c.Config.DisableCompression {
+			req.Header.Set("Accept-Encoding", "identity")
+		} else {
+			req.Header.Set("Accept-Encoding", "gzip")
+		}
+	}
+
+	authHeader, err := c.authHeader()
+	if err != nil {
+		return nil, requestError("creating request for", method, url, err)
+	}
+	if authHeader != "" {
+		req.Header.Add("Authorization", authHeader)
+	}
+
+	return req, nil
+}
+
+// hasHeaderValue reports whether one of the values of the header key is
+// value.
+func hasHeaderValue(h http.Header, key, value string) bool {
+	for _, v := range h.Values(key) {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// encodeBody returns the bytes of a request body. A json.RawMessage, []byte
+// or io.Reader body is sent as is, any other body is encoded as JSON.
+func encodeBody(body interface{}) ([]byte, error) {
+	switch b := body.(type) {
+	case nil:
+		return nil, nil
+	case json.RawMessage:
+		return b, nil
+	case []byte:
+		return b, nil
+	case io.Reader:
+		return io.ReadAll(b)
+	default:
+		return json.Marshal(body)
+	}
+}
+
+// validateAuthConfig checks that exactly one authentication method is
+// configured, so that requests are never silently sent with an empty or
+// ambiguous Authorization header.
+func validateAuthConfig(config *Config) error {
+	var methods []string
+	if config.Token != "" {
+		methods = append(methods, "Token")
+	}
+	if config.OAuthToken != "" {
+		methods = append(methods, "OAuthToken")
+	}
+	if config.TokenSource != nil {
+		methods = append(methods, "TokenSource")
+	}
+	if *config.APIAuthTokenType == AuthTokenTypeScopedOauthToken || *config.APIAuthTokenType == AuthTokenTypeUseAppCredentials {
+		if config.AppOauthScopedTokenParams == nil {
+			return fmt.Errorf("APIAuthTokenType %q requires AppOauthScopedTokenParams to be set", config.APIAuthTokenType.String())
+		}
+		methods = append(methods, "AppOauthScopedTokenParams")
+	}
+
+	switch len(methods) {
+	case 0:
+		if config.SkipCredentialCheck {
+			return nil
+		}
+		return fmt.Errorf("%w: set one of Token, OAuthToken, TokenSource or AppOauthScopedTokenParams, or SkipCredentialCheck when authenticating through a custom HTTPClient", ErrNoToken)
+	case 1:
+		return nil
+	default:
+		return fmt.Errorf("multiple authentication methods configured (%s): set exactly one", strings.Join(methods, ", "))
+	}
+}
+
+func (c *Client) authHeader() (string, error) {
+	if c.Config.TokenSource != nil {
+		token, err := c.Config.TokenSource.Token()
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Bearer %s", token), nil
+	}
+
+	c.credentialsMu.RLock()
+	defer c.credentialsMu.RUnlock()
+
+	if c.Config.OAuthToken != "" {
+		return fmt.Sprintf("Bearer %s", c.Config.OAuthToken), nil
+	}
+
+	if c.Config.Token == "" && c.Config.SkipCredentialCheck && *c.Config.APIAuthTokenType == AuthTokenTypeAPIToken {
+		// Authentication is left to a custom HTTPClient.
+		return "", nil
 	}
-	req.Header.Add("Accept", "application/vnd.pagerduty+json;version=2")
-	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add("User-Agent", c.Config.UserAgent)
 
 	// Defaults to API Token Authorization header configuration
 	authHeader := fmt.Sprintf("Token token=%s", c.Config.Token)
@@ -245,9 +741,7 @@ func (c *Client) newRequestContext(ctx context.Context, method, url string, body
 		log.Printf("[INFO] Pagerduty - Using Scoped Oauth")
 		authHeader = fmt.Sprintf("Bearer %s", c.Config.AppOauthScopedTokenParams.Token)
 	}
-	req.Header.Add("Authorization", authHeader)
-
-	return req, nil
+	return authHeader, nil
 }
 
 type scopedOauthResponse struct {
@@ -264,45 +758,9 @@ func (c *Client) generateScopedOauthAccessToken() error {
 		log.Printf("[INFO] Pagerduty - Using default region %q", defaultRegion)
 		region = defaultRegion
 	}
-	subdomain := aotp.PDSubDomain
-	u := defaultAppOauthTokenGenerationURL
-	scopes := strings.Join(availableOauthScopes(), " ")
-
-	data := url.Values{}
-	data.Add("grant_type", "client_credentials")
-	data.Add("client_id", aotp.ClientID)
-	data.Add("client_secret", aotp.ClientSecret)
-	data.Add("scope", fmt.Sprintf("as_account-%s.%s %s", region, subdomain, scopes))
-	encodedData := data.Encode()
-	payload := strings.NewReader(encodedData)
-
-	req, err := http.NewRequest("POST", u, payload)
-	if err != nil {
-		return err
-	}
-
-	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
-	req.Header.Add("User-Agent", c.Config.UserAgent)
-
-	internalClient := &http.Client{}
-
-	v := new(scopedOauthResponse)
-	resp, err := internalClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode > 400 {
-		return fmt.Errorf("with status code %d", resp.StatusCode)
-	}
-
-	bodyBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return err
-	}
+	scope := fmt.Sprintf("as_account-%s.%s %s", region, aotp.PDSubDomain, strings.Join(availableOauthScopes(), " "))
 
-	err = json.Unmarshal(bodyBytes, v)
+	v, err := requestClientCredentialsToken(context.Background(), &http.Client{}, defaultAppOauthTokenGenerationURL, c.userAgent, aotp.ClientID, aotp.ClientSecret, scope)
 	if err != nil {
 		return err
 	}
@@ -313,7 +771,9 @@ func (c *Client) generateScopedOauthAccessToken() error {
 	// 	return err
 	// }
 	c.Config.clientPersistentConfig.SetCredential("token", v.AccessToken)
+	c.credentialsMu.Lock()
 	c.Config.AppOauthScopedTokenParams.Token = v.AccessToken
+	c.credentialsMu.Unlock()
 
 	return nil
 }
@@ -326,7 +786,7 @@ func (c *Client) newRequestDoContext(ctx context.Context, method, url string, qr
 	if qryOptions != nil {
 		values, err := query.Values(qryOptions)
 		if err != nil {
-			return nil, err
+			return nil, requestError("encoding query for", method, url, err)
 		}
 
 		if v := values.Encode(); v != "" {
@@ -339,16 +799,32 @@ func (c *Client) newRequestDoContext(ctx context.Context, method, url string, qr
 	}
 	resp, err := c.do(req, v)
 	if err != nil {
-		if respErr, ok := err.(*Error); ok && respErr.needToRetry {
-			return c.newRequestDoContext(ctx, method, url, nil, body, v)
-		}
-
 		return nil, err
 	}
 
 	return resp, nil
 }
 
+// Do sends a request to an arbitrary API path, such as an endpoint this
+// library has no service for yet. qryOptions is encoded as the query string
+// using its url struct tags, body is encoded as JSON unless it is a
+// json.RawMessage, []byte or io.Reader, which are sent as is, and the
+// response body is decoded into v. Authentication, retries and error handling are the same
+// as for every other call.
+func (c *Client) Do(method, path string, qryOptions, body, v interface{}, reqOptions ...RequestOptions) (*Response, error) {
+	return c.DoContext(context.Background(), method, path, qryOptions, body, v, reqOptions...)
+}
+
+// DoContext sends a request to an arbitrary API path, such as an endpoint this
+// library has no service for yet. qryOptions is encoded as the query string
+// using its url struct tags, body is encoded as JSON unless it is a
+// json.RawMessage, []byte or io.Reader, which are sent as is, and the
+// response body is decoded into v. Authentication, retries and error handling are the same
+// as for every other call.
+func (c *Client) DoContext(ctx context.Context, method, path string, qryOptions, body, v interface{}, reqOptions ...RequestOptions) (*Response, error) {
+	return c.newRequestDoOptionsContext(ctx, method, path, qryOptions, body, v, reqOptions...)
+}
+
 func (c *Client) newRequestDoOptions(method, url string, qryOptions, body, v interface{}, reqOptions ...RequestOptions) (*Response, error) {
 	return c.newRequestDoOptionsContext(context.Background(), method, url, qryOptions, body, v, reqOptions...)
 }
@@ -357,7 +833,7 @@ func (c *Client) newRequestDoOptionsContext(ctx context.Context, method, url str
 	if qryOptions != nil {
 		values, err := query.Values(qryOptions)
 		if err != nil {
-			return nil, err
+			return nil, requestError("encoding query for", method, url, err)
 		}
 
 		if v := values.Encode(); v != "" {
@@ -371,41 +847,328 @@ func (c *Client) newRequestDoOptionsContext(ctx context.Context, method, url str
 
 	resp, err := c.do(req, v)
 	if err != nil {
-		if respErr, ok := err.(*Error); ok && respErr.needToRetry {
-			return c.newRequestDoOptionsContext(ctx, method, url, nil, body, v)
-		}
-
 		return nil, err
 	}
 
 	return resp, nil
 }
 
+// do sends the request, retrying it while the API asks for a retry (rate
+// limiting, an expired scoped OAuth token or a transient server error) up to
+// the attempts allowed by the retry policy, see Config.RetryPolicy.
 func (c *Client) do(req *http.Request, v interface{}) (*Response, error) {
+	if timeout, ok := req.Context().Value(timeoutContextKey{}).(time.Duration); ok {
+		ctx, cancel := context.WithTimeout(req.Context(), timeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+
+	policy := c.retryPolicy(req)
+	route := c.route(req)
+	start := c.now()
+	tokenRefreshed := false
+	for attempt := 1; ; attempt++ {
+		attemptStart := c.now()
+		resp, err := c.doOnce(req, v)
+
+		status := 0
+		if resp != nil {
+			status = resp.Response.StatusCode
+		}
+		c.instrumenter.ObserveRequest(req.Method, route, status, attempt, c.now().Sub(attemptStart), err)
+
+		// A 401 with a caching token source usually means the cached token
+		// expired or was revoked early, so retry once with a fresh token.
+		if !tokenRefreshed && resp != nil && resp.Response.StatusCode == http.StatusUnauthorized {
+			if ti, ok := c.Config.TokenSource.(tokenInvalidator); ok {
+				ti.Invalidate()
+				tokenRefreshed = true
+				c.logRetry(req, "token rejected with HTTP 401", 0)
+				if err := c.prepareRetry(req); err != nil {
+					return resp, requestError("retrying", req.Method, req.URL.Path, err)
+				}
+				continue
+			}
+		}
+
+		wait, retry := c.retryWait(req, err, attempt, policy)
+		if !retry {
+			return resp, err
+		}
+
+		if apiErr, ok := err.(*APIError); ok {
+			apiErr.Attempts = attempt
+		}
+		if attempt >= policy.MaxAttempts {
+			return resp, err
+		}
+		if elapsed := c.now().Sub(start); policy.MaxElapsed > 0 && elapsed+wait > policy.MaxElapsed {
+			return resp, &RetryError{Attempts: attempt, Elapsed: elapsed, Err: err}
+		}
+
+		c.logRetry(req, fmt.Sprintf("attempt %d failed: %v", attempt, err), wait)
+		if err := c.sleep(req.Context(), wait); err != nil {
+			return resp, requestError("waiting to retry", req.Method, req.URL.Path, err)
+		}
+
+		if err := c.prepareRetry(req); err != nil {
+			return resp, requestError("retrying", req.Method, req.URL.Path, err)
+		}
+	}
+}
+
+// logRetry reports an upcoming retry to Config.Logger, if set.
+func (c *Client) logRetry(req *http.Request, reason string, wait time.Duration) {
+	if c.Config.Logger == nil {
+		return
+	}
+	c.Config.Logger.Printf("[INFO] PagerDuty - Retrying %s %s in %v, %s", req.Method, req.URL.RequestURI(), wait, reason)
+}
+
+// prepareRetry rewinds the request body and refreshes the Authorization
+// header, which may have changed since the previous attempt. Requests sent
+// without one, such as those to the Events API, are left without.
+func (c *Client) prepareRetry(req *http.Request) error {
+	if err := rewindRequest(req); err != nil {
+		return err
+	}
+	if req.Header.Get("Authorization") == "" {
+		return nil
+	}
+
+	authHeader, err := c.authHeader()
+	if err != nil {
+		return err
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	return nil
+}
+
+// retryWait reports whether a failed attempt should be retried and how long
+// to wait before doing so.
+func (c *Client) retryWait(req *http.Request, err error, attempt int, policy RetryPolicy) (time.Duration, bool) {
+	if req.Context().Err() != nil {
+		return 0, false
+	}
+
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		// Errors returned by middleware are not retried, network errors only
+		// when the request was never sent or is safe to send again.
+		if !c.Config.RetryServerErrors || !isNetworkError(err) {
+			return 0, false
+		}
+		if isDialError(err) || c.isRetryableMethod(req) {
+			return backoff(attempt, policy), true
+		}
+		return 0, false
+	}
+
+	if apiErr.needToRetry {
+		return apiErr.retryAfter, true
+	}
+
+	if c.Config.RetryServerErrors && isTransientServerError(apiErr.StatusCode) && c.isRetryableMethod(req) {
+		return backoff(attempt, policy), true
+	}
+
+	return 0, false
+}
+
+func isTransientServerError(statusCode int) bool {
+	switch statusCode {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// isRetryableMethod reports whether req can safely be sent again after it
+// may have been processed.
+func (c *Client) isRetryableMethod(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		retryable, _ := req.Context().Value(retryableContextKey{}).(bool)
+		return retryable || c.Config.RetryNonIdempotent
+	}
+	return false
+}
+
+// isNetworkError reports whether err is a failure to send a request or to
+// receive its response.
+func isNetworkError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+}
+
+// isDialError reports whether err is a failure to connect to the server,
+// in which case the request was never sent.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// backoff returns the exponential backoff with jitter for the given attempt,
+// starting at policy.MinWait and capped by policy.MaxWait.
+func backoff(attempt int, policy RetryPolicy) time.Duration {
+	wait := policy.MaxWait
+	if attempt < 32 {
+		if exp := policy.MinWait << uint(attempt-1); exp > 0 && exp < wait {
+			wait = exp
+		}
+	}
+
+	jitter := 1 - (jitterPercent * rand.Float64())
+	return time.Duration(float64(wait) * jitter)
+}
+
+// sleepContext waits for the given duration or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// requestError wraps err with the step that failed and the request it
+// failed for, e.g. "pagerduty: sending GET /users: ...", keeping err
+// reachable through errors.Is and errors.As.
+func requestError(step, method, path string, err error) error {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	return fmt.Errorf("pagerduty: %s %s %s: %w", step, method, path, err)
+}
+
+// rewindRequest resets the body of an already sent request so it can be sent
+// again.
+func rewindRequest(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+
+	return nil
+}
+
+func (c *Client) doOnce(req *http.Request, v interface{}) (*Response, error) {
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.Wait(req.Context()); err != nil {
+			return nil, requestError("rate limiting", req.Method, req.URL.Path, err)
+		}
+	}
+
 	sLogger := newSecureLogger()
+	sLogger.maxBodyBytes = c.Config.MaxResponseBytes
+	if c.Config.Logger != nil {
+		sLogger.logger = c.Config.Logger
+		sLogger.SetCanLog(true)
+	}
+	for _, m := range c.requestMiddleware {
+		if err := m(req); err != nil {
+			return nil, err
+		}
+	}
+
+	var cacheKey string
+	var cached *etagCacheEntry
+	if c.etagCache != nil && req.Method == http.MethodGet {
+		cacheKey = etagCacheKey(req)
+		if cached = c.etagCache.get(cacheKey); cached != nil {
+			req.Header.Set("If-None-Match", cached.etag)
+		}
+	}
+
 	sLogger.LogReq(req)
 
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, requestError("sending", req.Method, req.URL.Path, err)
+	}
+
+	defer resp.Body.Close()
+
+	if err := decompressBody(resp); err != nil {
+		return nil, requestError("reading response of", req.Method, req.URL.Path, err)
+	}
+
+	// Error bodies are only kept for error messages, so there is no need to
+	// buffer more than a bounded amount of them. Other bodies are read up to
+	// one byte past the limit to detect an oversized one. The limit is applied
+	// before logging, which would otherwise buffer the whole body.
+	isError := resp.StatusCode < 200 || resp.StatusCode > 299
+	limit := c.Config.MaxResponseBytes + 1
+	if isError {
+		limit = maxErrorBodyBytes
 	}
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(resp.Body, limit), resp.Body}
 
 	sLogger.LogRes(resp)
 
 	bodyBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return nil, requestError("reading response of", req.Method, req.URL.Path, err)
+	}
+	if !isError && int64(len(bodyBytes)) > c.Config.MaxResponseBytes {
+		return nil, requestError("reading response of", req.Method, req.URL.Path,
+			fmt.Errorf("%w: more than %d bytes", ErrResponseTooLarge, c.Config.MaxResponseBytes))
 	}
 	response := &Response{
 		Response:  resp,
 		BodyBytes: bodyBytes,
+		RequestID: resp.Header.Get("X-Request-Id"),
+	}
+
+	for _, m := range c.responseMiddleware {
+		if err := m(response); err != nil {
+			return response, err
+		}
+	}
+
+	if cached != nil && resp.StatusCode == http.StatusNotModified {
+		// The resource is unchanged, so the cached body stands in for it.
+		response.BodyBytes = cached.body
+		if v != nil {
+			if err := c.DecodeJSON(response, v); err != nil {
+				return response, err
+			}
+		}
+		return response, nil
 	}
 
 	if err := c.checkResponse(response); err != nil {
 		return response, err
 	}
 
-	if v != nil {
+	if cacheKey != "" {
+		if etag := resp.Header.Get("ETag"); etag != "" {
+			c.etagCache.put(cacheKey, etag, bodyBytes)
+		}
+	}
+
+	if v != nil && hasBody(response) {
 		if err := c.DecodeJSON(response, v); err != nil {
 			return response, err
 		}
@@ -414,20 +1177,68 @@ func (c *Client) do(req *http.Request, v interface{}) (*Response, error) {
 	return response, nil
 }
 
-// ListResp represents a list response from the PagerDuty API
-type ListResp struct {
+// decompressBody replaces the body of a gzip encoded response with a reader
+// of the decompressed body.
+func decompressBody(resp *http.Response) error {
+	if !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return nil
+	}
+	if resp.StatusCode == http.StatusNoContent || resp.ContentLength == 0 {
+		return nil
+	}
+
+	zr, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to decompress response body: %w", err)
+	}
+	resp.Body = zr
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
+
+// hasBody reports whether a response has a body to decode. Many DELETE and
+// some PUT endpoints reply with 204 No Content or an otherwise empty body, in
+// which case v is left untouched.
+func hasBody(res *Response) bool {
+	if res.Response.StatusCode == http.StatusNoContent || res.Response.ContentLength == 0 {
+		return false
+	}
+	return len(bytes.TrimSpace(res.BodyBytes)) > 0
+}
+
+// ListOptions holds the pagination parameters of offset paginated list
+// requests, embedded in the list options types. Total asks the API to count
+// all records, which is returned in PaginationMeta.Total.
+type ListOptions struct {
+	Limit  int  `url:"limit,omitempty"`
+	Offset int  `url:"offset,omitempty"`
+	Total  bool `url:"total,omitempty"`
+}
+
+// PaginationMeta represents the pagination fields of a list response from the
+// PagerDuty API, embedded in the list response types. Total is only returned
+// when the request sets ListOptions.Total.
+type PaginationMeta struct {
 	Offset int  `json:"offset,omitempty"`
 	Limit  int  `json:"limit,omitempty"`
 	More   bool `json:"more,omitempty"`
 	Total  int  `json:"total,omitempty"`
 }
 
+// ListResp is the former name of PaginationMeta.
+//
+// Deprecated: Use PaginationMeta.
+type ListResp = PaginationMeta
+
 // responseHandler is capable of parsing a response. At a minimum it must
 // extract the page information for the current page. It can also execute
 // additional necessary handling; for example, if a closure, it has access
 // to the scope in which it was defined, and can be used to append data to
 // a specific slice. The responseHandler is responsible for closing the response.
-type responseHandler func(response *Response) (ListResp, *Response, error)
+type responseHandler func(response *Response) (PaginationMeta, *Response, error)
 
 // CursorListResp represents a cursor-paginated list response from the PagerDuty API
 type CursorListResp struct {
@@ -435,6 +1246,35 @@ type CursorListResp struct {
 	Limit      int
 }
 
+// CursorPagination holds the paging fields of endpoints that use an opaque
+// cursor instead of offset/limit. Embed it in both the list options and the
+// list response of such endpoints: Limit and Cursor are sent as query
+// parameters, while Limit and NextCursor are decoded from the response body.
+type CursorPagination struct {
+	Limit      int    `url:"limit,omitempty" json:"limit,omitempty"`
+	Cursor     string `url:"cursor,omitempty" json:"-"`
+	NextCursor string `url:"-" json:"next_cursor,omitempty"`
+}
+
+// cursorPaginationGen implements cursorQueryOptionsGen for any options
+// struct that embeds CursorPagination.
+type cursorPaginationGen struct {
+	options interface{}
+	page    *CursorPagination
+}
+
+func (o *cursorPaginationGen) currentCursor() string {
+	return o.page.Cursor
+}
+
+func (o *cursorPaginationGen) changeCursor(s string) {
+	o.page.Cursor = s
+}
+
+func (o *cursorPaginationGen) buildStruct() interface{} {
+	return o.options
+}
+
 // cursorResponseHandler is capable of parsing a response. At a minimum it must
 // extract the page information for the current page. It can also execute
 // additional necessary handling; for example, if a closure, it has access
@@ -470,7 +1310,11 @@ func (o *simpleOffsetQueryOptionsGen) buildStruct() interface{} {
 }
 
 func (c *Client) newRequestPagedGetDo(basePath string, handler responseHandler, reqOptions ...RequestOptions) error {
-	return c.newRequestPagedGetQueryDo(basePath, handler, &simpleOffsetQueryOptionsGen{}, reqOptions...)
+	return c.newRequestPagedGetDoContext(context.Background(), basePath, handler, reqOptions...)
+}
+
+func (c *Client) newRequestPagedGetDoContext(ctx context.Context, basePath string, handler responseHandler, reqOptions ...RequestOptions) error {
+	return c.newRequestPagedGetQueryDoContext(ctx, basePath, handler, &simpleOffsetQueryOptionsGen{}, reqOptions...)
 }
 
 func (c *Client) newRequestPagedGetQueryDo(basePath string, handler responseHandler, qryOptions offsetQueryOptionsGen, reqOptions ...RequestOptions) error {
@@ -501,6 +1345,12 @@ func (c *Client) newRequestPagedGetQueryDoContext(ctx context.Context, basePath
 		// Bump the offset as necessary and set whether more results exist.
 		nextOffset = pageInfo.Offset + pageInfo.Limit
 		stillMore = pageInfo.More
+
+		// Classic pagination can't go past a fixed number of records, asking
+		// for the next page would only return an error from the API.
+		if stillMore && nextOffset+pageInfo.Limit > maxPaginationOffset {
+			return &PaginationLimitError{Offset: nextOffset, Limit: pageInfo.Limit}
+		}
 	}
 
 	return nil
@@ -550,15 +1400,43 @@ func (c *Client) newRequestCursorPagedGetQueryDoContext(ctx context.Context, bas
 	return nil
 }
 
-// ValidateAuth validates a token against the PagerDuty API
+// ValidateAuth validates a token against the PagerDuty API. Rejected
+// credentials result in an *AuthError matching ErrInvalidToken or
+// ErrInsufficientScope with errors.Is, any other error is returned as is.
 func (c *Client) ValidateAuth() error {
-	_, _, err := c.Abilities.List()
+	return c.ValidateAuthContext(context.Background())
+}
+
+// ValidateAuthContext validates a token against the PagerDuty API. Rejected
+// credentials result in an *AuthError matching ErrInvalidToken or
+// ErrInsufficientScope with errors.Is, any other error is returned as is.
+func (c *Client) ValidateAuthContext(ctx context.Context) error {
+	// Listing a single user is about the cheapest call that both account and
+	// user level credentials are allowed to make.
+	o := struct {
+		Limit int `url:"limit"`
+	}{1}
+	_, err := c.newRequestDoOptionsContext(ctx, "GET", "/users", o, nil, nil, WithRoute("/users"))
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+	switch apiErr.StatusCode {
+	case http.StatusUnauthorized:
+		return &AuthError{Reason: ErrInvalidToken, Err: err}
+	case http.StatusForbidden:
+		return &AuthError{Reason: ErrInsufficientScope, Err: err}
+	}
 	return err
 }
 
 // DecodeJSON decodes json body to given interface
 func (c *Client) DecodeJSON(res *Response, v interface{}) error {
-	return json.Unmarshal(res.BodyBytes, v)
+	if err := json.Unmarshal(res.BodyBytes, v); err != nil {
+		return newDecodeError(res, err)
+	}
+	return nil
 }
 
 func (c *Client) checkResponse(res *Response) error {
@@ -572,38 +1450,46 @@ func (c *Client) checkResponse(res *Response) error {
 func (c *Client) decodeErrorResponse(res *Response) error {
 	// Try to decode error response or fallback with standard error
 	v := &errorResponse{Error: &Error{ErrorResponse: res}}
-	err := c.DecodeJSON(res, v)
+	var apiErr *APIError
+	if err := c.DecodeJSON(res, v); err != nil || v.Error == nil {
+		apiErr = newAPIError(res, nil)
+	} else {
+		apiErr = newAPIError(res, v.Error)
+	}
 
-	if handledError := handleRatelimitError(res, v); handledError != nil {
+	if handledError := handleRatelimitError(res, apiErr); handledError != nil {
 		return handledError
 	}
 
-	if handledError := c.handleScopedOAuthError(res, v); handledError != nil {
+	if handledError := c.handleScopedOAuthError(res, apiErr); handledError != nil {
 		return handledError
 	}
 
-	if err != nil {
-		return fmt.Errorf("%s API call to %s failed: %v", res.Response.Request.Method, res.Response.Request.URL.String(), res.Response.Status)
+	if apiErr.err != nil {
+		log.Printf("[INFO] v.Error %+v", apiErr.err)
 	}
-	log.Printf("[INFO] v.Error %+v", v.Error)
 
-	return v.Error
+	return apiErr
 }
 
-func (c *Client) handleScopedOAuthError(res *Response, v *errorResponse) error {
+func (c *Client) handleScopedOAuthError(res *Response, apiErr *APIError) error {
 	isUsingScopedAPITokenFromCredentials := *c.Config.APIAuthTokenType == AuthTokenTypeUseAppCredentials
 	isOauthScopeMissing := isUsingScopedAPITokenFromCredentials && res.Response.StatusCode == http.StatusForbidden
 	needNewOauthScopedAccessToken := isUsingScopedAPITokenFromCredentials && res.Response.StatusCode == http.StatusUnauthorized
 	if isOauthScopeMissing {
-		return fmt.Errorf("%s API call to %s failed because %s API scope is required", res.Response.Request.Method, res.Response.Request.URL.String(), v.Error.RequiredScopes)
+		var requiredScopes string
+		if apiErr.err != nil {
+			requiredScopes = apiErr.err.RequiredScopes
+		}
+		return fmt.Errorf("%s API call to %s failed because %s API scope is required: %w", res.Response.Request.Method, res.Response.Request.URL.String(), requiredScopes, apiErr)
 	}
 	if needNewOauthScopedAccessToken {
 		err := c.generateScopedOauthAccessToken()
 		if err != nil {
-			return fmt.Errorf("API call to obtain a new Scoped Oauth Access Token failed: %v", err)
+			return fmt.Errorf("API call to obtain a new Scoped Oauth Access Token failed: %w", err)
 		}
-		v.Error.needToRetry = true
-		return v.Error
+		apiErr.needToRetry = true
+		return apiErr
 	}
 
 	return nil
@@ -612,7 +1498,13 @@ func (c *Client) handleScopedOAuthError(res *Response, v *errorResponse) error {
 // handleRatelimitError will handle rate limit errors from responses with http
 // code 429. Delaying retry based on ratelimit-reset recommended by PagerDuty
 // https://developer.pagerduty.com/docs/72d3b724589e3-rest-api-rate-limits#reaching-the-limit
-func handleRatelimitError(res *Response, v *errorResponse) error {
+// and falling back to the standard Retry-After header. The actual wait happens
+// in do(), so that it can be interrupted by the request context.
+func handleRatelimitError(res *Response, apiErr *APIError) error {
+	if res.Response.StatusCode != http.StatusTooManyRequests {
+		return nil
+	}
+
 	var markErrorAsRetryable = func(waitFor time.Duration) error {
 		reqMethod := res.Response.Request.Method
 		reqEndpoint := res.Response.Request.URL
@@ -621,36 +1513,49 @@ func handleRatelimitError(res *Response, v *errorResponse) error {
 			strconv.FormatFloat(waitFor.Seconds(), 'f', 1, 64),
 			strings.ToUpper(reqMethod),
 			reqEndpoint)
-		time.Sleep(waitFor)
-		v.Error.needToRetry = true
-		return v.Error
+		apiErr.needToRetry = true
+		apiErr.retryAfter = waitFor
+		return apiErr
 	}
 
-	ratelimitReset := res.Response.Header.Get("ratelimit-reset")
+	jitter := 1 + (jitterPercent * rand.Float64())
 
-	if res.Response.StatusCode == http.StatusTooManyRequests && ratelimitReset == "" {
-		baseDelay := 5 * time.Second
-		jitter := 1 + (jitterPercent * rand.Float64())
-		waitFor := time.Duration(float64(baseDelay) * jitter)
+	if headerWait, ok := rateLimitHeaderWait(res.Response.Header); ok {
+		baseDelay := 500 * time.Millisecond
+		extraWait := time.Duration(float64(baseDelay) * jitter)
 
-		return markErrorAsRetryable(waitFor)
+		return markErrorAsRetryable(headerWait + extraWait)
 	}
 
-	if res.Response.StatusCode == http.StatusTooManyRequests && ratelimitReset != "" {
-		headerWaitSeconds, err := strconv.ParseInt(ratelimitReset, 10, 0)
-		if err == nil {
-			baseDelay := 500 * time.Millisecond
-			headerWait := time.Duration(headerWaitSeconds) * time.Second
-			jitter := 1 + (jitterPercent * rand.Float64())
-			extraWait := time.Duration(float64(baseDelay) * jitter)
+	baseDelay := 5 * time.Second
+	return markErrorAsRetryable(time.Duration(float64(baseDelay) * jitter))
+}
 
-			waitFor := headerWait + extraWait
+// rateLimitHeaderWait reads the time to wait before retrying from either the
+// ratelimit-reset or the Retry-After header. Retry-After may be expressed in
+// seconds or as an HTTP date.
+func rateLimitHeaderWait(h http.Header) (time.Duration, bool) {
+	if reset := h.Get("ratelimit-reset"); reset != "" {
+		if seconds, err := strconv.ParseInt(reset, 10, 0); err == nil {
+			return time.Duration(seconds) * time.Second, true
+		}
+	}
 
-			return markErrorAsRetryable(waitFor)
+	retryAfter := h.Get("Retry-After")
+	if retryAfter == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(retryAfter, 10, 0); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(retryAfter); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait, true
 		}
+		return 0, true
 	}
 
-	return nil
+	return 0, false
 }
 
 func availableOauthScopes() []string {
@@ -2,12 +2,25 @@ package pagerduty
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"reflect"
+	"runtime"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/google/go-querystring/query"
+	"github.com/heimweh/go-pagerduty/persistentconfig"
 )
 
 var (
@@ -24,7 +37,7 @@ var (
 func setup() {
 	mux = http.NewServeMux()
 	server = httptest.NewServer(mux)
-	client, _ = NewClient(&Config{BaseURL: server.URL, Token: "foo"})
+	client, _ = NewClient(&Config{BaseURL: server.URL, EventsBaseURL: server.URL, Token: "foo"})
 }
 
 func teardown() {
@@ -81,25 +94,141 @@ func testQueryCount(t *testing.T, r *http.Request, count int) {
 	}
 }
 func TestClientUserAgentDefault(t *testing.T) {
-	client, err := NewClient(&Config{Token: "foo"})
+	setup()
+	defer teardown()
+
+	want := "go-pagerduty/" + Version + " Go/" + strings.TrimPrefix(runtime.Version(), "go")
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "User-Agent", want)
+		w.Write([]byte(`{"abilities": []}`))
+	})
+
+	if _, _, err := client.Abilities.List(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClientUserAgentSuffix(t *testing.T) {
+	setup()
+	defer teardown()
+
+	c, err := NewClient(&Config{BaseURL: server.URL, Token: "foo", UserAgent: "terraform-provider-pagerduty/3.0.0"})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	if client.Config.UserAgent != defaultUserAgent {
-		t.Errorf("got %q, want %q", client.Config.UserAgent, defaultUserAgent)
+	want := "go-pagerduty/" + Version + " Go/" + strings.TrimPrefix(runtime.Version(), "go") + " terraform-provider-pagerduty/3.0.0"
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "User-Agent", want)
+		w.Write([]byte(`{"abilities": []}`))
+	})
+
+	if _, _, err := c.Abilities.List(); err != nil {
+		t.Fatal(err)
+	}
+	if c.Config.UserAgent != "terraform-provider-pagerduty/3.0.0" {
+		t.Errorf("Config.UserAgent = %q, want it unchanged", c.Config.UserAgent)
+	}
+}
+
+func TestClientAuthorizationHeader(t *testing.T) {
+	cases := []struct {
+		name   string
+		config Config
+		want   string
+	}{
+		{"api token", Config{Token: "foo"}, "Token token=foo"},
+		{"oauth token", Config{OAuthToken: "bar"}, "Bearer bar"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			config := tc.config
+			config.BaseURL = server.URL
+			c, err := NewClient(&config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+				testHeader(t, r, "Authorization", tc.want)
+				w.Write([]byte(`{"abilities": []}`))
+			})
+
+			if _, _, err := c.Abilities.List(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestNewClientValidation(t *testing.T) {
+	scoped := AuthTokenTypeScopedOauthToken
+
+	cases := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{"none", Config{}, true},
+		{"token", Config{Token: "foo"}, false},
+		{"oauth token", Config{OAuthToken: "foo"}, false},
+		{"token and oauth token", Config{Token: "foo", OAuthToken: "bar"}, true},
+		{"scoped oauth without params", Config{APIAuthTokenType: &scoped}, true},
+		{"scoped oauth", Config{APIAuthTokenType: &scoped, AppOauthScopedTokenParams: &persistentconfig.AppOauthScopedTokenParams{}}, false},
+		{"scoped oauth and token", Config{Token: "foo", APIAuthTokenType: &scoped, AppOauthScopedTokenParams: &persistentconfig.AppOauthScopedTokenParams{}}, true},
+		{"skip credential check", Config{SkipCredentialCheck: true}, false},
+		{"skip credential check with conflicting auth", Config{SkipCredentialCheck: true, Token: "foo", OAuthToken: "bar"}, true},
+		{"base url without scheme", Config{Token: "foo", BaseURL: "api.pagerduty.com"}, true},
+		{"base url without host", Config{Token: "foo", BaseURL: "https://"}, true},
+		{"base url with unsupported scheme", Config{Token: "foo", BaseURL: "ftp://api.pagerduty.com"}, true},
+		{"relative base url", Config{Token: "foo", BaseURL: "/api"}, true},
+		{"unparsable base url", Config{Token: "foo", BaseURL: "://api.pagerduty.com"}, true},
+		{"http base url", Config{Token: "foo", BaseURL: "http://localhost:8080"}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := tc.config
+			_, err := NewClient(&config)
+			if tc.wantErr && err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestNewClientNoCredentials(t *testing.T) {
+	_, err := NewClient(&Config{})
+	if !errors.Is(err, ErrNoToken) {
+		t.Fatalf("got error %v, want %v", err, ErrNoToken)
 	}
 }
 
-func TestClientUserAgentOverwritten(t *testing.T) {
-	newUserAgent := "foo-user-agent"
-	client, err := NewClient(&Config{Token: "foo", UserAgent: newUserAgent})
+func TestSkipCredentialCheckOmitsAuthorization(t *testing.T) {
+	setup()
+	defer teardown()
+
+	c, err := NewClient(&Config{BaseURL: server.URL, SkipCredentialCheck: true})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	if client.Config.UserAgent != newUserAgent {
-		t.Errorf("got %q, want %q", client.Config.UserAgent, newUserAgent)
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.Header["Authorization"]; ok {
+			t.Errorf("unexpected Authorization header %q", r.Header.Get("Authorization"))
+		}
+		w.Write([]byte(`{"abilities": []}`))
+	})
+
+	if _, _, err := c.Abilities.List(); err != nil {
+		t.Fatal(err)
 	}
 }
 
@@ -112,7 +241,7 @@ func TestRetryURL(t *testing.T) {
 	expectedURL := "/members?offset=100"
 
 	options := GetMembersOptions{
-		Offset: 100,
+		ListOptions: ListOptions{Offset: 100},
 	}
 
 	mux.HandleFunc("/members", func(w http.ResponseWriter, r *http.Request) {
@@ -232,3 +361,1097 @@ func TestHandleRatelimitErrorNoRatelimitHeaders(t *testing.T) {
 	}
 
 }
+
+func TestRequestContextCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	mux.HandleFunc("/users/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		cancel()
+		<-r.Context().Done()
+	})
+
+	_, _, err := client.Users.GetContext(ctx, "1", &GetUserOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v; want %v", err, context.Canceled)
+	}
+}
+
+func TestRequestContextDeadline(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	mux.HandleFunc("/teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		<-r.Context().Done()
+	})
+
+	_, _, err := client.Teams.ListContext(ctx, &ListTeamsOptions{})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v; want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRequestErrorsWrapped(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		// The server only notices the client going away once the body
+		// has been read.
+		io.Copy(io.Discard, r.Body)
+		cancel()
+		<-r.Context().Done()
+	})
+
+	_, _, err := client.Users.CreateContext(ctx, &User{Name: "foo"})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v; want %v", err, context.Canceled)
+	}
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		t.Fatalf("expected a *url.Error to be reachable, got %T", err)
+	}
+	if !strings.HasPrefix(err.Error(), "pagerduty: sending POST /users: ") {
+		t.Errorf("unexpected error message %q", err)
+	}
+}
+
+func TestRequestErrorsWrappedEncoding(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.Do("POST", "/users", nil, map[string]interface{}{"user": make(chan int)}, nil)
+	var typeErr *json.UnsupportedTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("expected a *json.UnsupportedTypeError to be reachable, got %v", err)
+	}
+	if !strings.HasPrefix(err.Error(), "pagerduty: creating request for POST /users: ") {
+		t.Errorf("unexpected error message %q", err)
+	}
+}
+
+func TestHandleRatelimitErrorRetryAfterHeader(t *testing.T) {
+	setup()
+	defer teardown()
+
+	count := 0
+	mux.HandleFunc("/teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"team":{"name":"foo"}}`)
+
+		if count > 0 {
+			w.Write([]byte(`{"team": {"id": "1", "name": "foo"}}`))
+			return
+		}
+
+		w.Header().Add("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+		count++
+	})
+
+	team, _, err := client.Teams.Create(&Team{Name: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Team{ID: "1", Name: "foo"}
+	if !reflect.DeepEqual(team, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", team, want)
+	}
+}
+
+func TestHandleRatelimitErrorMaxRetries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	waits := useFakeClock(client)
+	client.Config.MaxRetries = 2
+
+	count := 0
+	mux.HandleFunc("/teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		count++
+		w.Header().Add("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"error":{"message":"Rate Limit Exceeded","code":2020}}`))
+	})
+
+	_, _, err := client.Teams.List(&ListTeamsOptions{})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("got %v; want *APIError", err)
+	}
+	if apiErr.Attempts != 3 {
+		t.Errorf("got %d attempts; want 3", apiErr.Attempts)
+	}
+	if count != 3 {
+		t.Errorf("got %d requests; want 3", count)
+	}
+	if len(*waits) != 2 {
+		t.Errorf("waited %d times; want 2", len(*waits))
+	}
+}
+
+func TestHandleRatelimitErrorRetriesDisabled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Config.MaxRetries = -1
+
+	count := 0
+	mux.HandleFunc("/teams", func(w http.ResponseWriter, r *http.Request) {
+		count++
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	if _, _, err := client.Teams.List(&ListTeamsOptions{}); err == nil {
+		t.Fatal("expected error; got nil")
+	}
+	if count != 1 {
+		t.Errorf("got %d requests; want 1", count)
+	}
+}
+
+func TestHandleRatelimitErrorContextCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	mux.HandleFunc("/teams", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	start := time.Now()
+	_, _, err := client.Teams.ListContext(ctx, &ListTeamsOptions{})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v; want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("retry wait was not interrupted, took %v", elapsed)
+	}
+}
+
+func TestRetryServerErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Config.RetryServerErrors = true
+	client.Config.RetryMaxWait = time.Millisecond
+
+	count := 0
+	mux.HandleFunc("/teams/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		count++
+		if count <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"team": {"id": "1"}}`))
+	})
+
+	team, _, err := client.Teams.Get("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Team{ID: "1"}
+	if !reflect.DeepEqual(team, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", team, want)
+	}
+	if count != 3 {
+		t.Errorf("got %d requests; want 3", count)
+	}
+}
+
+func TestRetryServerErrorsDisabledByDefault(t *testing.T) {
+	setup()
+	defer teardown()
+
+	count := 0
+	mux.HandleFunc("/teams/1", func(w http.ResponseWriter, r *http.Request) {
+		count++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	if _, _, err := client.Teams.Get("1"); err == nil {
+		t.Fatal("expected error; got nil")
+	}
+	if count != 1 {
+		t.Errorf("got %d requests; want 1", count)
+	}
+}
+
+func TestRetryServerErrorsSkipsPost(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Config.RetryServerErrors = true
+	client.Config.RetryMaxWait = time.Millisecond
+
+	count := 0
+	mux.HandleFunc("/teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		count++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	if _, _, err := client.Teams.Create(&Team{Name: "foo"}); err == nil {
+		t.Fatal("expected error; got nil")
+	}
+	if count != 1 {
+		t.Errorf("got %d requests; want 1", count)
+	}
+
+	client.Config.RetryNonIdempotent = true
+	count = 0
+	if _, _, err := client.Teams.Create(&Team{Name: "foo"}); err == nil {
+		t.Fatal("expected error; got nil")
+	}
+	if want := client.Config.MaxRetries + 1; count != want {
+		t.Errorf("got %d requests; want %d", count, want)
+	}
+}
+
+func TestRetryNetworkErrorsSkipsPost(t *testing.T) {
+	setup()
+	defer teardown()
+
+	c, err := NewClient(&Config{
+		BaseURL:           server.URL,
+		Token:             "foo",
+		HTTPClient:        &http.Client{Timeout: 50 * time.Millisecond},
+		RetryServerErrors: true,
+		MaxRetries:        2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	useFakeClock(c)
+
+	// The request is processed, but the response is too late to be read.
+	var count atomic.Int32
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		count.Add(1)
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+
+	cases := []struct {
+		name   string
+		method string
+		opts   []RequestOptions
+		want   int32
+	}{
+		{"post", "POST", nil, 1},
+		{"retryable post", "POST", []RequestOptions{Retryable()}, 3},
+		{"get", "GET", nil, 3},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			count.Store(0)
+			if _, err := c.Do(tc.method, "/incidents", nil, nil, nil, tc.opts...); !IsTimeout(err) {
+				t.Fatalf("expected a timeout error, got %v", err)
+			}
+			if got := count.Load(); got != tc.want {
+				t.Errorf("got %d requests; want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRetryConnectionRefusedPost(t *testing.T) {
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+
+	c, err := NewClient(&Config{BaseURL: closed.URL, Token: "foo", RetryServerErrors: true, MaxRetries: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	useFakeClock(c)
+
+	attempts := 0
+	c.UseRequestMiddleware(func(*http.Request) error {
+		attempts++
+		return nil
+	})
+
+	if _, err := c.Do("POST", "/incidents", nil, nil, nil); err == nil {
+		t.Fatal("expected error; got nil")
+	}
+	if attempts != 3 {
+		t.Errorf("got %d attempts; want the request that was never sent to be retried", attempts)
+	}
+}
+
+func TestRequestOptionsHeadersAndQuery(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "X-Early-Access", "incident-types-early-access")
+		testHeader(t, r, "From", "user@example.com")
+		testQueryCount(t, r, 2)
+		if got := r.URL.Query().Get("limit"); got != "10" {
+			t.Errorf("limit = %q, want %q", got, "10")
+		}
+		if got := r.URL.Query().Get("time_zone"); got != "UTC" {
+			t.Errorf("time_zone = %q, want %q", got, "UTC")
+		}
+		w.Write([]byte(`{}`))
+	})
+
+	_, err := client.newRequestDoOptionsContext(context.Background(), "GET", "/incidents", struct {
+		Limit int `url:"limit"`
+	}{10}, nil, nil,
+		RequestOptions{Type: "header", Label: "X-Early-Access", Value: "incident-types-early-access"},
+		RequestOptions{Type: "header", Label: "From", Value: "user@example.com"},
+		RequestOptions{Type: "query", Label: "time_zone", Value: "UTC"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRequestOptionsUnsupportedType(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.newRequestDoOptionsContext(context.Background(), "GET", "/incidents", nil, nil, nil,
+		RequestOptions{Type: "cookie", Label: "foo", Value: "bar"},
+	)
+	if err == nil {
+		t.Fatal("expected an error for an unsupported request option type")
+	}
+}
+
+func TestMiddlewareOrder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "X-Trace-Id", "first,second")
+		w.Write([]byte(`{"abilities": []}`))
+	})
+
+	var calls []string
+	client.UseRequestMiddleware(
+		func(r *http.Request) error {
+			calls = append(calls, "req1")
+			r.Header.Set("X-Trace-Id", "first")
+			return nil
+		},
+		func(r *http.Request) error {
+			calls = append(calls, "req2")
+			r.Header.Set("X-Trace-Id", r.Header.Get("X-Trace-Id")+",second")
+			return nil
+		},
+	)
+	client.UseResponseMiddleware(func(r *Response) error {
+		calls = append(calls, fmt.Sprintf("res1 %d", r.Response.StatusCode))
+		return nil
+	})
+	client.UseResponseMiddleware(func(r *Response) error {
+		calls = append(calls, "res2")
+		return nil
+	})
+
+	if _, _, err := client.Abilities.List(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"req1", "req2", "res1 200", "res2"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("middleware calls = %v, want %v", calls, want)
+	}
+}
+
+func TestRequestMiddlewareAbort(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should have been aborted")
+	})
+
+	abort := errors.New("aborted")
+	secondCalled := false
+	client.UseRequestMiddleware(
+		func(r *http.Request) error { return abort },
+		func(r *http.Request) error { secondCalled = true; return nil },
+	)
+
+	if _, _, err := client.Abilities.List(); err != abort {
+		t.Fatalf("got error %v, want %v", err, abort)
+	}
+	if secondCalled {
+		t.Error("middleware after the aborting one was called")
+	}
+}
+
+func TestResponseMiddlewareAbort(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Config.RetryServerErrors = true
+
+	calls := 0
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	abort := errors.New("aborted")
+	client.UseResponseMiddleware(func(r *Response) error { return abort })
+
+	if _, _, err := client.Abilities.List(); err != abort {
+		t.Fatalf("got error %v, want %v", err, abort)
+	}
+	if calls != 1 {
+		t.Errorf("server called %d times, want 1", calls)
+	}
+}
+
+func TestDecodeEmptyBodies(t *testing.T) {
+	testCases := []struct {
+		name   string
+		status int
+		body   string
+		want   *TeamPayload
+	}{
+		{"no content", http.StatusNoContent, "", &TeamPayload{}},
+		{"ok with empty body", http.StatusOK, "", &TeamPayload{}},
+		{"ok with body", http.StatusOK, `{"team": {"id": "1"}}`, &TeamPayload{Team: &Team{ID: "1"}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			mux.HandleFunc("/teams/1", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				w.Write([]byte(tc.body))
+			})
+
+			v := new(TeamPayload)
+			resp, err := client.newRequestDoContext(context.Background(), "PUT", "/teams/1", nil, nil, v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.Response.StatusCode != tc.status {
+				t.Errorf("got status %d, want %d", resp.Response.StatusCode, tc.status)
+			}
+			if !reflect.DeepEqual(v, tc.want) {
+				t.Errorf("got %#v, want %#v", v, tc.want)
+			}
+		})
+	}
+}
+
+func TestClientDo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/status_pages/1/posts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Authorization", "Token token=foo")
+		testHeader(t, r, "X-Early-Access", "status-pages-early-access")
+		testQueryCount(t, r, 1)
+		testBody(t, r, `{"post":{"title":"Maintenance"}}`)
+		w.Write([]byte(`{"post": {"id": "P1", "title": "Maintenance"}}`))
+	})
+
+	type post struct {
+		ID    string `json:"id,omitempty"`
+		Title string `json:"title,omitempty"`
+	}
+	type postPayload struct {
+		Post *post `json:"post"`
+	}
+
+	v := new(postPayload)
+	_, err := client.Do("POST", "/status_pages/1/posts", struct {
+		Include []string `url:"include,brackets"`
+	}{[]string{"updates"}}, &postPayload{Post: &post{Title: "Maintenance"}}, v,
+		RequestOptions{Type: "header", Label: "X-Early-Access", Value: "status-pages-early-access"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := (&postPayload{Post: &post{ID: "P1", Title: "Maintenance"}}); !reflect.DeepEqual(v, want) {
+		t.Errorf("got %#v, want %#v", v, want)
+	}
+}
+
+func TestClientDoError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/status_pages/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error": {"message": "Not Found", "code": 2100}}`))
+	})
+
+	if _, err := client.Do("GET", "/status_pages/1", nil, nil, nil); !IsNotFound(err) {
+		t.Fatalf("got error %v, want a not found error", err)
+	}
+}
+
+func TestClientDoRequestBodies(t *testing.T) {
+	cases := []struct {
+		name        string
+		body        interface{}
+		opts        []RequestOptions
+		wantBody    string
+		contentType string
+	}{
+		{
+			"json",
+			map[string]string{"name": "Earline Greenholt"},
+			nil,
+			`{"name":"Earline Greenholt"}`,
+			"application/json",
+		},
+		{
+			"raw json",
+			json.RawMessage(`{ "name": "Earline Greenholt", "unknown_field": 1 }`),
+			nil,
+			`{ "name": "Earline Greenholt", "unknown_field": 1 }`,
+			"application/json",
+		},
+		{
+			"bytes",
+			[]byte(`{"name":"Earline Greenholt"}` + "\n"),
+			nil,
+			`{"name":"Earline Greenholt"}` + "\n",
+			"application/json",
+		},
+		{
+			"reader with content type",
+			strings.NewReader("name=Earline+Greenholt"),
+			[]RequestOptions{WithContentType("application/x-www-form-urlencoded")},
+			"name=Earline+Greenholt",
+			"application/x-www-form-urlencoded",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Values("Content-Type"); !reflect.DeepEqual(got, []string{tc.contentType}) {
+					t.Errorf("Content-Type = %q, want %q", got, tc.contentType)
+				}
+				b, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(b) != tc.wantBody {
+					t.Errorf("body = %q, want %q", b, tc.wantBody)
+				}
+			})
+
+			if _, err := client.Do("POST", "/users", nil, tc.body, nil, tc.opts...); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestRawRequestBodyRetried(t *testing.T) {
+	setup()
+	defer teardown()
+
+	useFakeClock(client)
+	client.Config.RetryServerErrors = true
+
+	calls := 0
+	mux.HandleFunc("/users/1", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if b, _ := io.ReadAll(r.Body); string(b) != `{"user":{"name":"foo"}}` {
+			t.Errorf("attempt %d: body = %q", calls, b)
+		}
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	})
+
+	if _, err := client.Do("PUT", "/users/1", nil, strings.NewReader(`{"user":{"name":"foo"}}`), nil); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2", calls)
+	}
+}
+
+func TestListOptionsArrayEncoding(t *testing.T) {
+	cases := []struct {
+		name string
+		opts interface{}
+		want string
+	}{
+		{
+			"users",
+			&ListUsersOptions{Include: []string{"contact_methods", "teams"}, TeamIDs: []string{"P1", "P2"}},
+			"include[]=contact_methods&include[]=teams&team_ids[]=P1&team_ids[]=P2",
+		},
+		{
+			"team members",
+			&GetMembersOptions{Includes: []string{"users"}},
+			"include[]=users",
+		},
+		{
+			"services",
+			&ListServicesOptions{Includes: []string{"escalation_policies"}, TeamIDs: []string{"P1"}},
+			"include[]=escalation_policies&team_ids[]=P1",
+		},
+		{
+			"escalation policies",
+			&ListEscalationPoliciesOptions{Includes: []string{"teams"}, TeamIDs: []string{"P1"}, UserIDs: []string{"P2", "P3"}},
+			"include[]=teams&team_ids[]=P1&user_ids[]=P2&user_ids[]=P3",
+		},
+		{
+			"oncalls",
+			&ListOnCallOptions{EscalationPolicyIds: []string{"P1"}, Includes: []string{"users"}, ScheduleIds: []string{"P2", "P3"}, UserIds: []string{"P4"}},
+			"escalation_policy_ids[]=P1&include[]=users&schedule_ids[]=P2&schedule_ids[]=P3&user_ids[]=P4",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v, err := query.Values(tc.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := url.QueryUnescape(v.Encode())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("query = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/schedules/preview", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+		w.Write([]byte(`{"schedule": {"id": "1"}}`))
+	})
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"abilities": []}`))
+	})
+
+	start := time.Now()
+	_, err := client.Do("POST", "/schedules/preview", nil, nil, nil, WithTimeout(20*time.Millisecond))
+	if !IsTimeout(err) {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the error to wrap context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("request took %v, want it to give up after the timeout", elapsed)
+	}
+
+	if _, err := client.Do("GET", "/abilities", nil, nil, nil, RequestOptions{Type: "timeout", Value: "5s"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Do("GET", "/abilities", nil, nil, nil, RequestOptions{Type: "timeout", Value: "soon"}); err == nil {
+		t.Fatal("expected an error for an invalid timeout, got nil")
+	}
+}
+
+func TestIsTimeout(t *testing.T) {
+	if IsTimeout(&APIError{StatusCode: http.StatusGatewayTimeout}) {
+		t.Error("IsTimeout is true for an API error")
+	}
+	if !IsTimeout(fmt.Errorf("listing users: %w", context.DeadlineExceeded)) {
+		t.Error("IsTimeout is false for a wrapped context.DeadlineExceeded")
+	}
+}
+
+func TestGzipResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Accept-Encoding", "gzip")
+		w.Header().Set("Content-Encoding", "gzip")
+		zw := gzip.NewWriter(w)
+		zw.Write([]byte(`{"users": [{"id": "P1D3Z4B"}], "limit": 25}`))
+		zw.Close()
+	})
+
+	resp, _, err := client.Users.List(&ListUsersOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Users) != 1 || resp.Users[0].ID != "P1D3Z4B" {
+		t.Errorf("returned %#v; want user P1D3Z4B", resp.Users)
+	}
+}
+
+func TestDisableCompression(t *testing.T) {
+	setup()
+	defer teardown()
+
+	c, err := NewClient(&Config{BaseURL: server.URL, Token: "foo", DisableCompression: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Accept-Encoding", "identity")
+		w.Write([]byte(`{"abilities": []}`))
+	})
+
+	if _, _, err := c.Abilities.List(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMaxResponseBytes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	c, err := NewClient(&Config{BaseURL: server.URL, Token: "foo", MaxResponseBytes: 1024})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"users": [], "padding": "`))
+		w.Write(bytes.Repeat([]byte("x"), 2048))
+		w.Write([]byte(`"}`))
+	})
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"abilities": ["teams"]}`))
+	})
+
+	_, _, err = c.Users.List(&ListUsersOptions{})
+	if !errors.Is(err, ErrResponseTooLarge) {
+		t.Fatalf("got error %v, want %v", err, ErrResponseTooLarge)
+	}
+
+	if _, _, err := c.Abilities.List(); err != nil {
+		t.Fatalf("a response within the limit failed: %v", err)
+	}
+}
+
+func TestMaxResponseBytesGzip(t *testing.T) {
+	setup()
+	defer teardown()
+
+	c, err := NewClient(&Config{BaseURL: server.URL, Token: "foo", MaxResponseBytes: 1024})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		zw := gzip.NewWriter(w)
+		zw.Write([]byte(`{"users": [], "padding": "`))
+		zw.Write(bytes.Repeat([]byte("x"), 1<<20))
+		zw.Write([]byte(`"}`))
+		zw.Close()
+	})
+
+	// The limit applies to the decompressed body.
+	if _, _, err := c.Users.List(&ListUsersOptions{}); !errors.Is(err, ErrResponseTooLarge) {
+		t.Fatalf("got error %v, want %v", err, ErrResponseTooLarge)
+	}
+}
+
+func TestOversizedErrorResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error": {"message": "`))
+		w.Write(bytes.Repeat([]byte("x"), 2*maxErrorBodyBytes))
+		w.Write([]byte(`"}}`))
+	})
+
+	_, _, err := client.Users.List(&ListUsersOptions{})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("got error %v, want an *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest || len(apiErr.RawBody) != maxErrorBodyBytes {
+		t.Errorf("got status %d with %d body bytes, want %d with %d", apiErr.StatusCode, len(apiErr.RawBody), http.StatusBadRequest, maxErrorBodyBytes)
+	}
+}
+
+func TestValidateAuth(t *testing.T) {
+	cases := []struct {
+		name   string
+		status int
+		want   error
+	}{
+		{"valid", http.StatusOK, nil},
+		{"invalid token", http.StatusUnauthorized, ErrInvalidToken},
+		{"missing scope", http.StatusForbidden, ErrInsufficientScope},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				testQueryValue(t, r, "limit", "1")
+				w.WriteHeader(tc.status)
+				if tc.status == http.StatusOK {
+					w.Write([]byte(`{"users": [{"id": "P1D3Z4B"}], "limit": 1}`))
+				} else {
+					w.Write([]byte(`{"error": {"code": 2006, "message": "Authentication failed"}}`))
+				}
+			})
+
+			err := client.ValidateAuth()
+			if tc.want == nil {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if !errors.Is(err, tc.want) || !errors.Is(err, ErrAuthFailure) {
+				t.Errorf("expected an error matching %v, got %v", tc.want, err)
+			}
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != tc.status {
+				t.Errorf("expected the error to wrap an API error with status %d, got %v", tc.status, err)
+			}
+		})
+	}
+}
+
+func TestValidateAuthTransientError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	err := client.ValidateAuth()
+	var authErr *AuthError
+	if err == nil || errors.As(err, &authErr) || errors.Is(err, ErrAuthFailure) {
+		t.Errorf("expected a non-auth error, got %v", err)
+	}
+}
+
+func TestListOptionsEncoding(t *testing.T) {
+	o := &ListTeamsOptions{ListOptions: ListOptions{Limit: 10, Offset: 20, Total: true}, More: true}
+	v, err := query.Values(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := v.Encode(), "limit=10&offset=20&total=true"; got != want {
+		t.Errorf("query = %q, want %q", got, want)
+	}
+}
+
+func TestPaginationMetaDecoding(t *testing.T) {
+	var v ListTeamsResponse
+	if err := json.Unmarshal([]byte(`{"teams": [], "limit": 10, "offset": 20, "more": true, "total": 42}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if want := (PaginationMeta{Limit: 10, Offset: 20, More: true, Total: 42}); v.PaginationMeta != want {
+		t.Errorf("pagination = %#v, want %#v", v.PaginationMeta, want)
+	}
+}
+
+func TestNewClientCopiesConfig(t *testing.T) {
+	setup()
+	defer teardown()
+
+	config := &Config{BaseURL: server.URL, Token: "foo"}
+	c, err := NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.Token = "bar"
+	config.UserAgent = "changed"
+
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "Token token=foo")
+		testHeader(t, r, "User-Agent", defaultUserAgent)
+		w.Write([]byte(`{"abilities": []}`))
+	})
+
+	if _, _, err := c.Abilities.List(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEarlyAccessFeatures(t *testing.T) {
+	setup()
+	defer teardown()
+
+	features := []string{"status-pages-early-access", "incident-types-early-access"}
+	c, err := NewClient(&Config{BaseURL: server.URL, Token: "foo", EarlyAccessFeatures: features})
+	if err != nil {
+		t.Fatal(err)
+	}
+	features[0] = "changed"
+
+	var got []string
+	mux.HandleFunc("/status_pages", func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Values("X-Early-Access")
+		w.Write([]byte(`{}`))
+	})
+
+	if _, err := c.Do("GET", "/status_pages", nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"status-pages-early-access", "incident-types-early-access"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("X-Early-Access = %q, want %q", got, want)
+	}
+
+	// A feature requested per request is not sent twice.
+	if _, err := c.Do("GET", "/status_pages", nil, nil, nil, WithEarlyAccess("incident-types-early-access"), WithEarlyAccess("custom-fields-early-access")); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"incident-types-early-access", "custom-fields-early-access", "status-pages-early-access"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("X-Early-Access = %q, want %q", got, want)
+	}
+}
+
+func TestSetTokenConcurrent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	config := &Config{BaseURL: server.URL, Token: "token-0"}
+	c, err := NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "Token token=token-") {
+			t.Errorf("unexpected Authorization header %q", r.Header.Get("Authorization"))
+		}
+		w.Write([]byte(`{"abilities": []}`))
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				if _, _, err := c.Abilities.List(); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 1; i <= 20; i++ {
+			c.SetToken(fmt.Sprintf("token-%d", i))
+			config.Token = fmt.Sprintf("ignored-%d", i)
+		}
+	}()
+	wg.Wait()
+
+	mux.HandleFunc("/users/me", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "Token token=token-20")
+		w.Write([]byte(`{"user": {"id": "P1"}}`))
+	})
+	if _, err := c.Do("GET", "/users/me", nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetTokenOAuth(t *testing.T) {
+	setup()
+	defer teardown()
+
+	c, err := NewClient(&Config{BaseURL: server.URL, OAuthToken: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.SetToken("bar")
+
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "Bearer bar")
+		w.Write([]byte(`{"abilities": []}`))
+	})
+
+	if _, _, err := c.Abilities.List(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAcceptHeader(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var accept string
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		accept = r.Header.Get("Accept")
+		w.Write([]byte(`{"abilities": []}`))
+	})
+
+	configured, err := NewClient(&Config{BaseURL: server.URL, Token: "foo", APIVersion: "3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name   string
+		client *Client
+		opts   []RequestOptions
+		want   string
+	}{
+		{"default", client, nil, "application/vnd.pagerduty+json;version=2"},
+		{"config", configured, nil, "application/vnd.pagerduty+json;version=3"},
+		{"request version", configured, []RequestOptions{WithAPIVersion("2")}, "application/vnd.pagerduty+json;version=2"},
+		{"request media type", client, []RequestOptions{{Type: "header", Label: "Accept", Value: "application/json"}}, "application/json"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := tc.client.Do("GET", "/abilities", nil, nil, nil, tc.opts...); err != nil {
+				t.Fatal(err)
+			}
+			if accept != tc.want {
+				t.Errorf("Accept = %q, want %q", accept, tc.want)
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package pagerduty
 
+import "context"
+
 // PriorityService handles the communication with priority related methods
 // of the PagerDuty API.
 type PriorityService service
@@ -22,10 +24,15 @@ type Priority struct {
 
 // List lists available priorities.
 func (s *PriorityService) List() (*ListPrioritiesResponse, *Response, error) {
+	return s.ListContext(context.Background())
+}
+
+// ListContext lists available priorities.
+func (s *PriorityService) ListContext(ctx context.Context) (*ListPrioritiesResponse, *Response, error) {
 	u := "/priorities"
 	v := new(ListPrioritiesResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, nil, nil, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, v, WithRoute("/priorities"))
 	if err != nil {
 		return nil, nil, err
 	}
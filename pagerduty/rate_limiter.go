@@ -0,0 +1,46 @@
+package pagerduty
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter limits the rate at which the client sends requests, see
+// Config.RateLimiter. It is satisfied by *rate.Limiter from
+// golang.org/x/time/rate.
+type RateLimiter interface {
+	// Wait blocks until a request may be sent or ctx is done.
+	Wait(ctx context.Context) error
+}
+
+// intervalLimiter is the RateLimiter used for Config.RequestsPerMinute. It
+// spaces requests evenly, so that a large sync does not spend the account's
+// rate budget in a single burst.
+type intervalLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newIntervalLimiter(requestsPerMinute int) *intervalLimiter {
+	return &intervalLimiter{interval: time.Minute / time.Duration(requestsPerMinute)}
+}
+
+// Wait reserves the next free slot and sleeps until it starts. A reservation
+// is kept even when ctx is done before it starts.
+func (l *intervalLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	wait := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	if wait == 0 {
+		return ctx.Err()
+	}
+	return sleepContext(ctx, wait)
+}
@@ -0,0 +1,107 @@
+package pagerduty
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type countingLimiter struct {
+	calls int32
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	atomic.AddInt32(&l.calls, 1)
+	return nil
+}
+
+func TestRequestsPerMinute(t *testing.T) {
+	setup()
+	defer teardown()
+
+	c, err := NewClient(&Config{BaseURL: server.URL, Token: "foo", RequestsPerMinute: 1200})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"abilities": []}`))
+	})
+
+	// 1200 requests per minute is one request every 50ms, so the last of
+	// five concurrent requests is sent at least 200ms after the first.
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := c.Abilities.List(); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("5 requests took %v, want at least 200ms", elapsed)
+	}
+}
+
+func TestRateLimiterConsumedByRetries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	limiter := &countingLimiter{}
+	c, err := NewClient(&Config{BaseURL: server.URL, Token: "foo", RateLimiter: limiter, RetryServerErrors: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calls := 0
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"abilities": []}`))
+	})
+
+	if _, _, err := c.Abilities.List(); err != nil {
+		t.Fatal(err)
+	}
+
+	if limiter.calls != 2 {
+		t.Errorf("limiter waited %d times, want 2", limiter.calls)
+	}
+}
+
+func TestRateLimiterContextCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	c, err := NewClient(&Config{BaseURL: server.URL, Token: "foo", RequestsPerMinute: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"abilities": []}`))
+	})
+
+	if _, _, err := c.Abilities.List(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, _, err := c.Abilities.ListContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
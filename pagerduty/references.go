@@ -37,6 +37,9 @@ type ContactMethodReference resourceReference
 // AddonReference represents a reference to an add-on.
 type AddonReference resourceReference
 
+// BusinessServiceReference represents a reference to a business service.
+type BusinessServiceReference resourceReference
+
 // ServiceReference represents a reference to a service.
 type ServiceReference resourceReference
 
@@ -65,3 +68,9 @@ type IncidentAttributeReference resourceReference
 // CustomFieldSchemaReference represents a reference to a Custom
 // Field schema
 type CustomFieldSchemaReference resourceReference
+
+// IncidentReference represents a reference to an incident.
+type IncidentReference resourceReference
+
+// PriorityReference represents a reference to an incident priority.
+type PriorityReference resourceReference
@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -59,6 +60,11 @@ type ListResponsePlayOptions struct {
 
 // List lists existing response_plays.
 func (s *ResponsePlayService) List(o *ListResponsePlayOptions) (*ListResponsePlaysResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists existing response_plays.
+func (s *ResponsePlayService) ListContext(ctx context.Context, o *ListResponsePlayOptions) (*ListResponsePlaysResponse, *Response, error) {
 	u := "/response_plays"
 	v := new(ListResponsePlaysResponse)
 
@@ -72,24 +78,24 @@ func (s *ResponsePlayService) List(o *ListResponsePlayOptions) (*ListResponsePla
 
 	// Create a handler closure capable of parsing data from the response_plays endpoint
 	// and appending resultant response plays to the return slice.
-	responseHandler := func(response *Response) (ListResp, *Response, error) {
+	responseHandler := func(response *Response) (PaginationMeta, *Response, error) {
 		var result ListResponsePlaysResponse
 
 		if err := s.client.DecodeJSON(response, &result); err != nil {
-			return ListResp{}, response, err
+			return PaginationMeta{}, response, err
 		}
 
 		responsePlays = append(responsePlays, result.ResponsePlays...)
 
 		// Return stats on the current page. Caller can use this information to
 		// adjust for requesting additional pages.
-		return ListResp{
+		return PaginationMeta{
 			More:   result.More,
 			Offset: result.Offset,
 			Limit:  result.Limit,
 		}, response, nil
 	}
-	err := s.client.newRequestPagedGetDo(u, responseHandler, ro)
+	err := s.client.newRequestPagedGetDoContext(ctx, u, responseHandler, ro, WithRoute("/response_plays"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -100,6 +106,11 @@ func (s *ResponsePlayService) List(o *ListResponsePlayOptions) (*ListResponsePla
 
 // Create creates a new response play.
 func (s *ResponsePlayService) Create(responsePlay *ResponsePlay) (*ResponsePlay, *Response, error) {
+	return s.CreateContext(context.Background(), responsePlay)
+}
+
+// CreateContext creates a new response play.
+func (s *ResponsePlayService) CreateContext(ctx context.Context, responsePlay *ResponsePlay) (*ResponsePlay, *Response, error) {
 	u := "/response_plays"
 	v := new(ResponsePlayPayload)
 	p := &ResponsePlayPayload{ResponsePlay: responsePlay}
@@ -108,7 +119,7 @@ func (s *ResponsePlayService) Create(responsePlay *ResponsePlay) (*ResponsePlay,
 		Label: "from",
 		Value: responsePlay.FromEmail,
 	}
-	resp, err := s.client.newRequestDoOptions("POST", u, nil, p, v, o)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, p, v, o, WithRoute("/response_plays"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -121,6 +132,11 @@ func (s *ResponsePlayService) Create(responsePlay *ResponsePlay) (*ResponsePlay,
 
 // Get gets a new response play.
 func (s *ResponsePlayService) Get(ID, From string) (*ResponsePlay, *Response, error) {
+	return s.GetContext(context.Background(), ID, From)
+}
+
+// GetContext gets a new response play.
+func (s *ResponsePlayService) GetContext(ctx context.Context, ID, From string) (*ResponsePlay, *Response, error) {
 	u := fmt.Sprintf("/response_plays/%s", ID)
 	v := new(ResponsePlayPayload)
 	p := &ResponsePlayPayload{}
@@ -129,7 +145,7 @@ func (s *ResponsePlayService) Get(ID, From string) (*ResponsePlay, *Response, er
 		Label: "from",
 		Value: From,
 	}
-	resp, err := s.client.newRequestDoOptions("GET", u, nil, p, v, o)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, p, v, o, WithRoute("/response_plays/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -142,17 +158,27 @@ func (s *ResponsePlayService) Get(ID, From string) (*ResponsePlay, *Response, er
 
 // Delete deletes an existing response_play.
 func (s *ResponsePlayService) Delete(ID, From string) (*Response, error) {
+	return s.DeleteContext(context.Background(), ID, From)
+}
+
+// DeleteContext deletes an existing response_play.
+func (s *ResponsePlayService) DeleteContext(ctx context.Context, ID, From string) (*Response, error) {
 	u := fmt.Sprintf("/response_plays/%s", ID)
 	o := RequestOptions{
 		Type:  "header",
 		Label: "from",
 		Value: From,
 	}
-	return s.client.newRequestDoOptions("DELETE", u, nil, nil, nil, o)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, o, WithRoute("/response_plays/{id}"))
 }
 
 // Update updates an existing response_play.
 func (s *ResponsePlayService) Update(ID string, responsePlay *ResponsePlay) (*ResponsePlay, *Response, error) {
+	return s.UpdateContext(context.Background(), ID, responsePlay)
+}
+
+// UpdateContext updates an existing response_play.
+func (s *ResponsePlayService) UpdateContext(ctx context.Context, ID string, responsePlay *ResponsePlay) (*ResponsePlay, *Response, error) {
 	u := fmt.Sprintf("/response_plays/%s", ID)
 	v := new(ResponsePlayPayload)
 	p := ResponsePlayPayload{ResponsePlay: responsePlay}
@@ -161,7 +187,7 @@ func (s *ResponsePlayService) Update(ID string, responsePlay *ResponsePlay) (*Re
 		Label: "from",
 		Value: responsePlay.FromEmail,
 	}
-	resp, err := s.client.newRequestDoOptions("PUT", u, nil, p, v, o)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, p, v, o, WithRoute("/response_plays/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -171,3 +197,28 @@ func (s *ResponsePlayService) Update(ID string, responsePlay *ResponsePlay) (*Re
 
 	return v.ResponsePlay, resp, nil
 }
+
+type runResponsePlayPayload struct {
+	Incident *IncidentReference `json:"incident,omitempty"`
+}
+
+// Run runs a response play on an incident. From is the email address of the
+// user running the response play and is required.
+func (s *ResponsePlayService) Run(ID, From, incidentID string) (*Response, error) {
+	return s.RunContext(context.Background(), ID, From, incidentID)
+}
+
+// RunContext runs a response play on an incident. From is the email address of the
+// user running the response play and is required.
+func (s *ResponsePlayService) RunContext(ctx context.Context, ID, From, incidentID string) (*Response, error) {
+	reqOptions, err := s.client.fromOptions("running a response play", From, []RequestOptions{WithRoute("/response_plays/{id}/run")})
+	if err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("/response_plays/%s/run", ID)
+	p := &runResponsePlayPayload{
+		Incident: &IncidentReference{ID: incidentID, Type: "incident_reference"},
+	}
+	return s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, p, nil, reqOptions...)
+}
@@ -150,3 +150,23 @@ func TestResponsePlayDelete(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestResponsePlayRun(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/response_plays/1/run", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "From", "foo@email.com")
+		testBody(t, r, `{"incident":{"id":"P1D3Z4B","type":"incident_reference"}}`)
+		w.Write([]byte(`{"status": "ok"}`))
+	})
+
+	if _, err := client.ResponsePlays.Run("1", "foo@email.com", "P1D3Z4B"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.ResponsePlays.Run("1", "", "P1D3Z4B"); err == nil {
+		t.Fatal("expected an error when From is missing")
+	}
+}
@@ -0,0 +1,98 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried. Zero fields fall
+// back to Config.MaxRetries, the 500ms base backoff, Config.RetryMaxWait and
+// no time budget respectively.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of times a request is sent, including
+	// the first attempt. One disables retries.
+	MaxAttempts int
+
+	// MinWait is the backoff before the first server error retry; it doubles
+	// with every further attempt.
+	MinWait time.Duration
+
+	// MaxWait caps the backoff between server error retries. Waits requested
+	// by the API when rate limiting are not capped.
+	MaxWait time.Duration
+
+	// MaxElapsed is the total time budget of a request including all of its
+	// retries. A retry whose wait would exceed the budget is not attempted
+	// and a *RetryError is returned instead.
+	MaxElapsed time.Duration
+}
+
+type retryPolicyContextKey struct{}
+
+// WithRetryPolicy returns a request option that overrides Config.RetryPolicy
+// for a single request.
+func WithRetryPolicy(p RetryPolicy) RequestOptions {
+	return RequestOptions{
+		Type:        "retry_policy",
+		RetryPolicy: &p,
+	}
+}
+
+type retryableContextKey struct{}
+
+// Retryable returns a request option that allows retrying a POST request on
+// server and network errors, like Config.RetryNonIdempotent does for all of
+// them. Only use it for requests that are safe to repeat, e.g. because they
+// carry a deduplication key.
+func Retryable() RequestOptions {
+	return RequestOptions{Type: "retryable"}
+}
+
+// RetryError is returned when a request is given up on because retrying it
+// would exceed RetryPolicy.MaxElapsed. Err is the error of the last attempt.
+type RetryError struct {
+	Attempts int
+	Elapsed  time.Duration
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("giving up after %d attempts in %v: %v", e.Attempts, e.Elapsed.Round(time.Millisecond), e.Err)
+}
+
+// Unwrap returns the error of the last attempt.
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// retryPolicy returns the retry policy for req with defaults filled in.
+func (c *Client) retryPolicy(req *http.Request) RetryPolicy {
+	var p RetryPolicy
+	if override, ok := req.Context().Value(retryPolicyContextKey{}).(*RetryPolicy); ok {
+		p = *override
+	} else if c.Config.RetryPolicy != nil {
+		p = *c.Config.RetryPolicy
+	}
+
+	if p.MaxAttempts == 0 {
+		p.MaxAttempts = c.Config.MaxRetries + 1
+	}
+	if p.MaxAttempts < 1 {
+		p.MaxAttempts = 1
+	}
+	if p.MinWait == 0 {
+		p.MinWait = retryBaseWait
+	}
+	if p.MaxWait == 0 {
+		p.MaxWait = c.Config.RetryMaxWait
+	}
+
+	return p
+}
+
+// withRetryPolicy attaches a per-request retry policy to ctx.
+func withRetryPolicy(ctx context.Context, p *RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyContextKey{}, p)
+}
@@ -0,0 +1,124 @@
+package pagerduty
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// useFakeClock makes the client sleep by advancing a fake clock, so retry
+// tests run instantly. It returns the waits the client slept for.
+func useFakeClock(c *Client) *[]time.Duration {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	var waits []time.Duration
+	c.now = func() time.Time { return now }
+	c.sleep = func(ctx context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		now = now.Add(d)
+		return nil
+	}
+	return &waits
+}
+
+func TestRetryPolicyMaxAttempts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	waits := useFakeClock(client)
+	client.Config.RetryServerErrors = true
+	client.Config.RetryPolicy = &RetryPolicy{MaxAttempts: 3, MinWait: time.Second, MaxWait: time.Minute}
+
+	calls := 0
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, _, err := client.Abilities.List()
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Attempts != 3 {
+		t.Fatalf("got %v; want *APIError after 3 attempts", err)
+	}
+	if calls != 3 {
+		t.Errorf("server called %d times, want 3", calls)
+	}
+	if len(*waits) != 2 {
+		t.Fatalf("slept %d times, want 2", len(*waits))
+	}
+	// Second wait doubles the first, minus up to 30% jitter.
+	if w := (*waits)[0]; w < 700*time.Millisecond || w > time.Second {
+		t.Errorf("first wait = %v, want between 700ms and 1s", w)
+	}
+	if w := (*waits)[1]; w < 1400*time.Millisecond || w > 2*time.Second {
+		t.Errorf("second wait = %v, want between 1.4s and 2s", w)
+	}
+}
+
+func TestRetryPolicyMaxElapsed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	useFakeClock(client)
+	client.Config.RetryServerErrors = true
+	client.Config.RetryPolicy = &RetryPolicy{
+		MaxAttempts: 10,
+		MinWait:     time.Second,
+		MaxWait:     time.Second,
+		MaxElapsed:  1300 * time.Millisecond,
+	}
+
+	calls := 0
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, _, err := client.Abilities.List()
+
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) {
+		t.Fatalf("got %v; want *RetryError", err)
+	}
+	if retryErr.Attempts != 2 || calls != 2 {
+		t.Errorf("gave up after %d attempts and %d calls, want 2 and 2", retryErr.Attempts, calls)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("got %v; want the last *APIError to be wrapped", err)
+	}
+}
+
+func TestRetryPolicyPerRequestOverride(t *testing.T) {
+	setup()
+	defer teardown()
+
+	useFakeClock(client)
+	client.Config.RetryServerErrors = true
+	client.Config.RetryPolicy = &RetryPolicy{MaxAttempts: 1}
+
+	calls := 0
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	if _, _, err := client.Abilities.List(); err == nil {
+		t.Fatal("expected an error")
+	}
+	if calls != 1 {
+		t.Errorf("server called %d times, want 1", calls)
+	}
+
+	calls = 0
+	_, err := client.newRequestDoOptionsContext(context.Background(), "GET", "/abilities", nil, nil, nil, WithRetryPolicy(RetryPolicy{MaxAttempts: 4}))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if calls != 4 {
+		t.Errorf("server called %d times, want 4", calls)
+	}
+}
@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -158,6 +159,11 @@ type RuleActionExtraction struct {
 
 // List lists existing rulesets.
 func (s *RulesetService) List() (*ListRulesetsResponse, *Response, error) {
+	return s.ListContext(context.Background())
+}
+
+// ListContext lists existing rulesets.
+func (s *RulesetService) ListContext(ctx context.Context) (*ListRulesetsResponse, *Response, error) {
 	u := "/rulesets"
 	v := new(ListRulesetsResponse)
 
@@ -165,24 +171,24 @@ func (s *RulesetService) List() (*ListRulesetsResponse, *Response, error) {
 
 	// Create a handler closure capable of parsing data from the rulesets endpoint
 	// and appending resultant rulesets to the return slice.
-	responseHandler := func(response *Response) (ListResp, *Response, error) {
+	responseHandler := func(response *Response) (PaginationMeta, *Response, error) {
 		var result ListRulesetsResponse
 
 		if err := s.client.DecodeJSON(response, &result); err != nil {
-			return ListResp{}, response, err
+			return PaginationMeta{}, response, err
 		}
 
 		rulesets = append(rulesets, result.Rulesets...)
 
 		// Return stats on the current page. Caller can use this information to
 		// adjust for requesting additional pages.
-		return ListResp{
+		return PaginationMeta{
 			More:   result.More,
 			Offset: result.Offset,
 			Limit:  result.Limit,
 		}, response, nil
 	}
-	err := s.client.newRequestPagedGetDo(u, responseHandler)
+	err := s.client.newRequestPagedGetDoContext(ctx, u, responseHandler, WithRoute("/rulesets"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -193,11 +199,16 @@ func (s *RulesetService) List() (*ListRulesetsResponse, *Response, error) {
 
 // Create creates a new ruleset.
 func (s *RulesetService) Create(ruleset *Ruleset) (*Ruleset, *Response, error) {
+	return s.CreateContext(context.Background(), ruleset)
+}
+
+// CreateContext creates a new ruleset.
+func (s *RulesetService) CreateContext(ctx context.Context, ruleset *Ruleset) (*Ruleset, *Response, error) {
 	u := "/rulesets"
 	v := new(RulesetPayload)
 	p := &RulesetPayload{Ruleset: ruleset}
 
-	resp, err := s.client.newRequestDo("POST", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, p, v, WithRoute("/rulesets"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -207,11 +218,16 @@ func (s *RulesetService) Create(ruleset *Ruleset) (*Ruleset, *Response, error) {
 
 // Get gets a new ruleset.
 func (s *RulesetService) Get(ID string) (*Ruleset, *Response, error) {
+	return s.GetContext(context.Background(), ID)
+}
+
+// GetContext gets a new ruleset.
+func (s *RulesetService) GetContext(ctx context.Context, ID string) (*Ruleset, *Response, error) {
 	u := fmt.Sprintf("/rulesets/%s", ID)
 	v := new(RulesetPayload)
 	p := &RulesetPayload{}
 
-	resp, err := s.client.newRequestDo("GET", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, p, v, WithRoute("/rulesets/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -221,17 +237,27 @@ func (s *RulesetService) Get(ID string) (*Ruleset, *Response, error) {
 
 // Delete deletes an existing ruleset.
 func (s *RulesetService) Delete(ID string) (*Response, error) {
+	return s.DeleteContext(context.Background(), ID)
+}
+
+// DeleteContext deletes an existing ruleset.
+func (s *RulesetService) DeleteContext(ctx context.Context, ID string) (*Response, error) {
 	u := fmt.Sprintf("/rulesets/%s", ID)
-	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/rulesets/{id}"))
 }
 
 // Update updates an existing ruleset.
 func (s *RulesetService) Update(ID string, ruleset *Ruleset) (*Ruleset, *Response, error) {
+	return s.UpdateContext(context.Background(), ID, ruleset)
+}
+
+// UpdateContext updates an existing ruleset.
+func (s *RulesetService) UpdateContext(ctx context.Context, ID string, ruleset *Ruleset) (*Ruleset, *Response, error) {
 	u := fmt.Sprintf("/rulesets/%s", ID)
 	v := new(RulesetPayload)
 	p := RulesetPayload{Ruleset: ruleset}
 
-	resp, err := s.client.newRequestDo("PUT", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, p, v, WithRoute("/rulesets/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -241,10 +267,15 @@ func (s *RulesetService) Update(ID string, ruleset *Ruleset) (*Ruleset, *Respons
 
 // ListRules Lists Event Rules for Ruleset
 func (s *RulesetService) ListRules(rulesetID string) (*ListRulesetRulesResponse, *Response, error) {
+	return s.ListRulesContext(context.Background(), rulesetID)
+}
+
+// ListRulesContext Lists Event Rules for Ruleset
+func (s *RulesetService) ListRulesContext(ctx context.Context, rulesetID string) (*ListRulesetRulesResponse, *Response, error) {
 	u := fmt.Sprintf("/rulesets/%s/rules", rulesetID)
 	v := new(ListRulesetRulesResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/rulesets/{id}/rules"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -254,11 +285,16 @@ func (s *RulesetService) ListRules(rulesetID string) (*ListRulesetRulesResponse,
 
 // CreateRule for Ruleset
 func (s *RulesetService) CreateRule(rulesetID string, rule *RulesetRule) (*RulesetRule, *Response, error) {
+	return s.CreateRuleContext(context.Background(), rulesetID, rule)
+}
+
+// CreateRuleContext creates a new rule in the ruleset.
+func (s *RulesetService) CreateRuleContext(ctx context.Context, rulesetID string, rule *RulesetRule) (*RulesetRule, *Response, error) {
 	u := fmt.Sprintf("/rulesets/%s/rules", rulesetID)
 	v := new(RulesetRulePayload)
 	p := RulesetRulePayload{Rule: rule}
 
-	resp, err := s.client.newRequestDo("POST", u, nil, p, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, p, &v, WithRoute("/rulesets/{id}/rules"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -268,10 +304,15 @@ func (s *RulesetService) CreateRule(rulesetID string, rule *RulesetRule) (*Rules
 
 // GetRule for Ruleset
 func (s *RulesetService) GetRule(rulesetID, ruleID string) (*RulesetRule, *Response, error) {
+	return s.GetRuleContext(context.Background(), rulesetID, ruleID)
+}
+
+// GetRuleContext gets a rule of the ruleset.
+func (s *RulesetService) GetRuleContext(ctx context.Context, rulesetID, ruleID string) (*RulesetRule, *Response, error) {
 	u := fmt.Sprintf("/rulesets/%s/rules/%s", rulesetID, ruleID)
 	v := new(RulesetRulePayload)
 
-	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/rulesets/{id}/rules/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -281,11 +322,16 @@ func (s *RulesetService) GetRule(rulesetID, ruleID string) (*RulesetRule, *Respo
 
 // UpdateRule for Ruleset
 func (s *RulesetService) UpdateRule(rulesetID, ruleID string, rule *RulesetRule) (*RulesetRule, *Response, error) {
+	return s.UpdateRuleContext(context.Background(), rulesetID, ruleID, rule)
+}
+
+// UpdateRuleContext updates an existing rule of the ruleset.
+func (s *RulesetService) UpdateRuleContext(ctx context.Context, rulesetID, ruleID string, rule *RulesetRule) (*RulesetRule, *Response, error) {
 	u := fmt.Sprintf("/rulesets/%s/rules/%s", rulesetID, ruleID)
 	v := new(RulesetRulePayload)
 	p := RulesetRulePayload{Rule: rule}
 
-	resp, err := s.client.newRequestDo("PUT", u, nil, p, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, p, &v, WithRoute("/rulesets/{id}/rules/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -295,6 +341,11 @@ func (s *RulesetService) UpdateRule(rulesetID, ruleID string, rule *RulesetRule)
 
 // DeleteRule deletes an existing rule from the ruleset.
 func (s *RulesetService) DeleteRule(rulesetID, ruleID string) (*Response, error) {
+	return s.DeleteRuleContext(context.Background(), rulesetID, ruleID)
+}
+
+// DeleteRuleContext deletes an existing rule from the ruleset.
+func (s *RulesetService) DeleteRuleContext(ctx context.Context, rulesetID, ruleID string) (*Response, error) {
 	u := fmt.Sprintf("/rulesets/%s/rules/%s", rulesetID, ruleID)
-	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/rulesets/{id}/rules/{id}"))
 }
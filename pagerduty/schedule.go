@@ -1,7 +1,11 @@
 package pagerduty
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"time"
 )
 
 // ScheduleService handles the communication with schedule
@@ -11,8 +15,8 @@ type ScheduleService service
 // Override represents an override
 type Override struct {
 	ID    string         `json:"id,omitempty"`
-	Start string         `json:"start,omitempty"`
-	End   string         `json:"end,omitempty"`
+	Start *Timestamp     `json:"start,omitempty"`
+	End   *Timestamp     `json:"end,omitempty"`
 	User  *UserReference `json:"user,omitempty"`
 }
 
@@ -34,14 +38,45 @@ type Schedule struct {
 	Teams                []*TeamReference             `json:"teams,omitempty"`
 }
 
-// SubSchedule represents a sub-schedule of a schedule.
+// Validate checks the schedule before it is sent to the API, which answers
+// mistakes with unhelpful errors: the time zone, when set, must be valid for
+// ValidateTimeZone and the layers for ScheduleLayer.Validate. Create and
+// Update call it unless SkipValidation is set.
+func (s *Schedule) Validate() error {
+	if s == nil {
+		return errors.New("a schedule is required")
+	}
+	if s.TimeZone != "" {
+		if err := ValidateTimeZone(s.TimeZone); err != nil {
+			return err
+		}
+	}
+	for i, l := range s.ScheduleLayers {
+		if err := l.Validate(); err != nil {
+			return fmt.Errorf("schedule layer %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Location returns the location of the time zone of the schedule, in which
+// the API renders its entries, e.g. to convert the start of an entry with
+// entry.Start.In(loc).
+func (s *Schedule) Location() (*time.Location, error) {
+	return LoadTimeZone(s.TimeZone)
+}
+
+// SubSchedule represents a sub-schedule of a schedule, the final schedule
+// or the overrides, rendered for the window requested on get.
+// RenderedCoveragePercentage is the share of the window covered by entries.
 type SubSchedule struct {
 	Name                       string                `json:"name,omitempty"`
 	RenderedCoveragePercentage float64               `json:"rendered_coverage_percentage,omitempty"`
 	RenderedScheduleEntries    []*ScheduleLayerEntry `json:"rendered_schedule_entries,omitempty"`
 }
 
-// Restriction represents a schedule layer restriction.
+// Restriction represents a schedule layer restriction. StartDayOfWeek, from
+// 1 for Monday to 7 for Sunday, is only used by weekly restrictions.
 type Restriction struct {
 	DurationSeconds int    `json:"duration_seconds,omitempty"`
 	StartDayOfWeek  int    `json:"start_day_of_week,omitempty"`
@@ -49,51 +84,110 @@ type Restriction struct {
 	Type            string `json:"type,omitempty"`
 }
 
+// Types of schedule layer restrictions.
+const (
+	RestrictionTypeDaily  = "daily_restriction"
+	RestrictionTypeWeekly = "weekly_restriction"
+)
+
+// Validate checks a daily or weekly restriction: its duration must be
+// positive, its start time of day in the HH:MM:SS form, and its start day of
+// week, from 1 to 7, set for weekly restrictions only. Restrictions of other
+// types are left for the API to check.
+func (r *Restriction) Validate() error {
+	switch r.Type {
+	case RestrictionTypeDaily:
+		if r.StartDayOfWeek != 0 {
+			return fmt.Errorf("start day of week %d set on a daily restriction", r.StartDayOfWeek)
+		}
+	case RestrictionTypeWeekly:
+		if r.StartDayOfWeek < 1 || r.StartDayOfWeek > 7 {
+			return fmt.Errorf("weekly restriction requires a start day of week from 1 to 7, got %d", r.StartDayOfWeek)
+		}
+	default:
+		return nil
+	}
+
+	if r.DurationSeconds <= 0 {
+		return fmt.Errorf("restriction duration must be positive, got %d seconds", r.DurationSeconds)
+	}
+	if _, err := time.Parse("15:04:05", r.StartTimeOfDay); err != nil || len(r.StartTimeOfDay) != len("15:04:05") {
+		return fmt.Errorf("restriction start time of day %q is not in the HH:MM:SS form", r.StartTimeOfDay)
+	}
+	return nil
+}
+
 // ScheduleLayerEntry represents a rendered schedule layer entry.
 type ScheduleLayerEntry struct {
-	End   string         `json:"end,omitempty"`
-	Start string         `json:"start,omitempty"`
+	End   *Timestamp     `json:"end,omitempty"`
+	Start *Timestamp     `json:"start,omitempty"`
 	User  *UserReference `json:"user,omitempty"`
 }
 
-// ScheduleLayer represents a schedule layer in a schedule
+// ScheduleLayer represents a schedule layer in a schedule. Users take turns
+// in the order of the slice.
 type ScheduleLayer struct {
 	// End should be nullable because if it's null, it means the layer does not end.
-	End                        *string                 `json:"end"`
+	End                        *Timestamp              `json:"end"`
 	ID                         string                  `json:"id,omitempty"`
 	Name                       string                  `json:"name,omitempty"`
 	RenderedCoveragePercentage float64                 `json:"rendered_coverage_percentage,omitempty"`
 	RenderedScheduleEntries    []*ScheduleLayerEntry   `json:"rendered_schedule_entries,omitempty"`
 	Restrictions               []*Restriction          `json:"restrictions,omitempty"`
 	RotationTurnLengthSeconds  int                     `json:"rotation_turn_length_seconds,omitempty"`
-	RotationVirtualStart       string                  `json:"rotation_virtual_start,omitempty"`
-	Start                      string                  `json:"start,omitempty"`
+	RotationVirtualStart       *Timestamp              `json:"rotation_virtual_start,omitempty"`
+	Start                      *Timestamp              `json:"start,omitempty"`
 	Users                      []*UserReferenceWrapper `json:"users,omitempty"`
 }
 
-// ListSchedulesOptions represents options when listing schedules.
+// Validate checks the layer before it is sent to the API: its rotation turn
+// length, if set, must be positive and its restrictions valid for
+// Restriction.Validate. ScheduleService.Create and Update call it through
+// Schedule.Validate; it can also be called on its own, e.g. when planning
+// changes.
+func (l *ScheduleLayer) Validate() error {
+	if l == nil {
+		return errors.New("a schedule layer is required")
+	}
+	if l.RotationTurnLengthSeconds < 0 {
+		return fmt.Errorf("rotation turn length must be positive, got %d seconds", l.RotationTurnLengthSeconds)
+	}
+	for i, r := range l.Restrictions {
+		if r == nil {
+			return fmt.Errorf("restriction %d is nil", i)
+		}
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("restriction %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// ListSchedulesOptions represents options when listing schedules. Query
+// matches schedules whose name contains it. Include "schedule_layers"
+// returns the layers of the schedules.
 type ListSchedulesOptions struct {
-	Limit  int    `url:"limit,omitempty"`
-	More   bool   `url:"more,omitempty"`
-	Offset int    `url:"offset,omitempty"`
-	Query  string `url:"query,omitempty"`
-	Total  int    `url:"total,omitempty"`
+	ListOptions
+
+	// Deprecated: More is not a request parameter and is ignored.
+	More bool `url:"-"`
+
+	Query   string   `url:"query,omitempty"`
+	Include []string `url:"include,omitempty,brackets"`
 }
 
 // ListSchedulesResponse represents a list response of schedules.
 type ListSchedulesResponse struct {
-	Limit     int         `json:"limit,omitempty"`
-	More      bool        `json:"more,omitempty"`
-	Offset    int         `json:"offset,omitempty"`
+	PaginationMeta
 	Schedules []*Schedule `json:"schedules,omitempty"`
-	Total     int         `json:"total,omitempty"`
 }
 
 // ListOnCallsOptions represents options when listing on calls.
 type ListOnCallsOptions struct {
-	ID    string `url:"id,omitempty"`
-	Since string `url:"since,omitempty"`
-	Until string `url:"until,omitempty"`
+	ID       string `url:"id,omitempty"`
+	Overflow bool   `url:"overflow,omitempty"`
+	Since    string `url:"since,omitempty"`
+	Until    string `url:"until,omitempty"`
 }
 
 // ListOnCallsResponse represents a list response of on calls.
@@ -101,7 +195,17 @@ type ListOnCallsResponse struct {
 	Users []*User `json:"users,omitempty"`
 }
 
-// ListOverridesOptions represents options when listing overrides.
+// ListOnCallUsersOptions represents options when listing the users on call
+// in a schedule. Overflow includes users whose shifts extend past the window.
+type ListOnCallUsersOptions struct {
+	Overflow bool      `url:"overflow,omitempty"`
+	Since    time.Time `url:"since,omitempty"`
+	Until    time.Time `url:"until,omitempty"`
+}
+
+// ListOverridesOptions represents options when listing overrides. Overflow
+// returns overrides extending past since and until instead of truncating
+// them.
 type ListOverridesOptions struct {
 	Editable bool   `url:"editable,omitempty"`
 	ID       string `url:"id,omitempty"`
@@ -112,28 +216,43 @@ type ListOverridesOptions struct {
 
 // ListOverridesResponse represents a list response of schedules.
 type ListOverridesResponse struct {
-	Limit     int         `json:"limit,omitempty"`
-	More      bool        `json:"more,omitempty"`
-	Offset    int         `json:"offset,omitempty"`
+	PaginationMeta
 	Overrides []*Override `json:"overrides,omitempty"`
-	Total     int         `json:"total,omitempty"`
 }
 
-// GetScheduleOptions represents options when retrieving a schedule.
+// GetScheduleOptions represents options when retrieving a schedule. Since
+// and Until select the window of the rendered entries of the final schedule,
+// the overrides and the layers, which are rendered in TimeZone. Overflow
+// returns rendered entries extending past the window instead of truncating
+// them.
 type GetScheduleOptions struct {
-	Since    string `url:"since,omitempty"`
-	TimeZone string `url:"time_zone,omitempty"`
-	Until    string `url:"until,omitempty"`
+	Overflow bool      `url:"overflow,omitempty"`
+	Since    time.Time `url:"since,omitempty"`
+	TimeZone string    `url:"time_zone,omitempty"`
+	Until    time.Time `url:"until,omitempty"`
+}
+
+// PreviewScheduleOptions represents options when previewing a schedule.
+// Since and Until select the window of the rendered entries, and Overflow
+// returns entries extending past it instead of truncating them.
+type PreviewScheduleOptions struct {
+	Overflow bool      `url:"overflow,omitempty"`
+	Since    time.Time `url:"since,omitempty"`
+	Until    time.Time `url:"until,omitempty"`
 }
 
 // CreateScheduleOptions represents options when creating a schedule.
+// SkipValidation sends the schedule without validating it first.
 type CreateScheduleOptions struct {
-	Overflow bool `url:"overflow,omitempty"`
+	Overflow       bool `url:"overflow,omitempty"`
+	SkipValidation bool `url:"-"`
 }
 
 // UpdateScheduleOptions represents options when updating a schedule.
+// SkipValidation sends the schedule without validating it first.
 type UpdateScheduleOptions struct {
-	Overflow bool `url:"overflow,omitempty"`
+	Overflow       bool `url:"overflow,omitempty"`
+	SkipValidation bool `url:"-"`
 }
 
 // SchedulePayload represents a schedule.
@@ -148,10 +267,15 @@ type OverridePayload struct {
 
 // List lists existing schedules.
 func (s *ScheduleService) List(o *ListSchedulesOptions) (*ListSchedulesResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists existing schedules.
+func (s *ScheduleService) ListContext(ctx context.Context, o *ListSchedulesOptions) (*ListSchedulesResponse, *Response, error) {
 	u := "/schedules"
 	v := new(ListSchedulesResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/schedules"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -159,12 +283,25 @@ func (s *ScheduleService) List(o *ListSchedulesOptions) (*ListSchedulesResponse,
 	return v, resp, nil
 }
 
-// Create creates a new schedule.
+// Create creates a new schedule. The schedule is validated first unless
+// SkipValidation is set.
 func (s *ScheduleService) Create(schedule *Schedule, o *CreateScheduleOptions) (*Schedule, *Response, error) {
+	return s.CreateContext(context.Background(), schedule, o)
+}
+
+// CreateContext creates a new schedule. The schedule is validated first
+// unless SkipValidation is set.
+func (s *ScheduleService) CreateContext(ctx context.Context, schedule *Schedule, o *CreateScheduleOptions) (*Schedule, *Response, error) {
+	if o == nil || !o.SkipValidation {
+		if err := schedule.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	u := "/schedules"
 	v := new(SchedulePayload)
 
-	resp, err := s.client.newRequestDo("POST", u, o, &SchedulePayload{Schedule: schedule}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, o, &SchedulePayload{Schedule: schedule}, &v, WithRoute("/schedules"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -172,18 +309,39 @@ func (s *ScheduleService) Create(schedule *Schedule, o *CreateScheduleOptions) (
 	return v.Schedule, resp, nil
 }
 
-// Delete removes an existing schedule.
+// Delete removes an existing schedule. A schedule still used by
+// escalation policies cannot be deleted, the error is then a
+// *ScheduleDeletionError listing them.
 func (s *ScheduleService) Delete(id string) (*Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext removes an existing schedule. A schedule still used by
+// escalation policies cannot be deleted, the error is then a
+// *ScheduleDeletionError listing them.
+func (s *ScheduleService) DeleteContext(ctx context.Context, id string) (*Response, error) {
 	u := fmt.Sprintf("/schedules/%s", id)
-	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/schedules/{id}"))
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest {
+		err = newScheduleDeletionError(id, apiErr)
+	}
+
+	return resp, err
 }
 
 // Get retrieves information about a schedule.
 func (s *ScheduleService) Get(id string, o *GetScheduleOptions) (*Schedule, *Response, error) {
+	return s.GetContext(context.Background(), id, o)
+}
+
+// GetContext retrieves information about a schedule.
+func (s *ScheduleService) GetContext(ctx context.Context, id string, o *GetScheduleOptions) (*Schedule, *Response, error) {
 	u := fmt.Sprintf("/schedules/%s", id)
 	v := new(SchedulePayload)
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/schedules/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -191,12 +349,25 @@ func (s *ScheduleService) Get(id string, o *GetScheduleOptions) (*Schedule, *Res
 	return v.Schedule, resp, nil
 }
 
-// Update updates an existing schedule.
+// Update updates an existing schedule. The schedule is validated first
+// unless SkipValidation is set.
 func (s *ScheduleService) Update(id string, schedule *Schedule, o *UpdateScheduleOptions) (*Schedule, *Response, error) {
+	return s.UpdateContext(context.Background(), id, schedule, o)
+}
+
+// UpdateContext updates an existing schedule. The schedule is validated
+// first unless SkipValidation is set.
+func (s *ScheduleService) UpdateContext(ctx context.Context, id string, schedule *Schedule, o *UpdateScheduleOptions) (*Schedule, *Response, error) {
+	if o == nil || !o.SkipValidation {
+		if err := schedule.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	u := fmt.Sprintf("/schedules/%s", id)
 	v := new(SchedulePayload)
 
-	resp, err := s.client.newRequestDo("PUT", u, o, &SchedulePayload{Schedule: schedule}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, o, &SchedulePayload{Schedule: schedule}, &v, WithRoute("/schedules/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -206,10 +377,15 @@ func (s *ScheduleService) Update(id string, schedule *Schedule, o *UpdateSchedul
 
 // ListOnCalls lists all of the users on call in a given schedule for a given time range.
 func (s *ScheduleService) ListOnCalls(scheduleID string, o *ListOnCallsOptions) (*ListOnCallsResponse, *Response, error) {
+	return s.ListOnCallsContext(context.Background(), scheduleID, o)
+}
+
+// ListOnCallsContext lists all of the users on call in a given schedule for a given time range.
+func (s *ScheduleService) ListOnCallsContext(ctx context.Context, scheduleID string, o *ListOnCallsOptions) (*ListOnCallsResponse, *Response, error) {
 	u := fmt.Sprintf("/schedules/%s/users", scheduleID)
 	v := new(ListOnCallsResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/schedules/{id}/users"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -217,12 +393,59 @@ func (s *ScheduleService) ListOnCalls(scheduleID string, o *ListOnCallsOptions)
 	return v, resp, nil
 }
 
+// ListOnCallUsers lists the users on call in a schedule between the since
+// and until of o. A zero since or until is left for PagerDuty to default to
+// the current time, so a nil o lists the users on call right now.
+func (s *ScheduleService) ListOnCallUsers(scheduleID string, o *ListOnCallUsersOptions) ([]*User, *Response, error) {
+	return s.ListOnCallUsersContext(context.Background(), scheduleID, o)
+}
+
+// ListOnCallUsersContext lists the users on call in a schedule between the
+// since and until of o. A zero since or until is left for PagerDuty to
+// default to the current time, so a nil o lists the users on call right now.
+func (s *ScheduleService) ListOnCallUsersContext(ctx context.Context, scheduleID string, o *ListOnCallUsersOptions) ([]*User, *Response, error) {
+	u := fmt.Sprintf("/schedules/%s/users", scheduleID)
+	v := new(ListOnCallsResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/schedules/{id}/users"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Users, resp, nil
+}
+
+// Preview renders a schedule without saving it, e.g. to check the coverage
+// of its layers before creating or updating it.
+func (s *ScheduleService) Preview(schedule *Schedule, o *PreviewScheduleOptions) (*Schedule, *Response, error) {
+	return s.PreviewContext(context.Background(), schedule, o)
+}
+
+// PreviewContext renders a schedule without saving it, e.g. to check the
+// coverage of its layers before creating or updating it.
+func (s *ScheduleService) PreviewContext(ctx context.Context, schedule *Schedule, o *PreviewScheduleOptions) (*Schedule, *Response, error) {
+	u := "/schedules/preview"
+	v := new(SchedulePayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, o, &SchedulePayload{Schedule: schedule}, &v, WithRoute("/schedules/preview"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Schedule, resp, nil
+}
+
 // ListOverrides lists existing overrides.
 func (s *ScheduleService) ListOverrides(scheduleID string, o *ListOverridesOptions) (*ListOverridesResponse, *Response, error) {
+	return s.ListOverridesContext(context.Background(), scheduleID, o)
+}
+
+// ListOverridesContext lists existing overrides.
+func (s *ScheduleService) ListOverridesContext(ctx context.Context, scheduleID string, o *ListOverridesOptions) (*ListOverridesResponse, *Response, error) {
 	u := fmt.Sprintf("/schedules/%s/overrides", scheduleID)
 	v := new(ListOverridesResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/schedules/{id}/overrides"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -231,11 +454,16 @@ func (s *ScheduleService) ListOverrides(scheduleID string, o *ListOverridesOptio
 }
 
 // CreateOverride creates an override for a specific user covering the specified time range.
-func (s *ScheduleService) CreateOverride(id string, override *Override) (*Override, *Response, error) {
+func (s *ScheduleService) CreateOverride(id string, override *Override, reqOptions ...RequestOptions) (*Override, *Response, error) {
+	return s.CreateOverrideContext(context.Background(), id, override, reqOptions...)
+}
+
+// CreateOverrideContext creates an override for a specific user covering the specified time range.
+func (s *ScheduleService) CreateOverrideContext(ctx context.Context, id string, override *Override, reqOptions ...RequestOptions) (*Override, *Response, error) {
 	u := fmt.Sprintf("/schedules/%s/overrides", id)
 	v := new(OverridePayload)
 
-	resp, err := s.client.newRequestDo("POST", u, nil, &OverridePayload{Override: override}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &OverridePayload{Override: override}, &v, withRouteOptions("/schedules/{id}/overrides", reqOptions)...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -244,7 +472,178 @@ func (s *ScheduleService) CreateOverride(id string, override *Override) (*Overri
 }
 
 // DeleteOverride deletes an override.
-func (s *ScheduleService) DeleteOverride(id string, overrideID string) (*Response, error) {
+func (s *ScheduleService) DeleteOverride(id string, overrideID string, reqOptions ...RequestOptions) (*Response, error) {
+	return s.DeleteOverrideContext(context.Background(), id, overrideID, reqOptions...)
+}
+
+// DeleteOverrideContext deletes an override.
+func (s *ScheduleService) DeleteOverrideContext(ctx context.Context, id string, overrideID string, reqOptions ...RequestOptions) (*Response, error) {
 	u := fmt.Sprintf("/schedules/%s/overrides/%s", id, overrideID)
-	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, withRouteOptions("/schedules/{id}/overrides/{id}", reqOptions)...)
+}
+
+// ListAuditRecords lists a single page of the audit records of a schedule,
+// e.g. changes of its rotations, with the actors who made them. Since and
+// Until are RFC 3339 timestamps. Use the NextCursor of the response as the
+// Cursor option to fetch the following page.
+func (s *ScheduleService) ListAuditRecords(scheduleID string, o *ListAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	return s.ListAuditRecordsContext(context.Background(), scheduleID, o)
+}
+
+// ListAuditRecordsContext lists a single page of the audit records of a
+// schedule, e.g. changes of its rotations, with the actors who made them.
+// Since and Until are RFC 3339 timestamps. Use the NextCursor of the
+// response as the Cursor option to fetch the following page.
+func (s *ScheduleService) ListAuditRecordsContext(ctx context.Context, scheduleID string, o *ListAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	return s.client.listAuditRecordsContext(ctx, fmt.Sprintf("/schedules/%s/audit/records", scheduleID), "/schedules/{id}/audit/records", o)
+}
+
+// ListAllAuditRecords lists every audit record of a schedule matching the
+// options, following the cursor until the last page.
+func (s *ScheduleService) ListAllAuditRecords(scheduleID string, o *ListAuditRecordsOptions) ([]*AuditRecord, error) {
+	return s.ListAllAuditRecordsContext(context.Background(), scheduleID, o)
+}
+
+// ListAllAuditRecordsContext lists every audit record of a schedule matching
+// the options, following the cursor until the last page.
+func (s *ScheduleService) ListAllAuditRecordsContext(ctx context.Context, scheduleID string, o *ListAuditRecordsOptions) ([]*AuditRecord, error) {
+	return s.client.listAllAuditRecordsContext(ctx, fmt.Sprintf("/schedules/%s/audit/records", scheduleID), "/schedules/{id}/audit/records", o)
+}
+
+type listSchedulesOptionsGen struct {
+	options *ListSchedulesOptions
+}
+
+func (o *listSchedulesOptionsGen) currentOffset() int {
+	return o.options.Offset
+}
+
+func (o *listSchedulesOptionsGen) changeOffset(i int) {
+	o.options.Offset = i
+}
+
+func (o *listSchedulesOptionsGen) buildStruct() interface{} {
+	return o.options
+}
+
+// ListAll lists existing schedules, going through every page of results.
+func (s *ScheduleService) ListAll(o *ListSchedulesOptions) ([]*Schedule, error) {
+	return s.ListAllContext(context.Background(), o)
+}
+
+// ListAllContext lists existing schedules, going through every page of
+// results.
+func (s *ScheduleService) ListAllContext(ctx context.Context, o *ListSchedulesOptions) ([]*Schedule, error) {
+	schedules := make([]*Schedule, 0)
+	err := s.ListPagesContext(ctx, o, func(page []*Schedule) error {
+		schedules = append(schedules, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// FindByName finds the schedule with exactly the given name, going through
+// every page of schedules matching it. When no schedule has that name,
+// IsNotFound reports true for the error; when several do, the error is an
+// *AmbiguousMatchError.
+func (s *ScheduleService) FindByName(name string) (*Schedule, error) {
+	return s.FindByNameContext(context.Background(), name)
+}
+
+// FindByNameContext finds the schedule with exactly the given name, going
+// through every page of schedules matching it. When no schedule has that
+// name, IsNotFound reports true for the error; when several do, the error is
+// an *AmbiguousMatchError.
+func (s *ScheduleService) FindByNameContext(ctx context.Context, name string) (*Schedule, error) {
+	if name == "" {
+		return nil, errors.New("a name is required to find a schedule")
+	}
+
+	// The query also matches schedules whose name merely contains the name.
+	var matches []*Schedule
+	it := s.IterContext(ctx, &ListSchedulesOptions{ListOptions: ListOptions{Limit: 100}, Query: name})
+	for it.Next() {
+		if schedule := it.Value(); schedule.Name == name {
+			matches = append(matches, schedule)
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("schedule named %q: %w", name, ErrNotFound)
+	case 1:
+		return matches[0], nil
+	}
+
+	ids := make([]string, 0, len(matches))
+	for _, schedule := range matches {
+		ids = append(ids, schedule.ID)
+	}
+	return nil, &AmbiguousMatchError{Value: name, IDs: ids}
+}
+
+// ListPages lists existing schedules, calling fn with every page of results until
+// there are no more pages or fn returns an error.
+func (s *ScheduleService) ListPages(o *ListSchedulesOptions, fn func([]*Schedule) error) error {
+	return s.ListPagesContext(context.Background(), o, fn)
+}
+
+// ListPagesContext lists existing schedules, calling fn with every page of results until
+// there are no more pages or fn returns an error.
+func (s *ScheduleService) ListPagesContext(ctx context.Context, o *ListSchedulesOptions, fn func([]*Schedule) error) error {
+	opts := ListSchedulesOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	responseHandler := func(response *Response) (PaginationMeta, *Response, error) {
+		var result ListSchedulesResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return PaginationMeta{}, response, err
+		}
+
+		if err := fn(result.Schedules); err != nil {
+			return PaginationMeta{}, response, err
+		}
+
+		return PaginationMeta{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+
+	return s.client.newRequestPagedGetQueryDoContext(ctx, "/schedules", responseHandler, &listSchedulesOptionsGen{options: &opts}, WithRoute("/schedules"))
+}
+
+// Iter returns an Iterator over existing schedules, which requests pages of
+// results as they are needed.
+func (s *ScheduleService) Iter(o *ListSchedulesOptions) *Iterator[*Schedule] {
+	return s.IterContext(context.Background(), o)
+}
+
+// IterContext returns an Iterator over existing schedules, which requests pages
+// of results as they are needed.
+func (s *ScheduleService) IterContext(ctx context.Context, o *ListSchedulesOptions) *Iterator[*Schedule] {
+	opts := ListSchedulesOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	return Iterate(opts.ListOptions, func(lo ListOptions) ([]*Schedule, PaginationMeta, *Response, error) {
+		opts.ListOptions = lo
+		v, resp, err := s.ListContext(ctx, &opts)
+		if err != nil {
+			return nil, PaginationMeta{}, resp, err
+		}
+		return v.Schedules, v.PaginationMeta, resp, nil
+	})
 }
@@ -2,9 +2,12 @@ package pagerduty
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestSchedulesList(t *testing.T) {
@@ -81,6 +84,90 @@ func TestSchedulesDelete(t *testing.T) {
 	}
 }
 
+func TestSchedulesDeleteUsedByEscalationPolicies(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/schedules/PI7DH85", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{
+			"error": {
+				"code": 2001,
+				"message": "Invalid Input Provided",
+				"errors": ["Schedule can't be deleted because it's being used by escalation policies"],
+				"conflicts": [
+					{"id": "PANZZEQ", "type": "escalation_policy_reference", "summary": "Engineering Escalation Policy"},
+					{"id": "PT20YPA", "type": "escalation_policy_reference", "summary": "Support Escalation Policy"}
+				]
+			}
+		}`))
+	})
+
+	_, err := client.Schedules.Delete("PI7DH85")
+
+	var deletionErr *ScheduleDeletionError
+	if !errors.As(err, &deletionErr) {
+		t.Fatalf("got error %v, want a *ScheduleDeletionError", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != 2001 {
+		t.Errorf("got error %v, want an *APIError with code 2001", err)
+	}
+
+	want := &ScheduleDeletionError{
+		ScheduleID: "PI7DH85",
+		EscalationPolicies: []*EscalationPolicyReference{
+			{ID: "PANZZEQ", Type: "escalation_policy_reference", Summary: "Engineering Escalation Policy"},
+			{ID: "PT20YPA", Type: "escalation_policy_reference", Summary: "Support Escalation Policy"},
+		},
+	}
+	deletionErr.Err = nil
+	if !reflect.DeepEqual(deletionErr, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", deletionErr, want)
+	}
+}
+
+func TestSchedulesDeleteWithOpenIncidents(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/schedules/PI7DH85", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{
+			"error": {
+				"code": 2001,
+				"message": "Invalid Input Provided",
+				"errors": ["Schedule can't be deleted because it's being used by escalation policies with open incidents"],
+				"conflicts": [
+					{"id": "PANZZEQ", "type": "escalation_policy_reference", "summary": "Engineering Escalation Policy"},
+					{"id": "PT4KHLK", "type": "incident_reference", "summary": "[#1234] The server is on fire."}
+				]
+			}
+		}`))
+	})
+
+	_, err := client.Schedules.Delete("PI7DH85")
+
+	var deletionErr *ScheduleDeletionError
+	if !errors.As(err, &deletionErr) {
+		t.Fatalf("got error %v, want a *ScheduleDeletionError", err)
+	}
+
+	want := &ScheduleDeletionError{
+		ScheduleID:         "PI7DH85",
+		EscalationPolicies: []*EscalationPolicyReference{{ID: "PANZZEQ", Type: "escalation_policy_reference", Summary: "Engineering Escalation Policy"}},
+		Incidents:          []*IncidentReference{{ID: "PT4KHLK", Type: "incident_reference", Summary: "[#1234] The server is on fire."}},
+		OpenIncidents:      true,
+	}
+	deletionErr.Err = nil
+	if !reflect.DeepEqual(deletionErr, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", deletionErr, want)
+	}
+}
+
 func TestSchedulesGet(t *testing.T) {
 	setup()
 	defer teardown()
@@ -179,16 +266,23 @@ func TestSchedulesCreateOverride(t *testing.T) {
 
 	mux.HandleFunc("/schedules/1/overrides", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
-		w.Write([]byte(`{"override": {"id": "1", "user": { "id": "1" }}}`))
+		testBody(t, r, `{"override":{"start":"2012-07-01T00:00:00-04:00","end":"2012-07-02T00:00:00Z","user":{"id":"1"}}}`)
+		w.Write([]byte(`{"override": {"id": "1", "start": "2012-07-01T00:00:00-04:00", "end": "2012-07-01T20:00:00-04:00", "user": { "id": "1" }}}`))
 	})
 
-	resp, _, err := client.Schedules.CreateOverride("1", &Override{User: &UserReference{ID: "1"}})
+	resp, _, err := client.Schedules.CreateOverride("1", &Override{
+		Start: NewTimestamp(time.Date(2012, 7, 1, 0, 0, 0, 0, time.FixedZone("EDT", -4*3600))),
+		End:   NewTimestamp(time.Date(2012, 7, 2, 0, 0, 0, 0, time.UTC)),
+		User:  &UserReference{ID: "1"},
+	})
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	want := &Override{
-		ID: "1",
+		ID:    "1",
+		Start: mustParseTimestamp(t, "2012-07-01T00:00:00-04:00"),
+		End:   mustParseTimestamp(t, "2012-07-01T20:00:00-04:00"),
 		User: &UserReference{
 			ID: "1",
 		},
@@ -239,3 +333,680 @@ func TestSchedulesListOnCalls(t *testing.T) {
 		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
 	}
 }
+
+func TestSchedulesListOnCallUsers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/schedules/1/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.Query().Get("since"), "2023-01-01T09:00:00+01:00"; got != want {
+			t.Errorf("since = %q, want %q", got, want)
+		}
+		if got, want := r.URL.Query().Get("until"), "2023-01-02T08:00:00Z"; got != want {
+			t.Errorf("until = %q, want %q", got, want)
+		}
+		w.Write([]byte(`{"users": [{"id": "1", "type": "user", "name": "foo", "email": "foo@bar.com"}]}`))
+	})
+
+	since := time.Date(2023, 1, 1, 9, 0, 0, 0, time.FixedZone("CET", 3600))
+	until := time.Date(2023, 1, 2, 8, 0, 0, 0, time.UTC)
+
+	resp, _, err := client.Schedules.ListOnCallUsers("1", &ListOnCallUsersOptions{Since: since, Until: until})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*User{{ID: "1", Type: "user", Name: "foo", Email: "foo@bar.com"}}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestSchedulesListOnCallUsersNow(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/schedules/1/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if q := r.URL.RawQuery; q != "" {
+			t.Errorf("query = %q, want none", q)
+		}
+		w.Write([]byte(`{"users": [{"id": "1"}]}`))
+	})
+
+	resp, _, err := client.Schedules.ListOnCallUsers("1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []*User{{ID: "1"}}; !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+// testScheduleJSON is a schedule as returned by the API, limited to the
+// fields modeled by Schedule.
+const testScheduleJSON = `{
+	"id": "PI7DH85",
+	"type": "schedule",
+	"summary": "Daily Engineering Rotation",
+	"self": "https://api.pagerduty.com/schedules/PI7DH85",
+	"html_url": "https://subdomain.pagerduty.com/schedules/PI7DH85",
+	"name": "Daily Engineering Rotation",
+	"time_zone": "America/New_York",
+	"description": "Rotation schedule for engineering",
+	"schedule_layers": [
+		{
+			"id": "PG68P1M",
+			"name": "Night Shift",
+			"start": "2015-11-06T20:00:00-05:00",
+			"end": null,
+			"rotation_virtual_start": "2015-11-06T20:00:00-05:00",
+			"rotation_turn_length_seconds": 86400,
+			"users": [
+				{"user": {"id": "PXPGF42", "type": "user_reference", "summary": "Earline Greenholt"}},
+				{"user": {"id": "PAM4FGS", "type": "user_reference", "summary": "Kyler Kuhn"}},
+				{"user": {"id": "P1D3ZQA", "type": "user_reference", "summary": "Wiley Jacobson"}}
+			],
+			"restrictions": [
+				{"type": "daily_restriction", "start_time_of_day": "20:00:00", "duration_seconds": 43200}
+			]
+		},
+		{
+			"id": "PQGUQ6F",
+			"name": "Weekend Backup",
+			"start": "2015-11-07T00:00:00-05:00",
+			"end": "2016-11-07T00:00:00-05:00",
+			"rotation_virtual_start": "2015-11-07T00:00:00-05:00",
+			"rotation_turn_length_seconds": 604800,
+			"users": [
+				{"user": {"id": "P1D3ZQA", "type": "user_reference"}},
+				{"user": {"id": "PXPGF42", "type": "user_reference"}}
+			],
+			"restrictions": [
+				{"type": "weekly_restriction", "start_time_of_day": "00:00:00", "start_day_of_week": 6, "duration_seconds": 172800}
+			]
+		}
+	],
+	"users": [
+		{"id": "PXPGF42", "type": "user_reference", "summary": "Earline Greenholt"},
+		{"id": "PAM4FGS", "type": "user_reference", "summary": "Kyler Kuhn"},
+		{"id": "P1D3ZQA", "type": "user_reference", "summary": "Wiley Jacobson"}
+	],
+	"escalation_policies": [
+		{"id": "PT20YPA", "type": "escalation_policy_reference", "summary": "Engineering Escalation Policy"}
+	],
+	"teams": [
+		{"id": "PQ9K7I8", "type": "team_reference", "summary": "Engineering"}
+	]
+}`
+
+func TestScheduleRoundTrip(t *testing.T) {
+	var schedule Schedule
+	if err := json.Unmarshal([]byte(testScheduleJSON), &schedule); err != nil {
+		t.Fatal(err)
+	}
+
+	night := schedule.ScheduleLayers[0]
+	if night.End != nil {
+		t.Errorf("End = %v, want nil", night.End)
+	}
+	var users []string
+	for _, u := range night.Users {
+		users = append(users, u.User.ID)
+	}
+	if want := []string{"PXPGF42", "PAM4FGS", "P1D3ZQA"}; !reflect.DeepEqual(users, want) {
+		t.Errorf("layer users = %v, want %v in order", users, want)
+	}
+
+	weekly := schedule.ScheduleLayers[1].Restrictions[0]
+	if want := (&Restriction{Type: RestrictionTypeWeekly, StartTimeOfDay: "00:00:00", StartDayOfWeek: 6, DurationSeconds: 172800}); !reflect.DeepEqual(weekly, want) {
+		t.Errorf("restriction = %#v, want %#v", weekly, want)
+	}
+
+	b, err := json.Marshal(&schedule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got, want interface{}
+	json.Unmarshal(b, &got)
+	json.Unmarshal([]byte(testScheduleJSON), &want)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("encoded \n\n%s want \n\n%s", b, testScheduleJSON)
+	}
+}
+
+func TestSchedulesCreateRoundTrip(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/schedules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testQueryValue(t, r, "overflow", "true")
+
+		var got, want interface{}
+		json.NewDecoder(r.Body).Decode(&got)
+		json.Unmarshal([]byte(`{"schedule": `+testScheduleJSON+`}`), &want)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Request body = %v, want %v", got, want)
+		}
+		w.Write([]byte(`{"schedule": ` + testScheduleJSON + `}`))
+	})
+
+	var input Schedule
+	if err := json.Unmarshal([]byte(testScheduleJSON), &input); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, _, err := client.Schedules.Create(&input, &CreateScheduleOptions{Overflow: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(resp, &input) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, &input)
+	}
+}
+
+func TestSchedulesGetOverflow(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/schedules/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "overflow", "true")
+		testQueryValue(t, r, "since", "2015-11-06T00:00:00Z")
+		w.Write([]byte(`{"schedule": {"id": "1"}}`))
+	})
+
+	if _, _, err := client.Schedules.Get("1", &GetScheduleOptions{Overflow: true, Since: time.Date(2015, 11, 6, 0, 0, 0, 0, time.UTC)}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSchedulesListQueryInclude(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/schedules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "query", "Engineering")
+		testQueryValue(t, r, "include[]", "schedule_layers")
+		w.Write([]byte(`{"schedules": [{
+			"id": "PI7DH85",
+			"name": "Daily Engineering Rotation",
+			"schedule_layers": [{"id": "PG68P1M", "end": null, "users": [{"user": {"id": "PXPGF42"}}]}],
+			"users": [{"id": "PXPGF42", "type": "user_reference"}],
+			"escalation_policies": [{"id": "PT20YPA", "type": "escalation_policy_reference"}]
+		}], "limit": 25, "offset": 0, "total": 1, "more": false}`))
+	})
+
+	resp, _, err := client.Schedules.List(&ListSchedulesOptions{
+		ListOptions: ListOptions{Total: true},
+		Query:       "Engineering",
+		Include:     []string{"schedule_layers"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListSchedulesResponse{
+		PaginationMeta: PaginationMeta{Limit: 25, Total: 1},
+		Schedules: []*Schedule{
+			{
+				ID:                 "PI7DH85",
+				Name:               "Daily Engineering Rotation",
+				ScheduleLayers:     []*ScheduleLayer{{ID: "PG68P1M", Users: []*UserReferenceWrapper{{User: &UserReference{ID: "PXPGF42"}}}}},
+				Users:              []*UserReference{{ID: "PXPGF42", Type: "user_reference"}},
+				EscalationPolicies: []*EscalationPolicyReference{{ID: "PT20YPA", Type: "escalation_policy_reference"}},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestSchedulesListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/schedules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("offset") {
+		case "0", "":
+			w.Write([]byte(`{"schedules": [{"id": "1"}], "limit": 1, "offset": 0, "more": true}`))
+		case "1":
+			w.Write([]byte(`{"schedules": [{"id": "2"}], "limit": 1, "offset": 1, "more": false}`))
+		default:
+			t.Errorf("unexpected offset %q", r.URL.Query().Get("offset"))
+		}
+	})
+
+	resp, err := client.Schedules.ListAll(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []*Schedule{{ID: "1"}, {ID: "2"}}; !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestSchedulesFindByName(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/schedules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch q := r.URL.Query().Get("query"); q {
+		case "Primary":
+			w.Write([]byte(`{"schedules": [{"id": "1", "name": "Primary Backup"}, {"id": "2", "name": "Primary"}], "more": false}`))
+		case "Backup":
+			w.Write([]byte(`{"schedules": [{"id": "1", "name": "Primary Backup"}], "more": false}`))
+		case "Shared":
+			w.Write([]byte(`{"schedules": [{"id": "3", "name": "Shared"}, {"id": "4", "name": "Shared"}], "more": false}`))
+		default:
+			t.Errorf("unexpected query %q", q)
+		}
+	})
+
+	schedule, err := client.Schedules.FindByName("Primary")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&Schedule{ID: "2", Name: "Primary"}); !reflect.DeepEqual(schedule, want) {
+		t.Errorf("returned %#v; want %#v", schedule, want)
+	}
+
+	if _, err := client.Schedules.FindByName("Backup"); !IsNotFound(err) {
+		t.Errorf("got error %v, want a not found error", err)
+	}
+
+	_, err = client.Schedules.FindByName("Shared")
+	var ambiguousErr *AmbiguousMatchError
+	if !errors.As(err, &ambiguousErr) {
+		t.Fatalf("got error %v, want an *AmbiguousMatchError", err)
+	}
+	if want := []string{"3", "4"}; !reflect.DeepEqual(ambiguousErr.IDs, want) {
+		t.Errorf("IDs = %v, want %v", ambiguousErr.IDs, want)
+	}
+}
+
+func TestSchedulesListAuditRecords(t *testing.T) {
+	setup()
+	defer teardown()
+
+	since := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mux.HandleFunc("/schedules/PI7DH85/audit/records", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "since", "2023-01-01T00:00:00Z")
+		testQueryValue(t, r, "until", "2023-01-08T00:00:00Z")
+		w.Write([]byte(`{"records": [{
+			"id": "PDRECORDID1",
+			"execution_time": "2023-01-03T10:00:00.000Z",
+			"actors": [{"id": "PXPGF42", "type": "user_reference", "summary": "Earline Greenholt"}],
+			"method": {"type": "browser"},
+			"root_resource": {"id": "PI7DH85", "type": "schedule_reference"},
+			"action": "update",
+			"details": {
+				"resource": {"id": "PG68P1M", "type": "schedule_layer_reference"},
+				"fields": [{"name": "rotation_turn_length_seconds", "value": "604800", "before_value": "86400"}]
+			}
+		}], "limit": 25, "next_cursor": null}`))
+	})
+
+	resp, _, err := client.Schedules.ListAuditRecords("PI7DH85", &ListAuditRecordsOptions{
+		Since: since.Format(time.RFC3339),
+		Until: since.AddDate(0, 0, 7).Format(time.RFC3339),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListAuditRecordsResponse{
+		CursorPagination: CursorPagination{Limit: 25},
+		Records: []*AuditRecord{
+			{
+				ID:            "PDRECORDID1",
+				ExecutionTime: "2023-01-03T10:00:00.000Z",
+				Actors:        []*AuditActor{{ID: "PXPGF42", Type: "user_reference", Summary: "Earline Greenholt"}},
+				Method:        &AuditMethod{Type: "browser"},
+				RootResource:  &AuditResource{ID: "PI7DH85", Type: "schedule_reference"},
+				Action:        "update",
+				Details: &AuditDetails{
+					Resource: &AuditResource{ID: "PG68P1M", Type: "schedule_layer_reference"},
+					Fields:   []*AuditField{{Name: "rotation_turn_length_seconds", Value: "604800", BeforeValue: "86400"}},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestSchedulesListAllAuditRecords(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/schedules/PI7DH85/audit/records", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch cursor := r.URL.Query().Get("cursor"); cursor {
+		case "":
+			w.Write([]byte(`{"records": [{"id": "1"}], "next_cursor": "abc"}`))
+		case "abc":
+			w.Write([]byte(`{"records": [{"id": "2"}], "next_cursor": null}`))
+		default:
+			t.Errorf("unexpected cursor %q", cursor)
+		}
+	})
+
+	resp, err := client.Schedules.ListAllAuditRecords("PI7DH85", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []*AuditRecord{{ID: "1"}, {ID: "2"}}; !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestSchedulesGetRendered(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/schedules/PI7DH85", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "since", "2015-11-06T00:00:00Z")
+		testQueryValue(t, r, "until", "2016-02-04T00:00:00Z")
+		testQueryValue(t, r, "time_zone", "America/New_York")
+		if _, ok := r.URL.Query()["overflow"]; ok {
+			t.Error("unexpected overflow parameter")
+		}
+		w.Write([]byte(`{"schedule": {
+			"id": "PI7DH85",
+			"time_zone": "America/New_York",
+			"final_schedule": {
+				"name": "Final Schedule",
+				"rendered_coverage_percentage": 85.5,
+				"rendered_schedule_entries": [
+					{"start": "2015-11-06T20:00:00-05:00", "end": "2015-11-07T08:00:00-05:00", "user": {"id": "PXPGF42", "type": "user_reference"}}
+				]
+			},
+			"overrides_subschedule": {
+				"name": "Overrides",
+				"rendered_coverage_percentage": 0,
+				"rendered_schedule_entries": []
+			}
+		}}`))
+	})
+
+	since := time.Date(2015, 11, 6, 0, 0, 0, 0, time.UTC)
+	resp, _, err := client.Schedules.Get("PI7DH85", &GetScheduleOptions{
+		Since:    since,
+		Until:    since.AddDate(0, 0, 90),
+		TimeZone: "America/New_York",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Schedule{
+		ID:       "PI7DH85",
+		TimeZone: "America/New_York",
+		FinalSchedule: &SubSchedule{
+			Name:                       "Final Schedule",
+			RenderedCoveragePercentage: 85.5,
+			RenderedScheduleEntries: []*ScheduleLayerEntry{
+				{
+					Start: mustParseTimestamp(t, "2015-11-06T20:00:00-05:00"),
+					End:   mustParseTimestamp(t, "2015-11-07T08:00:00-05:00"),
+					User:  &UserReference{ID: "PXPGF42", Type: "user_reference"},
+				},
+			},
+		},
+		OverridesSubSchedule: &SubSchedule{
+			Name:                    "Overrides",
+			RenderedScheduleEntries: []*ScheduleLayerEntry{},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestScheduleLayerEntryInLocation(t *testing.T) {
+	schedule := &Schedule{
+		TimeZone: "US/Eastern",
+		FinalSchedule: &SubSchedule{
+			RenderedScheduleEntries: []*ScheduleLayerEntry{
+				{Start: mustParseTimestamp(t, "2015-11-07T01:00:00Z")},
+			},
+		},
+	}
+
+	loc, err := schedule.Location()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := schedule.FinalSchedule.RenderedScheduleEntries[0].Start.In(loc)
+	if got, want := start.Format(time.RFC3339), "2015-11-06T20:00:00-05:00"; got != want {
+		t.Errorf("start = %s, want %s", got, want)
+	}
+}
+
+func TestSchedulesCreateInvalidTimeZone(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/schedules", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s for an invalid schedule", r.Method, r.URL.Path)
+	})
+
+	_, _, err := client.Schedules.Create(&Schedule{Name: "foo", TimeZone: "PST"}, nil)
+
+	var tzErr *TimeZoneError
+	if !errors.As(err, &tzErr) || tzErr.Suggestion != "America/Los_Angeles" {
+		t.Fatalf("got error %v, want a *TimeZoneError suggesting America/Los_Angeles", err)
+	}
+}
+
+func TestSchedulesUpdateSkipValidation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/schedules/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		if r.URL.RawQuery != "" {
+			t.Errorf("unexpected query %q", r.URL.RawQuery)
+		}
+		testBody(t, r, `{"schedule":{"time_zone":"US/Pacific"}}`)
+		w.Write([]byte(`{"schedule": {"id": "1", "time_zone": "America/Los_Angeles"}}`))
+	})
+
+	input := &Schedule{TimeZone: "US/Pacific"}
+
+	if _, _, err := client.Schedules.Update("1", input, nil); err == nil {
+		t.Fatal("expected an error updating a schedule with a deprecated time zone")
+	}
+
+	resp, _, err := client.Schedules.Update("1", input, &UpdateScheduleOptions{SkipValidation: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loc, err := resp.Location()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loc.String() != "America/Los_Angeles" {
+		t.Errorf("Location() = %s, want America/Los_Angeles", loc)
+	}
+}
+
+func TestScheduleLayerValidate(t *testing.T) {
+	var schedule Schedule
+	if err := json.Unmarshal([]byte(testScheduleJSON), &schedule); err != nil {
+		t.Fatal(err)
+	}
+	for _, l := range schedule.ScheduleLayers {
+		if err := l.Validate(); err != nil {
+			t.Errorf("layer %s returned %v", l.ID, err)
+		}
+	}
+
+	daily := func(r Restriction) *ScheduleLayer {
+		return &ScheduleLayer{RotationTurnLengthSeconds: 86400, Restrictions: []*Restriction{&r}}
+	}
+
+	cases := map[string]*ScheduleLayer{
+		"negative rotation turn":   {RotationTurnLengthSeconds: -86400},
+		"nil restriction":          {RotationTurnLengthSeconds: 86400, Restrictions: []*Restriction{nil}},
+		"zero duration":            daily(Restriction{Type: RestrictionTypeDaily, StartTimeOfDay: "08:00:00"}),
+		"negative duration":        daily(Restriction{Type: RestrictionTypeDaily, StartTimeOfDay: "08:00:00", DurationSeconds: -3600}),
+		"daily with day of week":   daily(Restriction{Type: RestrictionTypeDaily, StartTimeOfDay: "08:00:00", StartDayOfWeek: 1, DurationSeconds: 3600}),
+		"weekly without day":       daily(Restriction{Type: RestrictionTypeWeekly, StartTimeOfDay: "08:00:00", DurationSeconds: 3600}),
+		"weekly with day 8":        daily(Restriction{Type: RestrictionTypeWeekly, StartTimeOfDay: "08:00:00", StartDayOfWeek: 8, DurationSeconds: 3600}),
+		"missing time of day":      daily(Restriction{Type: RestrictionTypeDaily, DurationSeconds: 3600}),
+		"time of day without secs": daily(Restriction{Type: RestrictionTypeDaily, StartTimeOfDay: "08:00", DurationSeconds: 3600}),
+		"time of day single digit": daily(Restriction{Type: RestrictionTypeDaily, StartTimeOfDay: "8:00:00", DurationSeconds: 3600}),
+		"time of day out of range": daily(Restriction{Type: RestrictionTypeDaily, StartTimeOfDay: "24:00:00", DurationSeconds: 3600}),
+	}
+
+	for name, l := range cases {
+		if err := l.Validate(); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	valid := map[string]*ScheduleLayer{
+		"no rotation turn length":  {},
+		"short rotation turn":      {RotationTurnLengthSeconds: 60},
+		"unknown restriction type": daily(Restriction{Type: "monthly_restriction", StartTimeOfDay: "8am"}),
+	}
+
+	for name, l := range valid {
+		if err := l.Validate(); err != nil {
+			t.Errorf("%s: returned %v", name, err)
+		}
+	}
+}
+
+func TestSchedulesCreateInvalidLayer(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/schedules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		requests++
+		w.Write([]byte(`{"schedule": {"id": "1"}}`))
+	})
+
+	input := &Schedule{
+		Name: "foo",
+		ScheduleLayers: []*ScheduleLayer{{
+			RotationTurnLengthSeconds: 86400,
+			Restrictions:              []*Restriction{{Type: RestrictionTypeDaily, StartTimeOfDay: "8am", DurationSeconds: 3600}},
+		}},
+	}
+
+	if _, _, err := client.Schedules.Create(input, nil); err == nil || !strings.Contains(err.Error(), "schedule layer 0: restriction 0") {
+		t.Errorf("got error %v, want one locating the restriction", err)
+	}
+	if requests != 0 {
+		t.Fatalf("sent %d requests for an invalid layer", requests)
+	}
+
+	if _, _, err := client.Schedules.Create(input, &CreateScheduleOptions{SkipValidation: true}); err != nil {
+		t.Fatal(err)
+	}
+	if requests != 1 {
+		t.Errorf("sent %d requests with SkipValidation, want 1", requests)
+	}
+}
+
+func TestSchedulesPreview(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/schedules/preview", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if got, want := r.URL.Query().Get("since"), "2015-11-06T00:00:00Z"; got != want {
+			t.Errorf("since = %q, want %q", got, want)
+		}
+		testBody(t, r, `{"schedule":{"name":"foo","time_zone":"UTC"}}`)
+		w.Write([]byte(`{"schedule": {"name": "foo", "time_zone": "UTC", "final_schedule": {"name": "Final Schedule", "rendered_coverage_percentage": 50}}}`))
+	})
+
+	resp, _, err := client.Schedules.Preview(&Schedule{Name: "foo", TimeZone: "UTC"}, &PreviewScheduleOptions{
+		Since: time.Date(2015, 11, 6, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Schedule{
+		Name:          "foo",
+		TimeZone:      "UTC",
+		FinalSchedule: &SubSchedule{Name: "Final Schedule", RenderedCoveragePercentage: 50},
+	}
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+// TestSchedulesOverflow checks that overflow is only sent when set, since
+// sending overflow=false is not the same as leaving it out for the API.
+func TestSchedulesOverflow(t *testing.T) {
+	calls := map[string]func(overflow bool) error{
+		"/schedules/1": func(overflow bool) error {
+			_, _, err := client.Schedules.Get("1", &GetScheduleOptions{Overflow: overflow})
+			return err
+		},
+		"/schedules/1/users": func(overflow bool) error {
+			_, _, err := client.Schedules.ListOnCallUsers("1", &ListOnCallUsersOptions{Overflow: overflow})
+			return err
+		},
+		"/schedules/1/overrides": func(overflow bool) error {
+			_, _, err := client.Schedules.ListOverrides("1", &ListOverridesOptions{Overflow: overflow})
+			return err
+		},
+		"/schedules/preview": func(overflow bool) error {
+			_, _, err := client.Schedules.Preview(&Schedule{}, &PreviewScheduleOptions{Overflow: overflow})
+			return err
+		},
+	}
+
+	for path, call := range calls {
+		for _, overflow := range []bool{false, true} {
+			setup()
+
+			mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+				values, ok := r.URL.Query()["overflow"]
+				switch {
+				case overflow && !(ok && len(values) == 1 && values[0] == "true"):
+					t.Errorf("%s: overflow = %v, want true", path, values)
+				case !overflow && ok:
+					t.Errorf("%s: overflow = %v, want it left out", path, values)
+				}
+				w.Write([]byte(`{}`))
+			})
+
+			if err := call(overflow); err != nil {
+				t.Errorf("%s: %v", path, err)
+			}
+
+			teardown()
+		}
+	}
+}
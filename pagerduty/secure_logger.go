@@ -20,12 +20,21 @@ const (
 	obscuredLogTag           = `<OBSCURED>`
 )
 
+// Logger is the interface used to log API requests and responses, see
+// Config.Logger. It is satisfied by *log.Logger.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
 type secureLogger struct {
-	logger         *log.Logger
+	logger         Logger
 	headersContent string
 	bodyContent    string
 	logsContent    string
 	canLog         bool
+	// maxBodyBytes limits how much of a body is buffered for logging, the
+	// rest is still read by the caller. Zero means no limit.
+	maxBodyBytes int64
 }
 
 func (l *secureLogger) handleHeadersLogsContent(h http.Header) {
@@ -37,9 +46,12 @@ func (l *secureLogger) handleHeadersLogsContent(h http.Header) {
 
 	if _, ok := headers["Authorization"]; ok {
 		authHeader := headers["Authorization"][0]
-		last4AuthChars := authHeader
-		if len(authHeader) > 4 {
-			last4AuthChars = authHeader[len(authHeader)-4:]
+		// Only the credential itself is considered, and it is hidden
+		// entirely when it is too short to safely reveal a suffix of.
+		credential := authHeader[strings.LastIndexAny(authHeader, " =")+1:]
+		last4AuthChars := ""
+		if len(credential) > 8 {
+			last4AuthChars = credential[len(credential)-4:]
 		}
 		headers["Authorization"] = []string{fmt.Sprintf("%s%s", obscuredLogTag, last4AuthChars)}
 	}
@@ -53,7 +65,11 @@ func (l *secureLogger) handleHeadersLogsContent(h http.Header) {
 func (l *secureLogger) handleBodyLogsContent(body io.ReadCloser) io.ReadCloser {
 	l.bodyContent = ""
 	if body != nil {
-		bodyBytes, err := io.ReadAll(body)
+		r := io.Reader(body)
+		if l.maxBodyBytes > 0 {
+			r = io.LimitReader(body, l.maxBodyBytes)
+		}
+		bodyBytes, err := io.ReadAll(r)
 		if err != nil {
 			log.Printf("[ERROR] Error reading body: %v\n", err)
 			return body
@@ -72,7 +88,10 @@ func (l *secureLogger) handleBodyLogsContent(body io.ReadCloser) io.ReadCloser {
 			}
 		}
 
-		body = io.NopCloser(bytes.NewBuffer(bodyBytes))
+		body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(bodyBytes), body), body}
 	}
 
 	return body
@@ -94,12 +113,12 @@ func (l *secureLogger) LogReq(req *http.Request) {
 		return
 	}
 
-	logsContent := fmt.Sprintf("%s %s %s", req.Method, req.URL.Path, req.Proto)
+	logsContent := fmt.Sprintf("%s %s %s", req.Method, req.URL.RequestURI(), req.Proto)
 	l.handleHeadersLogsContent(req.Header)
 	req.Body = l.handleBodyLogsContent(req.Body)
 	l.putTogetherLogsContent(&logsContent, secureLogRequestHeading)
 
-	l.logger.Print(logsContent)
+	l.logger.Printf("%s", logsContent)
 }
 
 func (l *secureLogger) LogRes(res *http.Response) {
@@ -112,7 +131,7 @@ func (l *secureLogger) LogRes(res *http.Response) {
 	res.Body = l.handleBodyLogsContent(res.Body)
 	l.putTogetherLogsContent(&logsContent, secureLogResponseHeading)
 
-	l.logger.Print(logsContent)
+	l.logger.Printf("%s", logsContent)
 }
 
 func (l *secureLogger) SetCanLog(flag bool) {
@@ -125,11 +144,13 @@ func newSecureLogger() *secureLogger {
 	tfLogFlag := os.Getenv("TF_LOG")
 	tfLogFlag = strings.ToUpper(tfLogFlag)
 
+	logger := log.Default()
+	logger.SetFlags(log.Flags() &^ (log.Ldate | log.Ltime))
+
 	secLogger := secureLogger{
-		logger: log.Default(),
+		logger: logger,
 		canLog: tfLogFlag == "INFO" && pdLogFlag == "SECURE",
 	}
-	secLogger.logger.SetFlags(log.Flags() &^ (log.Ldate | log.Ltime))
 
 	return &secLogger
 }
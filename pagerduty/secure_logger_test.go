@@ -45,6 +45,24 @@ func TestSecureLoggerHandleBodyLogsContent_NonJSON(t *testing.T) {
 	}
 }
 
+func TestSecureLoggerHandleBodyLogsContent_MaxBodyBytes(t *testing.T) {
+	l := newSecureLogger()
+	l.SetCanLog(true)
+	l.maxBodyBytes = 4
+	body := l.handleBodyLogsContent(io.NopCloser(bytes.NewReader([]byte(`non-json content`))))
+
+	if l.bodyContent != "non-\n" {
+		t.Errorf("body not limited to maxBodyBytes: got %q", l.bodyContent)
+	}
+	rest, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(rest) != "non-json content" {
+		t.Errorf("got body %q after logging, want the whole body", rest)
+	}
+}
+
 func TestSecureLoggerCanLog(t *testing.T) {
 	var buf bytes.Buffer
 	log.SetOutput(&buf)
@@ -98,3 +116,59 @@ func TestSecureLoggerLogRes(t *testing.T) {
 		t.Errorf("Response not logged correctly: got %s", buf.String())
 	}
 }
+
+func TestConfigLogger(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var buf bytes.Buffer
+	c, err := NewClient(&Config{
+		BaseURL:           server.URL,
+		Token:             "secret-token-value",
+		Logger:            log.New(&buf, "", 0),
+		RetryServerErrors: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calls := 0
+	mux.HandleFunc("/teams/1", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"team": {"id": "1", "name": "response-team"}}`))
+	})
+
+	if _, _, err := c.Teams.Update("1", &Team{Name: "request-team"}); err != nil {
+		t.Fatal(err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		"PUT /teams/1",
+		"<OBSCURED>alue",
+		`"name": "request-team"`,
+		"502 Bad Gateway",
+		`"name": "response-team"`,
+		"Retrying PUT /teams/1",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log output does not contain %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "secret-token-value") {
+		t.Errorf("log output contains the API token:\n%s", out)
+	}
+}
+
+func TestSecureLoggerShortCredential(t *testing.T) {
+	l := newSecureLogger()
+	l.handleHeadersLogsContent(http.Header{"Authorization": []string{"Token token=foo"}})
+
+	if strings.Contains(l.headersContent, "foo") {
+		t.Errorf("Authorization header not properly obscured: got %s", l.headersContent)
+	}
+}
@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -192,10 +193,11 @@ type GetIntegrationOptions struct {
 
 // ListServicesOptions represents options when listing services.
 type ListServicesOptions struct {
-	Limit    int      `url:"limit,omitempty"`
-	More     bool     `url:"more,omitempty"`
-	Offset   int      `url:"offset,omitempty"`
-	Total    int      `url:"total,omitempty"`
+	ListOptions
+
+	// Deprecated: More is not a request parameter and is ignored.
+	More bool `url:"-"`
+
 	Includes []string `url:"include,omitempty,brackets"`
 	Query    string   `url:"query,omitempty"`
 	SortBy   string   `url:"sort_by,omitempty"`
@@ -205,11 +207,8 @@ type ListServicesOptions struct {
 
 // ListServicesResponse represents a list response of services.
 type ListServicesResponse struct {
-	Limit    int  `json:"limit,omitempty"`
-	More     bool `json:"more,omitempty"`
-	Offset   int  `json:"offset,omitempty"`
-	Total    int  `json:"total,omitempty"`
-	Services []*Service
+	PaginationMeta
+	Services []*Service `json:"services,omitempty"`
 }
 
 // GetServiceOptions represents options when retrieving a service.
@@ -219,27 +218,29 @@ type GetServiceOptions struct {
 
 // ListServiceEventRuleOptions represents options when retrieving a list of event rules for a service
 type ListServiceEventRuleOptions struct {
-	Limit  int  `json:"limit,omitempty"`
-	More   bool `json:"more,omitempty"`
-	Offset int  `json:"offset,omitempty"`
-	Total  int  `json:"total,omitempty"`
+	ListOptions
+
+	// Deprecated: More is not a request parameter and is ignored.
+	More bool `url:"-"`
 }
 
 // ListServiceEventRuleResponse represents a list of event rules for a service
 type ListServiceEventRuleResponse struct {
-	Limit      int                 `json:"limit,omitempty"`
-	More       bool                `json:"more,omitempty"`
-	Offset     int                 `json:"offset,omitempty"`
-	Total      int                 `json:"total,omitempty"`
+	PaginationMeta
 	EventRules []*ServiceEventRule `json:"rules,omitempty"`
 }
 
 // List lists existing services.
 func (s *ServicesService) List(o *ListServicesOptions) (*ListServicesResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists existing services.
+func (s *ServicesService) ListContext(ctx context.Context, o *ListServicesOptions) (*ListServicesResponse, *Response, error) {
 	u := "/services"
 	v := new(ListServicesResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/services"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -249,10 +250,15 @@ func (s *ServicesService) List(o *ListServicesOptions) (*ListServicesResponse, *
 
 // Create creates a new service.
 func (s *ServicesService) Create(service *Service) (*Service, *Response, error) {
+	return s.CreateContext(context.Background(), service)
+}
+
+// CreateContext creates a new service.
+func (s *ServicesService) CreateContext(ctx context.Context, service *Service) (*Service, *Response, error) {
 	u := "/services"
 	v := new(ServicePayload)
 
-	resp, err := s.client.newRequestDo("POST", u, nil, &ServicePayload{Service: service}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &ServicePayload{Service: service}, &v, WithRoute("/services"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -262,16 +268,26 @@ func (s *ServicesService) Create(service *Service) (*Service, *Response, error)
 
 // Delete removes an existing service.
 func (s *ServicesService) Delete(id string) (*Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext removes an existing service.
+func (s *ServicesService) DeleteContext(ctx context.Context, id string) (*Response, error) {
 	u := fmt.Sprintf("/services/%s", id)
-	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/services/{id}"))
 }
 
 // Get retrieves information about a service.
 func (s *ServicesService) Get(id string, o *GetServiceOptions) (*Service, *Response, error) {
+	return s.GetContext(context.Background(), id, o)
+}
+
+// GetContext retrieves information about a service.
+func (s *ServicesService) GetContext(ctx context.Context, id string, o *GetServiceOptions) (*Service, *Response, error) {
 	u := fmt.Sprintf("/services/%s", id)
 	v := new(ServicePayload)
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/services/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -281,10 +297,15 @@ func (s *ServicesService) Get(id string, o *GetServiceOptions) (*Service, *Respo
 
 // Update updates an existing service.
 func (s *ServicesService) Update(id string, service *Service) (*Service, *Response, error) {
+	return s.UpdateContext(context.Background(), id, service)
+}
+
+// UpdateContext updates an existing service.
+func (s *ServicesService) UpdateContext(ctx context.Context, id string, service *Service) (*Service, *Response, error) {
 	u := fmt.Sprintf("/services/%s", id)
 	v := new(ServicePayload)
 
-	resp, err := s.client.newRequestDo("PUT", u, nil, &ServicePayload{Service: service}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &ServicePayload{Service: service}, &v, WithRoute("/services/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -294,10 +315,15 @@ func (s *ServicesService) Update(id string, service *Service) (*Service, *Respon
 
 // CreateIntegration creates a new service integration.
 func (s *ServicesService) CreateIntegration(serviceID string, integration *Integration) (*Integration, *Response, error) {
+	return s.CreateIntegrationContext(context.Background(), serviceID, integration)
+}
+
+// CreateIntegrationContext creates a new service integration.
+func (s *ServicesService) CreateIntegrationContext(ctx context.Context, serviceID string, integration *Integration) (*Integration, *Response, error) {
 	u := fmt.Sprintf("/services/%s/integrations", serviceID)
 	v := new(IntegrationPayload)
 
-	resp, err := s.client.newRequestDo("POST", u, nil, &IntegrationPayload{Integration: integration}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &IntegrationPayload{Integration: integration}, &v, WithRoute("/services/{id}/integrations"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -307,10 +333,15 @@ func (s *ServicesService) CreateIntegration(serviceID string, integration *Integ
 
 // GetIntegration retrieves information about a service integration.
 func (s *ServicesService) GetIntegration(serviceID, integrationID string, o *GetIntegrationOptions) (*Integration, *Response, error) {
+	return s.GetIntegrationContext(context.Background(), serviceID, integrationID, o)
+}
+
+// GetIntegrationContext retrieves information about a service integration.
+func (s *ServicesService) GetIntegrationContext(ctx context.Context, serviceID, integrationID string, o *GetIntegrationOptions) (*Integration, *Response, error) {
 	u := fmt.Sprintf("/services/%s/integrations/%s", serviceID, integrationID)
 	v := new(IntegrationPayload)
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/services/{id}/integrations/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -320,10 +351,15 @@ func (s *ServicesService) GetIntegration(serviceID, integrationID string, o *Get
 
 // UpdateIntegration updates an existing service integration.
 func (s *ServicesService) UpdateIntegration(serviceID, integrationID string, integration *Integration) (*Integration, *Response, error) {
+	return s.UpdateIntegrationContext(context.Background(), serviceID, integrationID, integration)
+}
+
+// UpdateIntegrationContext updates an existing service integration.
+func (s *ServicesService) UpdateIntegrationContext(ctx context.Context, serviceID, integrationID string, integration *Integration) (*Integration, *Response, error) {
 	u := fmt.Sprintf("/services/%s/integrations/%s", serviceID, integrationID)
 	v := new(IntegrationPayload)
 
-	resp, err := s.client.newRequestDo("PUT", u, nil, &IntegrationPayload{Integration: integration}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &IntegrationPayload{Integration: integration}, &v, WithRoute("/services/{id}/integrations/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -333,16 +369,26 @@ func (s *ServicesService) UpdateIntegration(serviceID, integrationID string, int
 
 // DeleteIntegration removes an existing service integration.
 func (s *ServicesService) DeleteIntegration(serviceID, integrationID string) (*Response, error) {
+	return s.DeleteIntegrationContext(context.Background(), serviceID, integrationID)
+}
+
+// DeleteIntegrationContext removes an existing service integration.
+func (s *ServicesService) DeleteIntegrationContext(ctx context.Context, serviceID, integrationID string) (*Response, error) {
 	u := fmt.Sprintf("/services/%s/integrations/%s", serviceID, integrationID)
-	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/services/{id}/integrations/{id}"))
 }
 
 // ListEventRules lists existing service event rules.
 func (s *ServicesService) ListEventRules(serviceID string, o *ListServiceEventRuleOptions) (*ListServiceEventRuleResponse, *Response, error) {
+	return s.ListEventRulesContext(context.Background(), serviceID, o)
+}
+
+// ListEventRulesContext lists existing service event rules.
+func (s *ServicesService) ListEventRulesContext(ctx context.Context, serviceID string, o *ListServiceEventRuleOptions) (*ListServiceEventRuleResponse, *Response, error) {
 	u := fmt.Sprintf("/services/%s/rules", serviceID)
 	v := new(ListServiceEventRuleResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/services/{id}/rules"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -352,11 +398,16 @@ func (s *ServicesService) ListEventRules(serviceID string, o *ListServiceEventRu
 
 // CreateEventRule creates a new service event rule.
 func (s *ServicesService) CreateEventRule(serviceID string, eventRule *ServiceEventRule) (*ServiceEventRule, *Response, error) {
+	return s.CreateEventRuleContext(context.Background(), serviceID, eventRule)
+}
+
+// CreateEventRuleContext creates a new service event rule.
+func (s *ServicesService) CreateEventRuleContext(ctx context.Context, serviceID string, eventRule *ServiceEventRule) (*ServiceEventRule, *Response, error) {
 	u := fmt.Sprintf("/services/%s/rules", serviceID)
 	v := new(ServiceEventRulePayload)
 	p := ServiceEventRulePayload{Rule: eventRule}
 
-	resp, err := s.client.newRequestDo("POST", u, nil, p, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, p, &v, WithRoute("/services/{id}/rules"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -366,10 +417,15 @@ func (s *ServicesService) CreateEventRule(serviceID string, eventRule *ServiceEv
 
 // GetEventRule retrieves information about a service event rule.
 func (s *ServicesService) GetEventRule(serviceID, ruleID string) (*ServiceEventRule, *Response, error) {
+	return s.GetEventRuleContext(context.Background(), serviceID, ruleID)
+}
+
+// GetEventRuleContext retrieves information about a service event rule.
+func (s *ServicesService) GetEventRuleContext(ctx context.Context, serviceID, ruleID string) (*ServiceEventRule, *Response, error) {
 	u := fmt.Sprintf("/services/%s/rules/%s", serviceID, ruleID)
 	v := new(ServiceEventRulePayload)
 
-	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/services/{id}/rules/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -379,11 +435,16 @@ func (s *ServicesService) GetEventRule(serviceID, ruleID string) (*ServiceEventR
 
 // UpdateEventRule updates an existing service event rule.
 func (s *ServicesService) UpdateEventRule(serviceID, ruleID string, eventRule *ServiceEventRule) (*ServiceEventRule, *Response, error) {
+	return s.UpdateEventRuleContext(context.Background(), serviceID, ruleID, eventRule)
+}
+
+// UpdateEventRuleContext updates an existing service event rule.
+func (s *ServicesService) UpdateEventRuleContext(ctx context.Context, serviceID, ruleID string, eventRule *ServiceEventRule) (*ServiceEventRule, *Response, error) {
 	u := fmt.Sprintf("/services/%s/rules/%s", serviceID, ruleID)
 	v := new(ServiceEventRulePayload)
 	p := ServiceEventRulePayload{Rule: eventRule}
 
-	resp, err := s.client.newRequestDo("PUT", u, nil, p, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, p, &v, WithRoute("/services/{id}/rules/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -393,6 +454,86 @@ func (s *ServicesService) UpdateEventRule(serviceID, ruleID string, eventRule *S
 
 // DeleteEventRule removes an existing service event rule.
 func (s *ServicesService) DeleteEventRule(serviceID, ruleID string) (*Response, error) {
+	return s.DeleteEventRuleContext(context.Background(), serviceID, ruleID)
+}
+
+// DeleteEventRuleContext removes an existing service event rule.
+func (s *ServicesService) DeleteEventRuleContext(ctx context.Context, serviceID, ruleID string) (*Response, error) {
 	u := fmt.Sprintf("/services/%s/rules/%s", serviceID, ruleID)
-	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/services/{id}/rules/{id}"))
+}
+
+type listServicesOptionsGen struct {
+	options *ListServicesOptions
+}
+
+func (o *listServicesOptionsGen) currentOffset() int {
+	return o.options.Offset
+}
+
+func (o *listServicesOptionsGen) changeOffset(i int) {
+	o.options.Offset = i
+}
+
+func (o *listServicesOptionsGen) buildStruct() interface{} {
+	return o.options
+}
+
+// ListPages lists existing services, calling fn with every page of results until
+// there are no more pages or fn returns an error.
+func (s *ServicesService) ListPages(o *ListServicesOptions, fn func([]*Service) error) error {
+	return s.ListPagesContext(context.Background(), o, fn)
+}
+
+// ListPagesContext lists existing services, calling fn with every page of results until
+// there are no more pages or fn returns an error.
+func (s *ServicesService) ListPagesContext(ctx context.Context, o *ListServicesOptions, fn func([]*Service) error) error {
+	opts := ListServicesOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	responseHandler := func(response *Response) (PaginationMeta, *Response, error) {
+		var result ListServicesResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return PaginationMeta{}, response, err
+		}
+
+		if err := fn(result.Services); err != nil {
+			return PaginationMeta{}, response, err
+		}
+
+		return PaginationMeta{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+
+	return s.client.newRequestPagedGetQueryDoContext(ctx, "/services", responseHandler, &listServicesOptionsGen{options: &opts}, WithRoute("/services"))
+}
+
+// Iter returns an Iterator over existing services, which requests pages of
+// results as they are needed.
+func (s *ServicesService) Iter(o *ListServicesOptions) *Iterator[*Service] {
+	return s.IterContext(context.Background(), o)
+}
+
+// IterContext returns an Iterator over existing services, which requests pages
+// of results as they are needed.
+func (s *ServicesService) IterContext(ctx context.Context, o *ListServicesOptions) *Iterator[*Service] {
+	opts := ListServicesOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	return Iterate(opts.ListOptions, func(lo ListOptions) ([]*Service, PaginationMeta, *Response, error) {
+		opts.ListOptions = lo
+		v, resp, err := s.ListContext(ctx, &opts)
+		if err != nil {
+			return nil, PaginationMeta{}, resp, err
+		}
+		return v.Services, v.PaginationMeta, resp, nil
+	})
 }
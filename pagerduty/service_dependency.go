@@ -1,6 +1,9 @@
 package pagerduty
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // ServiceDependencyService handles the communication with service dependency
 // related methods of the PagerDuty API.
@@ -27,10 +30,15 @@ type ListServiceDependencies struct {
 
 // AssociateServiceDependencies Create new dependencies between two services
 func (s *ServiceDependencyService) AssociateServiceDependencies(dependencies *ListServiceDependencies) (*ListServiceDependencies, *Response, error) {
+	return s.AssociateServiceDependenciesContext(context.Background(), dependencies)
+}
+
+// AssociateServiceDependenciesContext Create new dependencies between two services
+func (s *ServiceDependencyService) AssociateServiceDependenciesContext(ctx context.Context, dependencies *ListServiceDependencies) (*ListServiceDependencies, *Response, error) {
 	u := "/service_dependencies/associate"
 	v := new(ListServiceDependencies)
 
-	resp, err := s.client.newRequestDo("POST", u, nil, dependencies, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, dependencies, &v, WithRoute("/service_dependencies/associate"))
 
 	if err != nil {
 		return nil, nil, err
@@ -41,10 +49,15 @@ func (s *ServiceDependencyService) AssociateServiceDependencies(dependencies *Li
 
 // DisassociateServiceDependencies Disassociate dependencies between two services.
 func (s *ServiceDependencyService) DisassociateServiceDependencies(dependencies *ListServiceDependencies) (*ListServiceDependencies, *Response, error) {
+	return s.DisassociateServiceDependenciesContext(context.Background(), dependencies)
+}
+
+// DisassociateServiceDependenciesContext Disassociate dependencies between two services.
+func (s *ServiceDependencyService) DisassociateServiceDependenciesContext(ctx context.Context, dependencies *ListServiceDependencies) (*ListServiceDependencies, *Response, error) {
 	u := "/service_dependencies/disassociate"
 	v := new(ListServiceDependencies)
 
-	resp, err := s.client.newRequestDo("POST", u, nil, dependencies, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, dependencies, &v, WithRoute("/service_dependencies/disassociate"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -54,21 +67,26 @@ func (s *ServiceDependencyService) DisassociateServiceDependencies(dependencies
 
 // GetServiceDependenciesForType gets all immediate dependencies of a dependent service.
 func (s *ServiceDependencyService) GetServiceDependenciesForType(serviceID, serviceType string) (*ListServiceDependencies, *Response, error) {
+	return s.GetServiceDependenciesForTypeContext(context.Background(), serviceID, serviceType)
+}
+
+// GetServiceDependenciesForTypeContext gets all immediate dependencies of a dependent service.
+func (s *ServiceDependencyService) GetServiceDependenciesForTypeContext(ctx context.Context, serviceID, serviceType string) (*ListServiceDependencies, *Response, error) {
 	if serviceType == "business_service" || serviceType == "business_service_reference" {
-		return s.getBusinessServiceDependencies(serviceID)
+		return s.getBusinessServiceDependencies(ctx, serviceID)
 	} else if serviceType == "service" || serviceType == "technical_service_reference" {
-		return s.getTechnicalServiceDependencies(serviceID)
+		return s.getTechnicalServiceDependencies(ctx, serviceID)
 	}
 	// return a not found error
 	return nil, nil, fmt.Errorf("dependent Service type of %s not found", serviceType)
 }
 
 // getBusinessServiceDependencies gets all immediate dependencies of a business service.
-func (s *ServiceDependencyService) getBusinessServiceDependencies(businessServiceID string) (*ListServiceDependencies, *Response, error) {
+func (s *ServiceDependencyService) getBusinessServiceDependencies(ctx context.Context, businessServiceID string) (*ListServiceDependencies, *Response, error) {
 	u := fmt.Sprintf("/service_dependencies/business_services/%s", businessServiceID)
 	v := new(ListServiceDependencies)
 
-	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/service_dependencies/business_services/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -77,11 +95,11 @@ func (s *ServiceDependencyService) getBusinessServiceDependencies(businessServic
 }
 
 // getTechnicalServiceDependencies gets all immediate dependencies of a technical service.
-func (s *ServiceDependencyService) getTechnicalServiceDependencies(serviceID string) (*ListServiceDependencies, *Response, error) {
+func (s *ServiceDependencyService) getTechnicalServiceDependencies(ctx context.Context, serviceID string) (*ListServiceDependencies, *Response, error) {
 	u := fmt.Sprintf("/service_dependencies/technical_services/%s", serviceID)
 	v := new(ListServiceDependencies)
 
-	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/service_dependencies/technical_services/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
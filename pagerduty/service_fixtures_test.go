@@ -229,7 +229,7 @@ var (
 				Type: "service",
 			},
 		},
-		Limit: 25,
+		PaginationMeta: PaginationMeta{Limit: 25},
 	}
 	validListServiceEventRuleResponse = &ListServiceEventRuleResponse{
 		EventRules: []*ServiceEventRule{
@@ -265,6 +265,6 @@ var (
 				},
 			},
 		},
-		Limit: 25,
+		PaginationMeta: PaginationMeta{Limit: 25},
 	}
 )
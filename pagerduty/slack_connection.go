@@ -1,6 +1,9 @@
 package pagerduty
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // SlackConnectionService handles the communication with the integration slack
 // related methods of the PagerDuty API.
@@ -42,6 +45,11 @@ type ListSlackConnectionsResponse struct {
 
 // List lists existing slack connections.
 func (s *SlackConnectionService) List(workspaceID string) (*ListSlackConnectionsResponse, *Response, error) {
+	return s.ListContext(context.Background(), workspaceID)
+}
+
+// ListContext lists existing slack connections.
+func (s *SlackConnectionService) ListContext(ctx context.Context, workspaceID string) (*ListSlackConnectionsResponse, *Response, error) {
 	u := fmt.Sprintf("/integration-slack/workspaces/%s/connections", workspaceID)
 	v := new(ListSlackConnectionsResponse)
 
@@ -49,24 +57,24 @@ func (s *SlackConnectionService) List(workspaceID string) (*ListSlackConnections
 
 	// Create a handler closure capable of parsing data from the integration-slack connections endpoint
 	// and appending resultant response plays to the return slice.
-	responseHandler := func(response *Response) (ListResp, *Response, error) {
+	responseHandler := func(response *Response) (PaginationMeta, *Response, error) {
 		var result ListSlackConnectionsResponse
 
 		if err := s.client.DecodeJSON(response, &result); err != nil {
-			return ListResp{}, response, err
+			return PaginationMeta{}, response, err
 		}
 
 		slackConnections = append(slackConnections, result.SlackConnections...)
 
 		// Return stats on the current page. Caller can use this information to
 		// adjust for requesting additional pages.
-		return ListResp{
+		return PaginationMeta{
 			More:   result.More,
 			Offset: result.Offset,
 			Limit:  result.Limit,
 		}, response, nil
 	}
-	err := s.client.newRequestPagedGetDo(u, responseHandler)
+	err := s.client.newRequestPagedGetDoContext(ctx, u, responseHandler, WithRoute("/integration-slack/workspaces/{id}/connections"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -77,11 +85,16 @@ func (s *SlackConnectionService) List(workspaceID string) (*ListSlackConnections
 
 // Create creates a new slack connection.
 func (s *SlackConnectionService) Create(workspaceID string, sconn *SlackConnection) (*SlackConnection, *Response, error) {
+	return s.CreateContext(context.Background(), workspaceID, sconn)
+}
+
+// CreateContext creates a new slack connection.
+func (s *SlackConnectionService) CreateContext(ctx context.Context, workspaceID string, sconn *SlackConnection) (*SlackConnection, *Response, error) {
 	u := fmt.Sprintf("/integration-slack/workspaces/%s/connections", workspaceID)
 	v := new(SlackConnectionPayload)
 	p := &SlackConnectionPayload{SlackConnection: sconn}
 
-	resp, err := s.client.newRequestDo("POST", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, p, v, WithRoute("/integration-slack/workspaces/{id}/connections"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -93,11 +106,16 @@ func (s *SlackConnectionService) Create(workspaceID string, sconn *SlackConnecti
 
 // Get gets a slack connection.
 func (s *SlackConnectionService) Get(workspaceID, ID string) (*SlackConnection, *Response, error) {
+	return s.GetContext(context.Background(), workspaceID, ID)
+}
+
+// GetContext gets a slack connection.
+func (s *SlackConnectionService) GetContext(ctx context.Context, workspaceID, ID string) (*SlackConnection, *Response, error) {
 	u := fmt.Sprintf("/integration-slack/workspaces/%s/connections/%s", workspaceID, ID)
 	v := new(SlackConnectionPayload)
 	p := &SlackConnectionPayload{}
 
-	resp, err := s.client.newRequestDo("GET", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, p, v, WithRoute("/integration-slack/workspaces/{id}/connections/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -107,17 +125,27 @@ func (s *SlackConnectionService) Get(workspaceID, ID string) (*SlackConnection,
 
 // Delete deletes a slack connection.
 func (s *SlackConnectionService) Delete(workspaceID, ID string) (*Response, error) {
+	return s.DeleteContext(context.Background(), workspaceID, ID)
+}
+
+// DeleteContext deletes a slack connection.
+func (s *SlackConnectionService) DeleteContext(ctx context.Context, workspaceID, ID string) (*Response, error) {
 	u := fmt.Sprintf("/integration-slack/workspaces/%s/connections/%s", workspaceID, ID)
-	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/integration-slack/workspaces/{id}/connections/{id}"))
 }
 
 // Update updates a slack connection.
 func (s *SlackConnectionService) Update(workspaceID, ID string, sconn *SlackConnection) (*SlackConnection, *Response, error) {
+	return s.UpdateContext(context.Background(), workspaceID, ID, sconn)
+}
+
+// UpdateContext updates a slack connection.
+func (s *SlackConnectionService) UpdateContext(ctx context.Context, workspaceID, ID string, sconn *SlackConnection) (*SlackConnection, *Response, error) {
 	u := fmt.Sprintf("/integration-slack/workspaces/%s/connections/%s", workspaceID, ID)
 	v := new(SlackConnectionPayload)
 	p := SlackConnectionPayload{SlackConnection: sconn}
 
-	resp, err := s.client.newRequestDo("PUT", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, p, v, WithRoute("/integration-slack/workspaces/{id}/connections/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1,6 +1,9 @@
 package pagerduty
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // TagService handles the communication with tag
 // related methods of the PagerDuty API.
@@ -55,6 +58,11 @@ type TagAssignment struct {
 
 // List lists existing tags.
 func (s *TagService) List(o *ListTagsOptions) (*ListTagsResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists existing tags.
+func (s *TagService) ListContext(ctx context.Context, o *ListTagsOptions) (*ListTagsResponse, *Response, error) {
 	u := "/tags"
 	v := new(ListTagsResponse)
 
@@ -62,24 +70,24 @@ func (s *TagService) List(o *ListTagsOptions) (*ListTagsResponse, *Response, err
 
 	// Create a handler closure capable of parsing data from the response_plays endpoint
 	// and appending resultant response plays to the return slice.
-	responseHandler := func(response *Response) (ListResp, *Response, error) {
+	responseHandler := func(response *Response) (PaginationMeta, *Response, error) {
 		var result ListTagsResponse
 
 		if err := s.client.DecodeJSON(response, &result); err != nil {
-			return ListResp{}, response, err
+			return PaginationMeta{}, response, err
 		}
 
 		tags = append(tags, result.Tags...)
 
 		// Return stats on the current page. Caller can use this information to
 		// adjust for requesting additional pages.
-		return ListResp{
+		return PaginationMeta{
 			More:   result.More,
 			Offset: result.Offset,
 			Limit:  result.Limit,
 		}, response, nil
 	}
-	err := s.client.newRequestPagedGetDo(u, responseHandler)
+	err := s.client.newRequestPagedGetDoContext(ctx, u, responseHandler, WithRoute("/tags"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -90,6 +98,11 @@ func (s *TagService) List(o *ListTagsOptions) (*ListTagsResponse, *Response, err
 
 // List Tags for a given Entity.
 func (s *TagService) ListTagsForEntity(e, eid string) (*ListTagsResponse, *Response, error) {
+	return s.ListTagsForEntityContext(context.Background(), e, eid)
+}
+
+// ListTagsForEntityContext List Tags for a given Entity.
+func (s *TagService) ListTagsForEntityContext(ctx context.Context, e, eid string) (*ListTagsResponse, *Response, error) {
 	u := fmt.Sprintf("/%s/%s/tags", e, eid)
 	v := new(ListTagsResponse)
 
@@ -97,24 +110,24 @@ func (s *TagService) ListTagsForEntity(e, eid string) (*ListTagsResponse, *Respo
 
 	// Create a handler closure capable of parsing data from the response_plays endpoint
 	// and appending resultant response plays to the return slice.
-	responseHandler := func(response *Response) (ListResp, *Response, error) {
+	responseHandler := func(response *Response) (PaginationMeta, *Response, error) {
 		var result ListTagsResponse
 
 		if err := s.client.DecodeJSON(response, &result); err != nil {
-			return ListResp{}, response, err
+			return PaginationMeta{}, response, err
 		}
 
 		tags = append(tags, result.Tags...)
 
 		// Return stats on the current page. Caller can use this information to
 		// adjust for requesting additional pages.
-		return ListResp{
+		return PaginationMeta{
 			More:   result.More,
 			Offset: result.Offset,
 			Limit:  result.Limit,
 		}, response, nil
 	}
-	err := s.client.newRequestPagedGetDo(u, responseHandler)
+	err := s.client.newRequestPagedGetDoContext(ctx, u, responseHandler, WithRoute(fmt.Sprintf("/%s/{id}/tags", e)))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -125,11 +138,16 @@ func (s *TagService) ListTagsForEntity(e, eid string) (*ListTagsResponse, *Respo
 
 // Create creates a new tag.
 func (s *TagService) Create(tag *Tag) (*Tag, *Response, error) {
+	return s.CreateContext(context.Background(), tag)
+}
+
+// CreateContext creates a new tag.
+func (s *TagService) CreateContext(ctx context.Context, tag *Tag) (*Tag, *Response, error) {
 	u := "/tags"
 	v := new(TagPayload)
 	p := &TagPayload{Tag: tag}
 
-	resp, err := s.client.newRequestDo("POST", u, nil, p, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, p, &v, WithRoute("/tags"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -139,16 +157,26 @@ func (s *TagService) Create(tag *Tag) (*Tag, *Response, error) {
 
 // Delete removes an existing tag.
 func (s *TagService) Delete(id string) (*Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext removes an existing tag.
+func (s *TagService) DeleteContext(ctx context.Context, id string) (*Response, error) {
 	u := fmt.Sprintf("/tags/%s", id)
-	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/tags/{id}"))
 }
 
 // Get retrieves information about a tag.
 func (s *TagService) Get(id string) (*Tag, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext retrieves information about a tag.
+func (s *TagService) GetContext(ctx context.Context, id string) (*Tag, *Response, error) {
 	u := fmt.Sprintf("/tags/%s", id)
 	v := new(TagPayload)
 
-	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/tags/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -158,9 +186,14 @@ func (s *TagService) Get(id string) (*Tag, *Response, error) {
 
 // Assign adds and removes tag assignments with entities
 func (s *TagService) Assign(e, eid string, a *TagAssignments) (*Response, error) {
+	return s.AssignContext(context.Background(), e, eid, a)
+}
+
+// AssignContext adds and removes tag assignments with entities
+func (s *TagService) AssignContext(ctx context.Context, e, eid string, a *TagAssignments) (*Response, error) {
 	u := fmt.Sprintf("/%s/%s/change_tags", e, eid)
 
-	resp, err := s.client.newRequestDo("POST", u, nil, a, nil)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, a, nil, WithRoute(fmt.Sprintf("/%s/{id}/change_tags", e)))
 	if err != nil {
 		return nil, err
 	}
@@ -1,15 +1,21 @@
 package pagerduty
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"net/http"
 )
 
 // TeamService handles the communication with team
 // related methods of the PagerDuty API.
 type TeamService service
 
-// Team represents a team.
+// Team represents a team. Parent nests the team under another one on
+// create and update; set it to NoParentTeam to make a nested team top level
+// again.
 type Team struct {
 	Description string         `json:"description,omitempty"`
 	HTMLURL     string         `json:"html_url,omitempty"`
@@ -22,45 +28,96 @@ type Team struct {
 	DefaultRole string         `json:"default_role,omitempty"`
 }
 
+// NoParentTeam is a sentinel to set as Team.Parent to remove the parent of
+// a team on update. Leaving Parent nil keeps the current parent, NoParentTeam
+// sends an explicit null instead. It is compared by identity, a copy of it is
+// an empty reference.
+var NoParentTeam = &TeamReference{}
+
+// MarshalJSON encodes a Team, sending a null parent for NoParentTeam and
+// defaulting the type of the parent reference to "team_reference".
+func (t Team) MarshalJSON() ([]byte, error) {
+	type team Team
+
+	switch {
+	case t.Parent == NoParentTeam:
+		return json.Marshal(struct {
+			team
+			Parent *TeamReference `json:"parent"`
+		}{team: team(t)})
+	case t.Parent != nil && t.Parent.Type == "":
+		parent := *t.Parent
+		parent.Type = "team_reference"
+		t.Parent = &parent
+	}
+
+	return json.Marshal(team(t))
+}
+
 // Member represents a team member.
 type Member struct {
 	User *UserReference `json:"user,omitempty"`
 	Role string         `json:"role,omitempty"`
 }
 
-// ListTeamsOptions represents options when listing teams.
+// Roles of the members of a team.
+const (
+	TeamRoleManager   = "manager"
+	TeamRoleResponder = "responder"
+	TeamRoleObserver  = "observer"
+)
+
+// TeamMember represents a member of a team listed by ListMembers. User only
+// holds the reference fields unless the users are included.
+type TeamMember struct {
+	User *User  `json:"user,omitempty"`
+	Role string `json:"role,omitempty"`
+}
+
+// ListMembersOptions represents options when listing the members of a team.
+// Include "users" returns the full user objects of the members.
+type ListMembersOptions struct {
+	ListOptions
+	Include []string `url:"include,omitempty,brackets"`
+}
+
+// ListMembersResponse represents a list response of team members.
+type ListMembersResponse struct {
+	PaginationMeta
+	Members []*TeamMember `json:"members,omitempty"`
+}
+
+// ListTeamsOptions represents options when listing teams. Query matches
+// teams whose name contains it. The API cannot filter on the parent of
+// teams, filter the results on Team.Parent instead.
 type ListTeamsOptions struct {
-	Limit  int    `url:"limit,omitempty"`
-	More   bool   `url:"more,omitempty"`
-	Offset int    `url:"offset,omitempty"`
-	Total  int    `url:"total,omitempty"`
-	Query  string `url:"query,omitempty"`
+	ListOptions
+
+	// Deprecated: More is not a request parameter and is ignored.
+	More bool `url:"-"`
+
+	Query string `url:"query,omitempty"`
 }
 
 // ListTeamsResponse represents a list response of teams.
 type ListTeamsResponse struct {
-	Limit  int     `json:"limit,omitempty"`
-	More   bool    `json:"more,omitempty"`
-	Offset int     `json:"offset,omitempty"`
-	Total  int     `json:"total,omitempty"`
-	Teams  []*Team `json:"teams,omitempty"`
+	PaginationMeta
+	Teams []*Team `json:"teams,omitempty"`
 }
 
 // GetMembersOptions represents options when getting a list of members.
 type GetMembersOptions struct {
-	Limit    int      `url:"limit,omitempty"`
-	More     bool     `url:"more,omitempty"`
-	Offset   int      `url:"offset,omitempty"`
-	Total    int      `url:"total,omitempty"`
+	ListOptions
+
+	// Deprecated: More is not a request parameter and is ignored.
+	More bool `url:"-"`
+
 	Includes []string `url:"include,omitempty,brackets"`
 }
 
 // GetMembersResponse represents a response of a list of members.
 type GetMembersResponse struct {
-	Limit   int       `json:"limit,omitempty"`
-	More    bool      `json:"more,omitempty"`
-	Offset  int       `json:"offset,omitempty"`
-	Total   int       `json:"total,omitempty"`
+	PaginationMeta
 	Members []*Member `json:"members,omitempty"`
 }
 
@@ -75,10 +132,15 @@ type TeamPayload struct {
 
 // List lists existing teams.
 func (s *TeamService) List(o *ListTeamsOptions) (*ListTeamsResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists existing teams.
+func (s *TeamService) ListContext(ctx context.Context, o *ListTeamsOptions) (*ListTeamsResponse, *Response, error) {
 	u := "/teams"
 	v := new(ListTeamsResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/teams"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -88,10 +150,15 @@ func (s *TeamService) List(o *ListTeamsOptions) (*ListTeamsResponse, *Response,
 
 // Create creates a new team.
 func (s *TeamService) Create(team *Team) (*Team, *Response, error) {
+	return s.CreateContext(context.Background(), team)
+}
+
+// CreateContext creates a new team.
+func (s *TeamService) CreateContext(ctx context.Context, team *Team) (*Team, *Response, error) {
 	u := "/teams"
 	v := new(TeamPayload)
 
-	resp, err := s.client.newRequestDo("POST", u, nil, &TeamPayload{Team: team}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &TeamPayload{Team: team}, &v, WithRoute("/teams"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -99,18 +166,39 @@ func (s *TeamService) Create(team *Team) (*Team, *Response, error) {
 	return v.Team, resp, nil
 }
 
-// Delete removes an existing team.
+// Delete removes an existing team. A team that still has services,
+// escalation policies or schedules cannot be removed, the error is a
+// *TeamDeletionError listing them in that case.
 func (s *TeamService) Delete(id string) (*Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext removes an existing team. A team that still has services,
+// escalation policies or schedules cannot be removed, the error is a
+// *TeamDeletionError listing them in that case.
+func (s *TeamService) DeleteContext(ctx context.Context, id string) (*Response, error) {
 	u := fmt.Sprintf("/teams/%s", id)
-	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/teams/{id}"))
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest {
+		err = newTeamDeletionError(id, apiErr)
+	}
+
+	return resp, err
 }
 
 // Get retrieves information about a team.
 func (s *TeamService) Get(id string) (*Team, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext retrieves information about a team.
+func (s *TeamService) GetContext(ctx context.Context, id string) (*Team, *Response, error) {
 	u := fmt.Sprintf("/teams/%s", id)
 	v := new(TeamPayload)
 
-	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/teams/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -120,10 +208,15 @@ func (s *TeamService) Get(id string) (*Team, *Response, error) {
 
 // Update updates an existing team.
 func (s *TeamService) Update(id string, team *Team) (*Team, *Response, error) {
+	return s.UpdateContext(context.Background(), id, team)
+}
+
+// UpdateContext updates an existing team.
+func (s *TeamService) UpdateContext(ctx context.Context, id string, team *Team) (*Team, *Response, error) {
 	u := fmt.Sprintf("/teams/%s", id)
 	v := new(TeamPayload)
 
-	resp, err := s.client.newRequestDo("PUT", u, nil, &TeamPayload{Team: team}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &TeamPayload{Team: team}, &v, WithRoute("/teams/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -133,8 +226,13 @@ func (s *TeamService) Update(id string, team *Team) (*Team, *Response, error) {
 
 // RemoveUser removes a user from a team.
 func (s *TeamService) RemoveUser(teamID, userID string) (*Response, error) {
+	return s.RemoveUserContext(context.Background(), teamID, userID)
+}
+
+// RemoveUserContext removes a user from a team.
+func (s *TeamService) RemoveUserContext(ctx context.Context, teamID, userID string) (*Response, error) {
 	u := fmt.Sprintf("/teams/%s/users/%s", teamID, userID)
-	resp, err := s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/teams/{id}/users/{id}"))
 	if err != nil {
 		return nil, err
 	}
@@ -148,17 +246,43 @@ func (s *TeamService) RemoveUser(teamID, userID string) (*Response, error) {
 	return resp, nil
 }
 
-// AddUser adds a user to a team.
-func (s *TeamService) AddUser(teamID, userID string) (*Response, error) {
-	u := fmt.Sprintf("/teams/%s/users/%s", teamID, userID)
-	return s.client.newRequestDo("PUT", u, nil, nil, nil)
+// AddUser adds a user to a team with the given role, one of the TeamRole
+// constants. If the user is already a member of the team, their role is
+// changed instead. The returned member holds the resulting role.
+func (s *TeamService) AddUser(teamID, userID, role string) (*TeamMember, *Response, error) {
+	return s.AddUserContext(context.Background(), teamID, userID, role)
+}
+
+// AddUserContext adds a user to a team with the given role, one of the
+// TeamRole constants. If the user is already a member of the team, their
+// role is changed instead. The returned member holds the resulting role.
+func (s *TeamService) AddUserContext(ctx context.Context, teamID, userID, role string) (*TeamMember, *Response, error) {
+	switch role {
+	case TeamRoleManager, TeamRoleResponder, TeamRoleObserver:
+	default:
+		return nil, nil, fmt.Errorf("unsupported team role %q", role)
+	}
+
+	resp, err := s.AddUserWithRoleContext(ctx, teamID, userID, role)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &TeamMember{User: &User{ID: userID, Type: "user_reference"}, Role: role}, resp, nil
 }
 
 // AddUserWithRole adds a user with the specified role (one of observer, manager, or responder[default])
+//
+// Deprecated: Use AddUser, which checks the role.
 func (s *TeamService) AddUserWithRole(teamID, userID string, role string) (*Response, error) {
+	return s.AddUserWithRoleContext(context.Background(), teamID, userID, role)
+}
+
+// AddUserWithRoleContext adds a user with the specified role (one of observer, manager, or responder[default])
+func (s *TeamService) AddUserWithRoleContext(ctx context.Context, teamID, userID string, role string) (*Response, error) {
 	tr := teamRole{Role: role}
 	u := fmt.Sprintf("/teams/%s/users/%s", teamID, userID)
-	resp, err := s.client.newRequestDo("PUT", u, nil, tr, nil)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, tr, nil, WithRoute("/teams/{id}/users/{id}"))
 	if err != nil {
 		return nil, err
 	}
@@ -174,6 +298,11 @@ func (s *TeamService) AddUserWithRole(teamID, userID string, role string) (*Resp
 
 // GetMembers retrieves information about members on a team.
 func (s *TeamService) GetMembers(teamID string, o *GetMembersOptions) (*GetMembersResponse, *Response, error) {
+	return s.GetMembersContext(context.Background(), teamID, o)
+}
+
+// GetMembersContext retrieves information about members on a team.
+func (s *TeamService) GetMembersContext(ctx context.Context, teamID string, o *GetMembersOptions) (*GetMembersResponse, *Response, error) {
 	u := fmt.Sprintf("/teams/%s/members", teamID)
 	v := new(GetMembersResponse)
 
@@ -188,24 +317,24 @@ func (s *TeamService) GetMembers(teamID string, o *GetMembersOptions) (*GetMembe
 		log.Printf("[DEBUG] error retrieving team members %q; %v", teamID, err)
 	}
 
-	responseHandler := func(response *Response) (ListResp, *Response, error) {
+	responseHandler := func(response *Response) (PaginationMeta, *Response, error) {
 		var result GetMembersResponse
 
 		if err := s.client.DecodeJSON(response, &result); err != nil {
-			return ListResp{}, response, err
+			return PaginationMeta{}, response, err
 		}
 
 		members = append(members, result.Members...)
 
 		// Return stats on the current page. Caller can use this information to
 		// adjust for requesting additional pages.
-		return ListResp{
+		return PaginationMeta{
 			More:   result.More,
 			Offset: result.Offset,
 			Limit:  result.Limit,
 		}, response, nil
 	}
-	err := s.client.newRequestPagedGetDo(u, responseHandler)
+	err := s.client.newRequestPagedGetDoContext(ctx, u, responseHandler, WithRoute("/teams/{id}/members"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -220,14 +349,304 @@ func (s *TeamService) GetMembers(teamID string, o *GetMembersOptions) (*GetMembe
 	return v, nil, nil
 }
 
-// RemoveEscalationPolicy removes an escalation policy from a team.
+// ListMembers lists the members of a team with their role. Unlike
+// GetMembers, it returns a single page of results and is never cached.
+func (s *TeamService) ListMembers(teamID string, o *ListMembersOptions) (*ListMembersResponse, *Response, error) {
+	return s.ListMembersContext(context.Background(), teamID, o)
+}
+
+// ListMembersContext lists the members of a team with their role. Unlike
+// GetMembersContext, it returns a single page of results and is never
+// cached.
+func (s *TeamService) ListMembersContext(ctx context.Context, teamID string, o *ListMembersOptions) (*ListMembersResponse, *Response, error) {
+	u := fmt.Sprintf("/teams/%s/members", teamID)
+	v := new(ListMembersResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/teams/{id}/members"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// IterMembers returns an Iterator over the members of a team, which requests
+// pages of results as they are needed.
+func (s *TeamService) IterMembers(teamID string, o *ListMembersOptions) *Iterator[*TeamMember] {
+	return s.IterMembersContext(context.Background(), teamID, o)
+}
+
+// IterMembersContext returns an Iterator over the members of a team, which
+// requests pages of results as they are needed.
+func (s *TeamService) IterMembersContext(ctx context.Context, teamID string, o *ListMembersOptions) *Iterator[*TeamMember] {
+	opts := ListMembersOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	return Iterate(opts.ListOptions, func(lo ListOptions) ([]*TeamMember, PaginationMeta, *Response, error) {
+		opts.ListOptions = lo
+		v, resp, err := s.ListMembersContext(ctx, teamID, &opts)
+		if err != nil {
+			return nil, PaginationMeta{}, resp, err
+		}
+		return v.Members, v.PaginationMeta, resp, nil
+	})
+}
+
+// RemoveEscalationPolicy removes an escalation policy from a team. IsNotFound
+// reports true for the error if the team or the escalation policy does not
+// exist.
 func (s *TeamService) RemoveEscalationPolicy(teamID, escID string) (*Response, error) {
+	return s.RemoveEscalationPolicyContext(context.Background(), teamID, escID)
+}
+
+// RemoveEscalationPolicyContext removes an escalation policy from a team.
+// IsNotFound reports true for the error if the team or the escalation policy
+// does not exist.
+func (s *TeamService) RemoveEscalationPolicyContext(ctx context.Context, teamID, escID string) (*Response, error) {
 	u := fmt.Sprintf("/teams/%s/escalation_policies/%s", teamID, escID)
-	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/teams/{id}/escalation_policies/{id}"))
 }
 
-// AddEscalationPolicy adds an escalation policy to a team.
+// AddEscalationPolicy adds an escalation policy to a team. IsNotFound reports
+// true for the error if the team or the escalation policy does not exist.
 func (s *TeamService) AddEscalationPolicy(teamID, escID string) (*Response, error) {
+	return s.AddEscalationPolicyContext(context.Background(), teamID, escID)
+}
+
+// AddEscalationPolicyContext adds an escalation policy to a team. IsNotFound
+// reports true for the error if the team or the escalation policy does not
+// exist.
+func (s *TeamService) AddEscalationPolicyContext(ctx context.Context, teamID, escID string) (*Response, error) {
 	u := fmt.Sprintf("/teams/%s/escalation_policies/%s", teamID, escID)
-	return s.client.newRequestDo("PUT", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, nil, nil, WithRoute("/teams/{id}/escalation_policies/{id}"))
+}
+
+// ListAuditRecords lists a single page of the audit records of a team, e.g.
+// members being added or removed. Since and Until are RFC 3339 timestamps.
+// Use the NextCursor of the response as the Cursor option to fetch the
+// following page.
+func (s *TeamService) ListAuditRecords(teamID string, o *ListAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	return s.ListAuditRecordsContext(context.Background(), teamID, o)
+}
+
+// ListAuditRecordsContext lists a single page of the audit records of a
+// team, e.g. members being added or removed. Since and Until are RFC 3339
+// timestamps. Use the NextCursor of the response as the Cursor option to
+// fetch the following page.
+func (s *TeamService) ListAuditRecordsContext(ctx context.Context, teamID string, o *ListAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	return s.client.listAuditRecordsContext(ctx, fmt.Sprintf("/teams/%s/audit/records", teamID), "/teams/{id}/audit/records", o)
+}
+
+// ListAllAuditRecords lists every audit record of a team matching the
+// options, following the cursor until the last page.
+func (s *TeamService) ListAllAuditRecords(teamID string, o *ListAuditRecordsOptions) ([]*AuditRecord, error) {
+	return s.ListAllAuditRecordsContext(context.Background(), teamID, o)
+}
+
+// ListAllAuditRecordsContext lists every audit record of a team matching the
+// options, following the cursor until the last page.
+func (s *TeamService) ListAllAuditRecordsContext(ctx context.Context, teamID string, o *ListAuditRecordsOptions) ([]*AuditRecord, error) {
+	return s.client.listAllAuditRecordsContext(ctx, fmt.Sprintf("/teams/%s/audit/records", teamID), "/teams/{id}/audit/records", o)
+}
+
+// ListServices lists the services of a team, going through every page of
+// results.
+func (s *TeamService) ListServices(teamID string) ([]*Service, error) {
+	return s.ListServicesContext(context.Background(), teamID)
+}
+
+// ListServicesContext lists the services of a team, going through every
+// page of results.
+func (s *TeamService) ListServicesContext(ctx context.Context, teamID string) ([]*Service, error) {
+	services := make([]*Service, 0)
+	err := s.client.Services.ListPagesContext(ctx, &ListServicesOptions{TeamIDs: []string{teamID}}, func(page []*Service) error {
+		services = append(services, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return services, nil
+}
+
+// ListEscalationPolicies lists the escalation policies of a team, going
+// through every page of results.
+func (s *TeamService) ListEscalationPolicies(teamID string) ([]*EscalationPolicy, error) {
+	return s.ListEscalationPoliciesContext(context.Background(), teamID)
+}
+
+// ListEscalationPoliciesContext lists the escalation policies of a team,
+// going through every page of results.
+func (s *TeamService) ListEscalationPoliciesContext(ctx context.Context, teamID string) ([]*EscalationPolicy, error) {
+	policies := make([]*EscalationPolicy, 0)
+	err := s.client.EscalationPolicies.ListPagesContext(ctx, &ListEscalationPoliciesOptions{TeamIDs: []string{teamID}}, func(page []*EscalationPolicy) error {
+		policies = append(policies, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// ListSchedules lists the schedules of a team. The schedules endpoint cannot
+// filter on teams, so this goes through every schedule of the account and
+// keeps those whose Teams include the team.
+func (s *TeamService) ListSchedules(teamID string) ([]*Schedule, error) {
+	return s.ListSchedulesContext(context.Background(), teamID)
+}
+
+// ListSchedulesContext lists the schedules of a team. The schedules endpoint
+// cannot filter on teams, so this goes through every schedule of the
+// account and keeps those whose Teams include the team.
+func (s *TeamService) ListSchedulesContext(ctx context.Context, teamID string) ([]*Schedule, error) {
+	schedules := make([]*Schedule, 0)
+	err := s.client.Schedules.ListPagesContext(ctx, nil, func(page []*Schedule) error {
+		for _, schedule := range page {
+			for _, team := range schedule.Teams {
+				if team != nil && team.ID == teamID {
+					schedules = append(schedules, schedule)
+					break
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// ListTags lists the tags of a team, going through every page of results.
+func (s *TeamService) ListTags(teamID string) ([]*Tag, error) {
+	return s.ListTagsContext(context.Background(), teamID)
+}
+
+// ListTagsContext lists the tags of a team, going through every page of
+// results.
+func (s *TeamService) ListTagsContext(ctx context.Context, teamID string) ([]*Tag, error) {
+	v, _, err := s.client.Tags.ListTagsForEntityContext(ctx, "teams", teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.Tags, nil
+}
+
+// ChangeTags adds and removes tags of a team in a single transaction. Tags
+// are given by ID with type "tag_reference", or by label with type "tag",
+// which creates the tag when it does not exist. If a tag reference is
+// invalid, no tag is changed and the error is an *APIError whose Errors
+// describe the invalid reference.
+func (s *TeamService) ChangeTags(teamID string, add, remove []*TagAssignment) (*Response, error) {
+	return s.ChangeTagsContext(context.Background(), teamID, add, remove)
+}
+
+// ChangeTagsContext adds and removes tags of a team in a single transaction.
+// Tags are given by ID with type "tag_reference", or by label with type
+// "tag", which creates the tag when it does not exist. If a tag reference is
+// invalid, no tag is changed and the error is an *APIError whose Errors
+// describe the invalid reference.
+func (s *TeamService) ChangeTagsContext(ctx context.Context, teamID string, add, remove []*TagAssignment) (*Response, error) {
+	return s.client.Tags.AssignContext(ctx, "teams", teamID, &TagAssignments{Add: add, Remove: remove})
+}
+
+type listTeamsOptionsGen struct {
+	options *ListTeamsOptions
+}
+
+func (o *listTeamsOptionsGen) currentOffset() int {
+	return o.options.Offset
+}
+
+func (o *listTeamsOptionsGen) changeOffset(i int) {
+	o.options.Offset = i
+}
+
+func (o *listTeamsOptionsGen) buildStruct() interface{} {
+	return o.options
+}
+
+// ListAll lists existing teams, going through every page of results.
+func (s *TeamService) ListAll(o *ListTeamsOptions) ([]*Team, error) {
+	return s.ListAllContext(context.Background(), o)
+}
+
+// ListAllContext lists existing teams, going through every page of results.
+func (s *TeamService) ListAllContext(ctx context.Context, o *ListTeamsOptions) ([]*Team, error) {
+	teams := make([]*Team, 0)
+	err := s.ListPagesContext(ctx, o, func(page []*Team) error {
+		teams = append(teams, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
+// ListPages lists existing teams, calling fn with every page of results until
+// there are no more pages or fn returns an error.
+func (s *TeamService) ListPages(o *ListTeamsOptions, fn func([]*Team) error) error {
+	return s.ListPagesContext(context.Background(), o, fn)
+}
+
+// ListPagesContext lists existing teams, calling fn with every page of results until
+// there are no more pages or fn returns an error.
+func (s *TeamService) ListPagesContext(ctx context.Context, o *ListTeamsOptions, fn func([]*Team) error) error {
+	opts := ListTeamsOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	responseHandler := func(response *Response) (PaginationMeta, *Response, error) {
+		var result ListTeamsResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return PaginationMeta{}, response, err
+		}
+
+		if err := fn(result.Teams); err != nil {
+			return PaginationMeta{}, response, err
+		}
+
+		return PaginationMeta{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+
+	return s.client.newRequestPagedGetQueryDoContext(ctx, "/teams", responseHandler, &listTeamsOptionsGen{options: &opts}, WithRoute("/teams"))
+}
+
+// Iter returns an Iterator over existing teams, which requests pages of
+// results as they are needed.
+func (s *TeamService) Iter(o *ListTeamsOptions) *Iterator[*Team] {
+	return s.IterContext(context.Background(), o)
+}
+
+// IterContext returns an Iterator over existing teams, which requests pages
+// of results as they are needed.
+func (s *TeamService) IterContext(ctx context.Context, o *ListTeamsOptions) *Iterator[*Team] {
+	opts := ListTeamsOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	return Iterate(opts.ListOptions, func(lo ListOptions) ([]*Team, PaginationMeta, *Response, error) {
+		opts.ListOptions = lo
+		v, resp, err := s.ListContext(ctx, &opts)
+		if err != nil {
+			return nil, PaginationMeta{}, resp, err
+		}
+		return v.Teams, v.PaginationMeta, resp, nil
+	})
 }
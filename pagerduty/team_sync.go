@@ -0,0 +1,149 @@
+package pagerduty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// maxSyncMembersAttempts is the number of times SyncMembers reads the
+// membership of a team again after a conflicting concurrent change.
+const maxSyncMembersAttempts = 3
+
+// TeamMemberSpec represents a desired member of a team for SyncMembers. Role
+// is one of the TeamRole constants, TeamRoleResponder when empty.
+type TeamMemberSpec struct {
+	UserID string
+	Role   string
+}
+
+// TeamMemberChange represents a change of the membership of a user made by
+// SyncMembers. Role is empty for removed users, PreviousRole for added ones.
+type TeamMemberChange struct {
+	UserID       string
+	Role         string
+	PreviousRole string
+}
+
+// TeamSyncReport represents the changes made by SyncMembers.
+type TeamSyncReport struct {
+	Added       []*TeamMemberChange
+	Removed     []*TeamMemberChange
+	RoleChanged []*TeamMemberChange
+}
+
+// desiredTeamMembers returns the roles of the desired members by user ID.
+func desiredTeamMembers(desired []TeamMemberSpec) (map[string]string, error) {
+	roles := make(map[string]string, len(desired))
+	for _, m := range desired {
+		if m.UserID == "" {
+			return nil, errors.New("a team member requires a user ID")
+		}
+
+		role := m.Role
+		switch role {
+		case "":
+			role = TeamRoleResponder
+		case TeamRoleManager, TeamRoleResponder, TeamRoleObserver:
+		default:
+			return nil, fmt.Errorf("unsupported team role %q for user %s", m.Role, m.UserID)
+		}
+
+		if r, ok := roles[m.UserID]; ok && r != role {
+			return nil, fmt.Errorf("user %s is listed with roles %q and %q", m.UserID, r, role)
+		}
+		roles[m.UserID] = role
+	}
+	return roles, nil
+}
+
+// SyncMembers makes the members of a team match the desired ones, adding,
+// removing and changing the role of users as needed, and leaving the others
+// untouched. When a change conflicts with a concurrent one, the membership
+// is read again and the remaining changes are recomputed. The returned
+// report holds the changes made, also when an error stopped the sync.
+func (s *TeamService) SyncMembers(teamID string, desired []TeamMemberSpec) (*TeamSyncReport, error) {
+	return s.SyncMembersContext(context.Background(), teamID, desired)
+}
+
+// SyncMembersContext makes the members of a team match the desired ones,
+// adding, removing and changing the role of users as needed, and leaving
+// the others untouched. When a change conflicts with a concurrent one, the
+// membership is read again and the remaining changes are recomputed. The
+// returned report holds the changes made, also when an error stopped the
+// sync.
+func (s *TeamService) SyncMembersContext(ctx context.Context, teamID string, desired []TeamMemberSpec) (*TeamSyncReport, error) {
+	roles, err := desiredTeamMembers(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &TeamSyncReport{}
+
+	for attempt := 1; ; attempt++ {
+		err := s.syncMembersOnce(ctx, teamID, roles, report)
+		if err == nil || !IsConflict(err) || attempt == maxSyncMembersAttempts {
+			return report, err
+		}
+	}
+}
+
+// sortedUserIDs returns the user IDs of members in order, so that changes
+// are made in a predictable order.
+func sortedUserIDs(members map[string]string) []string {
+	ids := make([]string, 0, len(members))
+	for id := range members {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// syncMembersOnce reads the members of a team and makes the changes needed
+// to match roles, recording them in report.
+func (s *TeamService) syncMembersOnce(ctx context.Context, teamID string, roles map[string]string, report *TeamSyncReport) error {
+	current := make(map[string]string)
+	it := s.IterMembersContext(ctx, teamID, &ListMembersOptions{ListOptions: ListOptions{Limit: 100}})
+	for it.Next() {
+		if m := it.Value(); m.User != nil {
+			current[m.User.ID] = m.Role
+		}
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+
+	// Add and change roles before removing, so that a team is never left
+	// without a manager in between.
+	for _, id := range sortedUserIDs(roles) {
+		role, previous := roles[id], current[id]
+		if role == previous {
+			continue
+		}
+
+		if _, _, err := s.AddUserContext(ctx, teamID, id, role); err != nil {
+			return err
+		}
+
+		change := &TeamMemberChange{UserID: id, Role: role, PreviousRole: previous}
+		if previous == "" {
+			report.Added = append(report.Added, change)
+		} else {
+			report.RoleChanged = append(report.RoleChanged, change)
+		}
+	}
+
+	for _, id := range sortedUserIDs(current) {
+		if _, ok := roles[id]; ok {
+			continue
+		}
+
+		if _, err := s.RemoveUserContext(ctx, teamID, id); err != nil {
+			return err
+		}
+		report.Removed = append(report.Removed, &TeamMemberChange{UserID: id, PreviousRole: current[id]})
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// handleTeamMembers serves the members of team 1, starting with members,
+// and records the changes made to them. conflict is called before each
+// change and answers it with a 409 when it returns true.
+func handleTeamMembers(t *testing.T, members map[string]string, conflict func(r *http.Request) bool) *[]string {
+	changes := &[]string{}
+
+	mux.HandleFunc("/teams/1/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		ids := make([]string, 0, len(members))
+		for id := range members {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+
+		var list []string
+		for _, id := range ids {
+			list = append(list, fmt.Sprintf(`{"user": {"id": %q, "type": "user_reference"}, "role": %q}`, id, members[id]))
+		}
+		fmt.Fprintf(w, `{"members": [%s], "limit": 100, "offset": 0, "more": false}`, strings.Join(list, ","))
+	})
+
+	mux.HandleFunc("/teams/1/users/", func(w http.ResponseWriter, r *http.Request) {
+		if conflict != nil && conflict(r) {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"error": {"code": 2001, "message": "Conflict"}}`))
+			return
+		}
+
+		id := strings.TrimPrefix(r.URL.Path, "/teams/1/users/")
+		switch r.Method {
+		case "PUT":
+			var v teamRole
+			json.NewDecoder(r.Body).Decode(&v)
+			members[id] = v.Role
+			*changes = append(*changes, "PUT "+id+" "+v.Role)
+		case "DELETE":
+			delete(members, id)
+			*changes = append(*changes, "DELETE "+id)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	return changes
+}
+
+func TestTeamsSyncMembers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	members := map[string]string{
+		"P1": TeamRoleManager,
+		"P2": TeamRoleResponder,
+		"P3": TeamRoleResponder,
+	}
+	changes := handleTeamMembers(t, members, nil)
+
+	report, err := client.Teams.SyncMembers("1", []TeamMemberSpec{
+		{UserID: "P1", Role: TeamRoleManager},
+		{UserID: "P2", Role: TeamRoleObserver},
+		{UserID: "P4"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &TeamSyncReport{
+		Added:       []*TeamMemberChange{{UserID: "P4", Role: TeamRoleResponder}},
+		Removed:     []*TeamMemberChange{{UserID: "P3", PreviousRole: TeamRoleResponder}},
+		RoleChanged: []*TeamMemberChange{{UserID: "P2", Role: TeamRoleObserver, PreviousRole: TeamRoleResponder}},
+	}
+	if !reflect.DeepEqual(report, want) {
+		t.Errorf("returned %#v; want %#v", report, want)
+	}
+
+	if want := []string{"PUT P2 observer", "PUT P4 responder", "DELETE P3"}; !reflect.DeepEqual(*changes, want) {
+		t.Errorf("made changes %v, want %v", *changes, want)
+	}
+}
+
+func TestTeamsSyncMembersUnchanged(t *testing.T) {
+	setup()
+	defer teardown()
+
+	changes := handleTeamMembers(t, map[string]string{"P1": TeamRoleManager}, nil)
+
+	report, err := client.Teams.SyncMembers("1", []TeamMemberSpec{{UserID: "P1", Role: TeamRoleManager}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := (&TeamSyncReport{}); !reflect.DeepEqual(report, want) {
+		t.Errorf("returned %#v; want %#v", report, want)
+	}
+	if len(*changes) != 0 {
+		t.Errorf("made changes %v, want none", *changes)
+	}
+}
+
+func TestTeamsSyncMembersConflict(t *testing.T) {
+	setup()
+	defer teardown()
+
+	members := map[string]string{"P1": TeamRoleManager, "P2": TeamRoleResponder}
+
+	// Another client removes P2 while the first removal is attempted.
+	conflicts := 0
+	changes := handleTeamMembers(t, members, func(r *http.Request) bool {
+		if r.Method == "DELETE" && conflicts == 0 {
+			conflicts++
+			delete(members, "P2")
+			return true
+		}
+		return false
+	})
+
+	report, err := client.Teams.SyncMembers("1", []TeamMemberSpec{
+		{UserID: "P1", Role: TeamRoleManager},
+		{UserID: "P3", Role: TeamRoleObserver},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &TeamSyncReport{
+		Added: []*TeamMemberChange{{UserID: "P3", Role: TeamRoleObserver}},
+	}
+	if !reflect.DeepEqual(report, want) {
+		t.Errorf("returned %#v; want %#v", report, want)
+	}
+
+	if want := []string{"PUT P3 observer"}; !reflect.DeepEqual(*changes, want) {
+		t.Errorf("made changes %v, want %v", *changes, want)
+	}
+}
+
+func TestTeamsSyncMembersPersistentConflict(t *testing.T) {
+	setup()
+	defer teardown()
+
+	conflicts := 0
+	handleTeamMembers(t, map[string]string{"P1": TeamRoleManager}, func(r *http.Request) bool {
+		conflicts++
+		return true
+	})
+
+	_, err := client.Teams.SyncMembers("1", []TeamMemberSpec{{UserID: "P1", Role: TeamRoleObserver}})
+	if !IsConflict(err) {
+		t.Fatalf("got error %v, want a conflict error", err)
+	}
+	if conflicts != maxSyncMembersAttempts {
+		t.Errorf("made %d attempts, want %d", conflicts, maxSyncMembersAttempts)
+	}
+}
+
+func TestTeamsSyncMembersInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/teams/1/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s for invalid members", r.Method, r.URL.Path)
+	})
+
+	for _, desired := range [][]TeamMemberSpec{
+		{{UserID: "P1", Role: "owner"}},
+		{{Role: TeamRoleManager}},
+		{{UserID: "P1", Role: TeamRoleManager}, {UserID: "P1", Role: TeamRoleObserver}},
+	} {
+		if _, err := client.Teams.SyncMembers("1", desired); err == nil {
+			t.Errorf("expected an error syncing %#v", desired)
+		}
+	}
+}
@@ -2,10 +2,13 @@ package pagerduty
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"os"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestTeamsList(t *testing.T) {
@@ -35,6 +38,95 @@ func TestTeamsList(t *testing.T) {
 	}
 }
 
+func TestTeamsListQueryWithParent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "query", "eng")
+		testQueryValue(t, r, "limit", "2")
+		w.Write([]byte(`{"teams": [{"id": "1", "name": "Engineering"}, {"id": "2", "name": "Platform Engineering", "parent": {"id": "1", "type": "team_reference"}}], "limit": 2, "offset": 0, "total": 3, "more": true}`))
+	})
+
+	resp, _, err := client.Teams.List(&ListTeamsOptions{ListOptions: ListOptions{Limit: 2}, Query: "eng"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListTeamsResponse{
+		PaginationMeta: PaginationMeta{Limit: 2, Total: 3, More: true},
+		Teams: []*Team{
+			{ID: "1", Name: "Engineering"},
+			{ID: "2", Name: "Platform Engineering", Parent: &TeamReference{ID: "1", Type: "team_reference"}},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestTeamsListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "query", "eng")
+		switch r.URL.Query().Get("offset") {
+		case "0", "":
+			w.Write([]byte(`{"teams": [{"id": "1"}], "limit": 1, "offset": 0, "more": true}`))
+		case "1":
+			w.Write([]byte(`{"teams": [{"id": "2", "parent": {"id": "1", "type": "team_reference"}}], "limit": 1, "offset": 1, "more": false}`))
+		default:
+			t.Errorf("unexpected offset %q", r.URL.Query().Get("offset"))
+		}
+	})
+
+	resp, err := client.Teams.ListAll(&ListTeamsOptions{Query: "eng"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*Team{{ID: "1"}, {ID: "2", Parent: &TeamReference{ID: "1", Type: "team_reference"}}}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestTeamsListPagesLimitExceeded(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		offset := r.URL.Query().Get("offset")
+		if offset == "" {
+			offset = "0"
+		}
+		w.Write([]byte(fmt.Sprintf(`{"teams":[{"id":"P1"}],"limit":100,"offset":%s,"more":true}`, offset)))
+	})
+
+	pages := 0
+	err := client.Teams.ListPages(&ListTeamsOptions{ListOptions: ListOptions{Limit: 100}}, func(teams []*Team) error {
+		pages++
+		return nil
+	})
+
+	var limitErr *PaginationLimitError
+	if !errors.As(err, &limitErr) {
+		t.Fatalf("got %v; want *PaginationLimitError", err)
+	}
+	if limitErr.Offset != 10000 {
+		t.Errorf("got offset %d; want 10000", limitErr.Offset)
+	}
+	if pages != 100 {
+		t.Errorf("got %d pages; want 100", pages)
+	}
+}
+
 func TestTeamsCreate(t *testing.T) {
 	setup()
 	defer teardown()
@@ -109,6 +201,68 @@ func TestTeamsCreateWithParent(t *testing.T) {
 	}
 }
 
+func TestTeamsUpdateParent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/teams/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"team":{"parent":{"id":"1","type":"team_reference"}}}`)
+		w.Write([]byte(`{"team": {"id": "2", "parent": {"id": "1", "type": "team_reference"}}}`))
+	})
+
+	resp, _, err := client.Teams.Update("2", &Team{Parent: &TeamReference{ID: "1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Team{ID: "2", Parent: &TeamReference{ID: "1", Type: "team_reference"}}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestTeamsUpdateClearParent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/teams/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"team":{"name":"foo","parent":null}}`)
+		w.Write([]byte(`{"team": {"id": "2", "name": "foo"}}`))
+	})
+
+	resp, _, err := client.Teams.Update("2", &Team{Name: "foo", Parent: NoParentTeam})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Parent != nil {
+		t.Errorf("Parent = %#v, want nil", resp.Parent)
+	}
+}
+
+func TestTeamMarshalParent(t *testing.T) {
+	for _, tc := range []struct {
+		team *Team
+		want string
+	}{
+		{&Team{Name: "foo"}, `{"name":"foo"}`},
+		{&Team{Name: "foo", Parent: NoParentTeam}, `{"name":"foo","parent":null}`},
+		{&Team{Name: "foo", Parent: &TeamReference{}}, `{"name":"foo","parent":{"type":"team_reference"}}`},
+		{&Team{Name: "foo", Parent: &TeamReference{ID: "1", Type: "team"}}, `{"name":"foo","parent":{"id":"1","type":"team"}}`},
+	} {
+		b, err := json.Marshal(tc.team)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != tc.want {
+			t.Errorf("json.Marshal(%#v) = %s, want %s", tc.team, b, tc.want)
+		}
+	}
+}
+
 func TestTeamsDelete(t *testing.T) {
 	setup()
 	defer teardown()
@@ -123,6 +277,51 @@ func TestTeamsDelete(t *testing.T) {
 	}
 }
 
+func TestTeamsDeleteWithResources(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/teams/PQ9K7I8", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{
+			"error": {
+				"code": 2001,
+				"message": "Invalid Input Provided",
+				"errors": ["Team cannot be deleted while it has associated resources"],
+				"conflicts": [
+					{"id": "PIJ90N7", "type": "service_reference", "summary": "My Application Service"},
+					{"id": "PANZZEQ", "type": "escalation_policy_reference", "summary": "Engineering Escalation Policy"},
+					{"id": "PI7DH85", "type": "schedule_reference", "summary": "Daily Engineering Rotation"}
+				]
+			}
+		}`))
+	})
+
+	_, err := client.Teams.Delete("PQ9K7I8")
+
+	var deletionErr *TeamDeletionError
+	if !errors.As(err, &deletionErr) {
+		t.Fatalf("got error %v, want a *TeamDeletionError", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != 2001 {
+		t.Errorf("got error %v, want an *APIError with code 2001", err)
+	}
+
+	want := &TeamDeletionError{
+		TeamID:             "PQ9K7I8",
+		Services:           []*ServiceReference{{ID: "PIJ90N7", Type: "service_reference", Summary: "My Application Service"}},
+		EscalationPolicies: []*EscalationPolicyReference{{ID: "PANZZEQ", Type: "escalation_policy_reference", Summary: "Engineering Escalation Policy"}},
+		Schedules:          []*ScheduleReference{{ID: "PI7DH85", Type: "schedule_reference", Summary: "Daily Engineering Rotation"}},
+	}
+	deletionErr.Err = nil
+	if !reflect.DeepEqual(deletionErr, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", deletionErr, want)
+	}
+}
+
 func TestTeamsGet(t *testing.T) {
 	setup()
 	defer teardown()
@@ -146,6 +345,27 @@ func TestTeamsGet(t *testing.T) {
 	}
 }
 
+func TestTeamsGetWithParent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/teams/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"team": {"id": "2", "parent": {"id": "1", "type": "team_reference", "summary": "Engineering"}}}`))
+	})
+
+	resp, _, err := client.Teams.Get("2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Team{ID: "2", Parent: &TeamReference{ID: "1", Type: "team_reference", Summary: "Engineering"}}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
 func TestTeamsUpdate(t *testing.T) {
 	setup()
 	defer teardown()
@@ -185,11 +405,64 @@ func TestTeamsAddUser(t *testing.T) {
 
 	mux.HandleFunc("/teams/1/users/1", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "PUT")
+		testBody(t, r, `{"role":"observer"}`)
+		w.WriteHeader(http.StatusNoContent)
 	})
 
-	if _, err := client.Teams.AddUser("1", "1"); err != nil {
+	resp, _, err := client.Teams.AddUser("1", "1", TeamRoleObserver)
+	if err != nil {
 		t.Fatal(err)
 	}
+
+	want := &TeamMember{User: &User{ID: "1", Type: "user_reference"}, Role: TeamRoleObserver}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestTeamsAddUserChangeRole(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var roles []string
+	mux.HandleFunc("/teams/1/users/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		var v teamRole
+		json.NewDecoder(r.Body).Decode(&v)
+		roles = append(roles, v.Role)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, _, err := client.Teams.AddUser("1", "1", TeamRoleResponder); err != nil {
+		t.Fatal(err)
+	}
+	resp, _, err := client.Teams.AddUser("1", "1", TeamRoleManager)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Role != TeamRoleManager {
+		t.Errorf("Role = %q, want %q", resp.Role, TeamRoleManager)
+	}
+	if want := []string{"responder", "manager"}; !reflect.DeepEqual(roles, want) {
+		t.Errorf("sent roles %v, want %v", roles, want)
+	}
+}
+
+func TestTeamsAddUserInvalidRole(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/teams/1/users/1", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request for an invalid role")
+	})
+
+	for _, role := range []string{"", "garbage", "Manager"} {
+		if _, _, err := client.Teams.AddUser("1", "1", role); err == nil {
+			t.Errorf("expected an error adding a user with role %q", role)
+		}
+	}
 }
 
 func TestTeamsAddUserWithRole(t *testing.T) {
@@ -406,6 +679,66 @@ func TestTeamsCachedPagedGetMembers(t *testing.T) {
 	}
 }
 
+func TestTeamsListMembers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/teams/1/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "include[]", "users")
+		testQueryValue(t, r, "limit", "2")
+		w.Write([]byte(`{"members": [{"user": {"id": "1", "type": "user", "name": "foo", "email": "foo@bar.com"}, "role": "manager"}, {"user": {"id": "2", "type": "user", "name": "bar"}, "role": "observer"}], "limit": 2, "offset": 0, "more": true}`))
+	})
+
+	resp, _, err := client.Teams.ListMembers("1", &ListMembersOptions{ListOptions: ListOptions{Limit: 2}, Include: []string{"users"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListMembersResponse{
+		PaginationMeta: PaginationMeta{Limit: 2, More: true},
+		Members: []*TeamMember{
+			{User: &User{ID: "1", Type: "user", Name: "foo", Email: "foo@bar.com"}, Role: TeamRoleManager},
+			{User: &User{ID: "2", Type: "user", Name: "bar"}, Role: TeamRoleObserver},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestTeamsIterMembers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/teams/1/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("offset") {
+		case "":
+			w.Write([]byte(`{"members": [{"user": {"id": "1"}, "role": "manager"}], "limit": 1, "offset": 0, "more": true}`))
+		case "1":
+			w.Write([]byte(`{"members": [{"user": {"id": "2"}, "role": "responder"}], "limit": 1, "offset": 1, "more": false}`))
+		default:
+			t.Errorf("unexpected offset %q", r.URL.Query().Get("offset"))
+		}
+	})
+
+	var got []string
+	it := client.Teams.IterMembers("1", nil)
+	for it.Next() {
+		m := it.Value()
+		got = append(got, m.User.ID+":"+m.Role)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"1:manager", "2:responder"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
 func TestTeamsAddEscalationPolicy(t *testing.T) {
 	setup()
 	defer teardown()
@@ -419,6 +752,24 @@ func TestTeamsAddEscalationPolicy(t *testing.T) {
 	}
 }
 
+func TestTeamsAddEscalationPolicyNoContent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/teams/1/escalation_policies/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.Teams.AddEscalationPolicy("1", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Response.StatusCode != http.StatusNoContent {
+		t.Errorf("status code = %d, want %d", resp.Response.StatusCode, http.StatusNoContent)
+	}
+}
+
 func TestTeamsRemoveEscalationPolicy(t *testing.T) {
 	setup()
 	defer teardown()
@@ -431,3 +782,268 @@ func TestTeamsRemoveEscalationPolicy(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestTeamsRemoveEscalationPolicyNoContent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/teams/1/escalation_policies/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.Teams.RemoveEscalationPolicy("1", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Response.StatusCode != http.StatusNoContent {
+		t.Errorf("status code = %d, want %d", resp.Response.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestTeamsEscalationPolicyNotFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	notFound := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error": {"code": 2100, "message": "Not Found"}}`))
+	}
+	// Unknown team, then unknown escalation policy.
+	mux.HandleFunc("/teams/missing/escalation_policies/1", notFound)
+	mux.HandleFunc("/teams/1/escalation_policies/missing", notFound)
+
+	for _, ids := range [][2]string{{"missing", "1"}, {"1", "missing"}} {
+		if _, err := client.Teams.AddEscalationPolicy(ids[0], ids[1]); !IsNotFound(err) {
+			t.Errorf("AddEscalationPolicy(%q, %q) returned %v, want a not found error", ids[0], ids[1], err)
+		}
+		if _, err := client.Teams.RemoveEscalationPolicy(ids[0], ids[1]); !IsNotFound(err) {
+			t.Errorf("RemoveEscalationPolicy(%q, %q) returned %v, want a not found error", ids[0], ids[1], err)
+		}
+	}
+}
+
+func TestTeamsListAuditRecords(t *testing.T) {
+	setup()
+	defer teardown()
+
+	since := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := since.AddDate(0, 1, 0)
+
+	mux.HandleFunc("/teams/PQ9K7I8/audit/records", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "since", "2023-01-01T00:00:00Z")
+		testQueryValue(t, r, "until", "2023-02-01T00:00:00Z")
+		w.Write([]byte(`{"records": [{
+			"id": "PDRECORDID1",
+			"execution_time": "2023-01-10T17:22:11.000Z",
+			"actors": [{"id": "PDUSER", "type": "user_reference", "summary": "John Snow"}],
+			"root_resource": {"id": "PQ9K7I8", "type": "team_reference"},
+			"action": "update",
+			"details": {
+				"resource": {"id": "PQ9K7I8", "type": "team_reference"},
+				"references": [{"name": "members", "removed": [{"id": "PXPGF42", "type": "user_reference"}]}]
+			}
+		}], "limit": 25, "next_cursor": null}`))
+	})
+
+	resp, _, err := client.Teams.ListAuditRecords("PQ9K7I8", &ListAuditRecordsOptions{
+		Since: since.Format(time.RFC3339),
+		Until: until.Format(time.RFC3339),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListAuditRecordsResponse{
+		CursorPagination: CursorPagination{Limit: 25},
+		Records: []*AuditRecord{
+			{
+				ID:            "PDRECORDID1",
+				ExecutionTime: "2023-01-10T17:22:11.000Z",
+				Actors:        []*AuditActor{{ID: "PDUSER", Type: "user_reference", Summary: "John Snow"}},
+				RootResource:  &AuditResource{ID: "PQ9K7I8", Type: "team_reference"},
+				Action:        "update",
+				Details: &AuditDetails{
+					Resource:   &AuditResource{ID: "PQ9K7I8", Type: "team_reference"},
+					References: []*AuditFieldReference{{Name: "members", Removed: []*AuditResource{{ID: "PXPGF42", Type: "user_reference"}}}},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestTeamsListAllAuditRecords(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/teams/PQ9K7I8/audit/records", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch cursor := r.URL.Query().Get("cursor"); cursor {
+		case "":
+			w.Write([]byte(`{"records": [{"id": "1"}], "next_cursor": "abc"}`))
+		case "abc":
+			w.Write([]byte(`{"records": [{"id": "2"}], "next_cursor": null}`))
+		default:
+			t.Errorf("unexpected cursor %q", cursor)
+		}
+	})
+
+	resp, err := client.Teams.ListAllAuditRecords("PQ9K7I8", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*AuditRecord{{ID: "1"}, {ID: "2"}}
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestTeamsListServices(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/services", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "team_ids[]", "PQ9K7I8")
+		switch r.URL.Query().Get("offset") {
+		case "0", "":
+			w.Write([]byte(`{"services": [{"id": "S1"}], "limit": 1, "offset": 0, "more": true}`))
+		case "1":
+			w.Write([]byte(`{"services": [{"id": "S2"}], "limit": 1, "offset": 1, "more": false}`))
+		default:
+			t.Errorf("unexpected offset %q", r.URL.Query().Get("offset"))
+		}
+	})
+
+	resp, err := client.Teams.ListServices("PQ9K7I8")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*Service{{ID: "S1"}, {ID: "S2"}}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestTeamsListEscalationPolicies(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/escalation_policies", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "team_ids[]", "PQ9K7I8")
+		w.Write([]byte(`{"escalation_policies": [{"id": "E1"}], "limit": 25, "offset": 0, "more": false}`))
+	})
+
+	resp, err := client.Teams.ListEscalationPolicies("PQ9K7I8")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*EscalationPolicy{{ID: "E1"}}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestTeamsListSchedules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/schedules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, ok := r.URL.Query()["team_ids[]"]; ok {
+			t.Error("unexpected team_ids[] parameter")
+		}
+		switch r.URL.Query().Get("offset") {
+		case "0", "":
+			w.Write([]byte(`{"schedules": [{"id": "SC1", "teams": [{"id": "PQ9K7I8"}]}, {"id": "SC2", "teams": [{"id": "OTHER"}]}], "limit": 2, "offset": 0, "more": true}`))
+		case "2":
+			w.Write([]byte(`{"schedules": [{"id": "SC3"}, {"id": "SC4", "teams": [{"id": "OTHER"}, {"id": "PQ9K7I8"}]}], "limit": 2, "offset": 2, "more": false}`))
+		default:
+			t.Errorf("unexpected offset %q", r.URL.Query().Get("offset"))
+		}
+	})
+
+	resp, err := client.Teams.ListSchedules("PQ9K7I8")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	for _, s := range resp {
+		ids = append(ids, s.ID)
+	}
+	if want := []string{"SC1", "SC4"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("returned schedules %v, want %v", ids, want)
+	}
+}
+
+func TestTeamsListTags(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/teams/PQ9K7I8/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"tags": [{"id": "T1", "type": "tag", "label": "cost-center-42"}], "limit": 25, "offset": 0, "more": false}`))
+	})
+
+	resp, err := client.Teams.ListTags("PQ9K7I8")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*Tag{{ID: "T1", Type: "tag", Label: "cost-center-42"}}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestTeamsChangeTags(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/teams/PQ9K7I8/change_tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"add":[{"type":"tag_reference","id":"T1"},{"type":"tag","label":"cost-center-42"}],"remove":[{"type":"tag_reference","id":"T2"}]}`)
+		w.Write([]byte(`{}`))
+	})
+
+	_, err := client.Teams.ChangeTags("PQ9K7I8",
+		[]*TagAssignment{{Type: "tag_reference", TagID: "T1"}, {Type: "tag", Label: "cost-center-42"}},
+		[]*TagAssignment{{Type: "tag_reference", TagID: "T2"}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTeamsChangeTagsInvalidReference(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/teams/PQ9K7I8/change_tags", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error": {"code": 2001, "message": "Invalid Input Provided", "errors": ["Tag reference TMISSING not found"]}}`))
+	})
+
+	_, err := client.Teams.ChangeTags("PQ9K7I8", []*TagAssignment{{Type: "tag_reference", TagID: "TMISSING"}}, nil)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("got error %v, want an *APIError", err)
+	}
+	if want := []string{"Tag reference TMISSING not found"}; apiErr.StatusCode != http.StatusBadRequest || !reflect.DeepEqual(apiErr.Errors, want) {
+		t.Errorf("got status %d and errors %v, want 400 and %v", apiErr.StatusCode, apiErr.Errors, want)
+	}
+}
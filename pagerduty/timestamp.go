@@ -0,0 +1,90 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// timestampLayouts are the layouts of the times sent by the API, tried in
+// order. Offset-less times are parsed in UTC.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05",
+}
+
+// Timestamp represents a time sent or received by the API, such as the
+// start and end of schedule layers, overrides and rendered entries. It
+// embeds the parsed time.Time and keeps the raw value received, returned by
+// String. Offset-less values are parsed in UTC; use String with
+// time.ParseInLocation to read them in another time zone.
+//
+// Timestamps are encoded as RFC 3339, except that a value received and left
+// unchanged is sent back as received.
+type Timestamp struct {
+	time.Time
+	raw string
+}
+
+// NewTimestamp returns a timestamp for t, e.g. to set the start of an
+// override.
+func NewTimestamp(t time.Time) *Timestamp {
+	return &Timestamp{Time: t}
+}
+
+// ParseTimestamp parses a time in one of the formats sent by the API.
+func ParseTimestamp(value string) (*Timestamp, error) {
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return &Timestamp{Time: t, raw: value}, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot parse %q as a timestamp", value)
+}
+
+// String returns the raw value received from the API, or the time formatted
+// as RFC 3339 if the timestamp was not received or was changed since.
+func (t Timestamp) String() string {
+	if t.raw != "" && t.unchanged() {
+		return t.raw
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+// unchanged reports whether the time still is the one parsed from raw.
+func (t Timestamp) unchanged() bool {
+	parsed, err := ParseTimestamp(t.raw)
+	return err == nil && parsed.Time.Equal(t.Time)
+}
+
+// MarshalJSON encodes the timestamp as RFC 3339, or as received if it was
+// not changed. A zero timestamp is encoded as null.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON decodes a time in one of the formats sent by the API. Null
+// and empty values decode as the zero timestamp.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	var value *string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	if value == nil || *value == "" {
+		*t = Timestamp{}
+		return nil
+	}
+
+	parsed, err := ParseTimestamp(*value)
+	if err != nil {
+		return err
+	}
+	*t = *parsed
+	return nil
+}
@@ -0,0 +1,125 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func mustParseTimestamp(t *testing.T, value string) *Timestamp {
+	t.Helper()
+	ts, err := ParseTimestamp(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ts
+}
+
+func TestParseTimestamp(t *testing.T) {
+	est := time.FixedZone("", -5*3600)
+
+	cases := []struct {
+		value string
+		want  time.Time
+	}{
+		{"2015-11-06T20:00:00-05:00", time.Date(2015, 11, 6, 20, 0, 0, 0, est)},
+		{"2015-11-07T01:00:00Z", time.Date(2015, 11, 7, 1, 0, 0, 0, time.UTC)},
+		{"2015-11-06T20:00:00.123-05:00", time.Date(2015, 11, 6, 20, 0, 0, 123000000, est)},
+		{"2015-11-06T20:00:00-0500", time.Date(2015, 11, 6, 20, 0, 0, 0, est)},
+		{"2015-11-06T20:00:00.5-0500", time.Date(2015, 11, 6, 20, 0, 0, 500000000, est)},
+		{"2015-11-06T20:00:00", time.Date(2015, 11, 6, 20, 0, 0, 0, time.UTC)},
+		{"2015-11-06T20:00:00.250", time.Date(2015, 11, 6, 20, 0, 0, 250000000, time.UTC)},
+		{"2015-11-06 20:00:00 -0500", time.Date(2015, 11, 6, 20, 0, 0, 0, est)},
+		{"2015-11-06 20:00:00", time.Date(2015, 11, 6, 20, 0, 0, 0, time.UTC)},
+	}
+
+	for _, c := range cases {
+		ts, err := ParseTimestamp(c.value)
+		if err != nil {
+			t.Errorf("ParseTimestamp(%q) returned %v", c.value, err)
+			continue
+		}
+		if !ts.Equal(c.want) {
+			t.Errorf("ParseTimestamp(%q) = %v, want %v", c.value, ts.Time, c.want)
+		}
+		_, offset := ts.Zone()
+		if _, want := c.want.Zone(); offset != want {
+			t.Errorf("ParseTimestamp(%q) has offset %d, want %d", c.value, offset, want)
+		}
+		if ts.String() != c.value {
+			t.Errorf("ParseTimestamp(%q).String() = %q, want the raw value", c.value, ts.String())
+		}
+	}
+}
+
+func TestParseTimestampInvalid(t *testing.T) {
+	for _, value := range []string{"", "yesterday", "2015-11-06", "2015-11-06T20:00", "06/11/2015 20:00:00", "2015-13-06T20:00:00Z"} {
+		if ts, err := ParseTimestamp(value); err == nil {
+			t.Errorf("ParseTimestamp(%q) = %v, want an error", value, ts)
+		}
+	}
+}
+
+func TestTimestampUnmarshalJSON(t *testing.T) {
+	var v struct {
+		Start *Timestamp `json:"start"`
+		End   *Timestamp `json:"end"`
+		Empty *Timestamp `json:"empty"`
+	}
+	if err := json.Unmarshal([]byte(`{"start": "2015-11-06T20:00:00-05:00", "end": null, "empty": ""}`), &v); err != nil {
+		t.Fatal(err)
+	}
+
+	if !v.Start.Equal(time.Date(2015, 11, 7, 1, 0, 0, 0, time.UTC)) {
+		t.Errorf("start = %v", v.Start)
+	}
+	if v.End != nil {
+		t.Errorf("end = %v, want nil", v.End)
+	}
+	if v.Empty == nil || !v.Empty.IsZero() {
+		t.Errorf("empty = %#v, want a zero timestamp", v.Empty)
+	}
+
+	for _, data := range []string{`"yesterday"`, `1446858000`, `{}`} {
+		var ts Timestamp
+		if err := json.Unmarshal([]byte(data), &ts); err == nil {
+			t.Errorf("decoding %s = %v, want an error", data, ts)
+		}
+	}
+}
+
+func TestTimestampMarshalJSON(t *testing.T) {
+	cases := []struct {
+		ts   *Timestamp
+		want string
+	}{
+		{NewTimestamp(time.Date(2015, 11, 6, 20, 0, 0, 0, time.FixedZone("EST", -5*3600))), `"2015-11-06T20:00:00-05:00"`},
+		{NewTimestamp(time.Date(2015, 11, 7, 1, 0, 0, 500, time.UTC)), `"2015-11-07T01:00:00.0000005Z"`},
+		{&Timestamp{}, `null`},
+		// Received values are sent back as received, offset-less ones too.
+		{mustParseTimestamp(t, "2015-11-06T20:00:00"), `"2015-11-06T20:00:00"`},
+		{mustParseTimestamp(t, "2015-11-06T20:00:00-0500"), `"2015-11-06T20:00:00-0500"`},
+	}
+
+	for _, c := range cases {
+		b, err := json.Marshal(c.ts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != c.want {
+			t.Errorf("encoded %v as %s, want %s", c.ts.Time, b, c.want)
+		}
+	}
+}
+
+func TestTimestampChanged(t *testing.T) {
+	ts := mustParseTimestamp(t, "2015-11-06T20:00:00")
+	ts.Time = ts.Add(time.Hour)
+
+	if got, want := ts.String(), "2015-11-06T21:00:00Z"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if b, _ := json.Marshal(ts); string(b) != `"2015-11-06T21:00:00Z"` {
+		t.Errorf("encoded %s, want the changed time", b)
+	}
+}
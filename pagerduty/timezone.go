@@ -0,0 +1,109 @@
+package pagerduty
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	// Embedded so that time zones validate the same on hosts without a
+	// tz database, e.g. in minimal containers.
+	_ "time/tzdata"
+)
+
+// deprecatedTimeZones maps backward compatible names of the tz database to
+// their canonical name.
+var deprecatedTimeZones = map[string]string{
+	"America/Buenos_Aires": "America/Argentina/Buenos_Aires",
+	"America/Indianapolis": "America/Indiana/Indianapolis",
+	"Asia/Calcutta":        "Asia/Kolkata",
+	"Asia/Katmandu":        "Asia/Kathmandu",
+	"Asia/Saigon":          "Asia/Ho_Chi_Minh",
+	"Australia/ACT":        "Australia/Sydney",
+	"Canada/Eastern":       "America/Toronto",
+	"Canada/Pacific":       "America/Vancouver",
+	"GB":                   "Europe/London",
+	"GMT":                  "UTC",
+	"US/Alaska":            "America/Anchorage",
+	"US/Arizona":           "America/Phoenix",
+	"US/Central":           "America/Chicago",
+	"US/Eastern":           "America/New_York",
+	"US/Hawaii":            "Pacific/Honolulu",
+	"US/Mountain":          "America/Denver",
+	"US/Pacific":           "America/Los_Angeles",
+	"UCT":                  "UTC",
+	"Universal":            "UTC",
+	"Zulu":                 "UTC",
+}
+
+// timeZoneAbbreviations maps common abbreviations, which are not time zones
+// or only fixed offsets in the tz database, to the time zone usually meant.
+var timeZoneAbbreviations = map[string]string{
+	"AEST": "Australia/Sydney",
+	"AKST": "America/Anchorage",
+	"BST":  "Europe/London",
+	"CDT":  "America/Chicago",
+	"CET":  "Europe/Paris",
+	"CST":  "America/Chicago",
+	"EDT":  "America/New_York",
+	"EST":  "America/New_York",
+	"HST":  "Pacific/Honolulu",
+	"IST":  "Asia/Kolkata",
+	"JST":  "Asia/Tokyo",
+	"MDT":  "America/Denver",
+	"MST":  "America/Denver",
+	"PDT":  "America/Los_Angeles",
+	"PST":  "America/Los_Angeles",
+}
+
+// TimeZoneError is returned by ValidateTimeZone for a time zone the API does
+// not accept. Suggestion is the canonical time zone probably meant, empty if
+// unknown. Err is the error loading the time zone, if any.
+type TimeZoneError struct {
+	TimeZone   string
+	Suggestion string
+	Err        error
+}
+
+func (e *TimeZoneError) Error() string {
+	msg := fmt.Sprintf("invalid time zone %q", e.TimeZone)
+	if e.Suggestion != "" {
+		msg = fmt.Sprintf("%s, did you mean %q?", msg, e.Suggestion)
+	}
+	return msg
+}
+
+// Unwrap returns the error loading the time zone.
+func (e *TimeZoneError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateTimeZone checks that name is the canonical name of a time zone of
+// the tz database, such as "America/Los_Angeles" or "UTC", as expected by
+// the API. Abbreviations such as "PST" and deprecated names such as
+// "US/Pacific" return a *TimeZoneError suggesting the canonical name.
+func ValidateTimeZone(name string) error {
+	if canonical, ok := deprecatedTimeZones[name]; ok {
+		return &TimeZoneError{TimeZone: name, Suggestion: canonical}
+	}
+	if suggestion, ok := timeZoneAbbreviations[strings.ToUpper(name)]; ok {
+		return &TimeZoneError{TimeZone: name, Suggestion: suggestion}
+	}
+
+	if _, err := time.LoadLocation(name); err != nil || name == "" || name == "Local" {
+		return &TimeZoneError{TimeZone: name, Err: err}
+	}
+	if name != "UTC" && !strings.Contains(name, "/") {
+		return &TimeZoneError{TimeZone: name}
+	}
+	return nil
+}
+
+// LoadTimeZone returns the location of a time zone received from the API,
+// after trimming it and replacing deprecated names by their canonical one.
+func LoadTimeZone(name string) (*time.Location, error) {
+	name = strings.TrimSpace(name)
+	if canonical, ok := deprecatedTimeZones[name]; ok {
+		name = canonical
+	}
+	return time.LoadLocation(name)
+}
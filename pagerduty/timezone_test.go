@@ -0,0 +1,88 @@
+package pagerduty
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateTimeZone(t *testing.T) {
+	for _, name := range []string{"America/Los_Angeles", "Europe/Berlin", "America/Argentina/Buenos_Aires", "UTC", "Etc/UTC"} {
+		if err := ValidateTimeZone(name); err != nil {
+			t.Errorf("ValidateTimeZone(%q) = %v, want nil", name, err)
+		}
+	}
+}
+
+func TestValidateTimeZoneInvalid(t *testing.T) {
+	cases := []struct {
+		name       string
+		suggestion string
+		loadErr    bool
+	}{
+		{name: ""},
+		{name: "Local"},
+		{name: "Mars/Olympus_Mons", loadErr: true},
+		{name: "america/new_york", loadErr: true},
+		{name: "PST", suggestion: "America/Los_Angeles"},
+		{name: "est", suggestion: "America/New_York"},
+		{name: "EST5EDT"},
+	}
+
+	for _, c := range cases {
+		err := ValidateTimeZone(c.name)
+
+		var tzErr *TimeZoneError
+		if !errors.As(err, &tzErr) {
+			t.Errorf("ValidateTimeZone(%q) = %v, want a *TimeZoneError", c.name, err)
+			continue
+		}
+		if tzErr.TimeZone != c.name || tzErr.Suggestion != c.suggestion {
+			t.Errorf("ValidateTimeZone(%q) = %#v, want suggestion %q", c.name, tzErr, c.suggestion)
+		}
+		if (tzErr.Err != nil) != c.loadErr {
+			t.Errorf("ValidateTimeZone(%q) load error = %v, want one: %t", c.name, tzErr.Err, c.loadErr)
+		}
+	}
+}
+
+func TestValidateTimeZoneDeprecated(t *testing.T) {
+	cases := map[string]string{
+		"US/Pacific":    "America/Los_Angeles",
+		"US/Eastern":    "America/New_York",
+		"Asia/Calcutta": "Asia/Kolkata",
+		"GMT":           "UTC",
+	}
+
+	for name, canonical := range cases {
+		var tzErr *TimeZoneError
+		if err := ValidateTimeZone(name); !errors.As(err, &tzErr) || tzErr.Suggestion != canonical {
+			t.Errorf("ValidateTimeZone(%q) = %v, want a *TimeZoneError suggesting %q", name, err, canonical)
+		}
+	}
+
+	if got, want := (&TimeZoneError{TimeZone: "US/Pacific", Suggestion: "America/Los_Angeles"}).Error(), `invalid time zone "US/Pacific", did you mean "America/Los_Angeles"?`; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestLoadTimeZone(t *testing.T) {
+	for name, want := range map[string]string{
+		"America/Chicago": "America/Chicago",
+		" US/Central ":    "America/Chicago",
+		"Asia/Saigon":     "Asia/Ho_Chi_Minh",
+		"":                "UTC",
+	} {
+		loc, err := LoadTimeZone(name)
+		if err != nil {
+			t.Errorf("LoadTimeZone(%q) returned %v", name, err)
+			continue
+		}
+		if loc.String() != want {
+			t.Errorf("LoadTimeZone(%q) = %s, want %s", name, loc, want)
+		}
+	}
+
+	if _, err := LoadTimeZone("Mars/Olympus_Mons"); err == nil {
+		t.Error("expected an error loading an unknown time zone")
+	}
+}
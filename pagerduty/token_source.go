@@ -0,0 +1,205 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultTokenExpiryDelta is how long before its reported expiry a cached
+// token is considered stale and refreshed.
+const defaultTokenExpiryDelta = time.Minute
+
+// defaultTokenRequestTimeout limits a request for a new token when the
+// HTTPClient of a ClientCredentialsTokenSource sets no timeout of its own.
+const defaultTokenRequestTimeout = 30 * time.Second
+
+// TokenSource supplies the OAuth access token sent as a Bearer token with
+// every request. Token is called once per request, so implementations should
+// cache tokens and be safe for concurrent use.
+//
+// If a TokenSource also has an Invalidate() method, the client calls it when
+// the API rejects a request with HTTP 401 and then retries the request once
+// with a freshly obtained token.
+type TokenSource interface {
+	Token() (string, error)
+}
+
+// tokenInvalidator is implemented by token sources that cache tokens and can
+// drop one that the API rejected.
+type tokenInvalidator interface {
+	Invalidate()
+}
+
+// StaticTokenSource is a TokenSource that always returns the same token.
+type StaticTokenSource string
+
+// Token returns the static token.
+func (s StaticTokenSource) Token() (string, error) {
+	return string(s), nil
+}
+
+// ClientCredentialsTokenSource is a TokenSource that obtains scoped OAuth
+// access tokens with the client_credentials grant and caches them until
+// shortly before they expire.
+type ClientCredentialsTokenSource struct {
+	ClientID     string
+	ClientSecret string
+	PDSubDomain  string
+	// Region defaults to "us".
+	Region string
+	// Scopes defaults to every scope available to scoped OAuth apps.
+	Scopes []string
+	// TokenURL defaults to the PagerDuty identity service.
+	TokenURL string
+	// HTTPClient defaults to http.DefaultClient. Requests for a token time
+	// out after HTTPClient.Timeout, or 30 seconds when it is zero.
+	HTTPClient *http.Client
+	// ExpiryDelta defaults to one minute.
+	ExpiryDelta time.Duration
+
+	mu      sync.Mutex
+	token   string
+	expiry  time.Time
+	refresh *tokenRefresh
+	now     func() time.Time
+}
+
+// tokenRefresh is a request for a new token in flight, shared by the calls
+// to Token that need one. done is closed once token or err is set.
+type tokenRefresh struct {
+	done  chan struct{}
+	token string
+	err   error
+}
+
+// Token returns the cached access token, requesting a new one when there is
+// none or it is about to expire. Concurrent calls share a single request.
+func (s *ClientCredentialsTokenSource) Token() (string, error) {
+	s.mu.Lock()
+
+	now := time.Now
+	if s.now != nil {
+		now = s.now
+	}
+
+	delta := s.ExpiryDelta
+	if delta == 0 {
+		delta = defaultTokenExpiryDelta
+	}
+
+	if s.token != "" && now().Add(delta).Before(s.expiry) {
+		token := s.token
+		s.mu.Unlock()
+		return token, nil
+	}
+
+	// The lock is released during the request, so that a slow identity
+	// service does not block Invalidate. Callers that need a new token in
+	// the meantime wait for this request instead of starting their own.
+	if r := s.refresh; r != nil {
+		s.mu.Unlock()
+		<-r.done
+		return r.token, r.err
+	}
+	r := &tokenRefresh{done: make(chan struct{})}
+	s.refresh = r
+
+	region := s.Region
+	if region == "" {
+		region = defaultRegion
+	}
+	scopes := s.Scopes
+	if len(scopes) == 0 {
+		scopes = availableOauthScopes()
+	}
+	tokenURL := s.TokenURL
+	if tokenURL == "" {
+		tokenURL = defaultAppOauthTokenGenerationURL
+	}
+	httpClient := s.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	timeout := httpClient.Timeout
+	if timeout == 0 {
+		timeout = defaultTokenRequestTimeout
+	}
+	scope := fmt.Sprintf("as_account-%s.%s %s", region, s.PDSubDomain, strings.Join(scopes, " "))
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	v, err := requestClientCredentialsToken(ctx, httpClient, tokenURL, defaultUserAgent, s.ClientID, s.ClientSecret, scope)
+
+	s.mu.Lock()
+	s.refresh = nil
+	if err != nil {
+		r.err = fmt.Errorf("API call to obtain a new Scoped Oauth Access Token failed: %w", err)
+	} else {
+		s.token = v.AccessToken
+		s.expiry = now().Add(time.Duration(v.ExpiresIn) * time.Second)
+		r.token = v.AccessToken
+	}
+	s.mu.Unlock()
+	close(r.done)
+
+	return r.token, r.err
+}
+
+// Invalidate drops the cached token so that the next call to Token requests
+// a new one.
+func (s *ClientCredentialsTokenSource) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = ""
+	s.expiry = time.Time{}
+}
+
+// requestClientCredentialsToken performs the OAuth client_credentials flow
+// against tokenURL.
+func requestClientCredentialsToken(ctx context.Context, httpClient *http.Client, tokenURL, userAgent, clientID, clientSecret, scope string) (*scopedOauthResponse, error) {
+	data := url.Values{}
+	data.Add("grant_type", "client_credentials")
+	data.Add("client_id", clientID)
+	data.Add("client_secret", clientSecret)
+	data.Add("scope", scope)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Add("User-Agent", userAgent)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("with status code %d", resp.StatusCode)
+	}
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	v := new(scopedOauthResponse)
+	if err := json.Unmarshal(bodyBytes, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
@@ -0,0 +1,186 @@
+package pagerduty
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStaticTokenSource(t *testing.T) {
+	setup()
+	defer teardown()
+
+	c, err := NewClient(&Config{BaseURL: server.URL, TokenSource: StaticTokenSource("foo")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "Bearer foo")
+		w.Write([]byte(`{"abilities": []}`))
+	})
+
+	if _, _, err := c.Abilities.List(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClientCredentialsTokenSource(t *testing.T) {
+	setup()
+	defer teardown()
+
+	issued := 0
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if got := r.PostForm.Get("grant_type"); got != "client_credentials" {
+			t.Errorf("grant_type = %q, want client_credentials", got)
+		}
+		if got := r.PostForm.Get("scope"); got != "as_account-eu.acme users.read" {
+			t.Errorf("scope = %q, want %q", got, "as_account-eu.acme users.read")
+		}
+		issued++
+		fmt.Fprintf(w, `{"access_token": "token-%d", "expires_in": 3600}`, issued)
+	})
+
+	now := time.Now()
+	ts := &ClientCredentialsTokenSource{
+		ClientID:     "id",
+		ClientSecret: "secret",
+		PDSubDomain:  "acme",
+		Region:       "eu",
+		Scopes:       []string{"users.read"},
+		TokenURL:     server.URL + "/oauth/token",
+		now:          func() time.Time { return now },
+	}
+
+	for i := 0; i < 2; i++ {
+		token, err := ts.Token()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token != "token-1" {
+			t.Errorf("token = %q, want %q", token, "token-1")
+		}
+	}
+
+	// Within ExpiryDelta of the expiry, a new token is requested.
+	now = now.Add(59*time.Minute + time.Second)
+	token, err := ts.Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "token-2" {
+		t.Errorf("token = %q, want %q", token, "token-2")
+	}
+}
+
+func TestClientCredentialsTokenSourceConcurrent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var issued atomic.Int32
+	release := make(chan struct{})
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		fmt.Fprintf(w, `{"access_token": "token-%d", "expires_in": 3600}`, issued.Add(1))
+	})
+
+	ts := &ClientCredentialsTokenSource{PDSubDomain: "acme", TokenURL: server.URL + "/oauth/token"}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, err := ts.Token()
+			if err != nil {
+				t.Error(err)
+			}
+			if token != "token-1" {
+				t.Errorf("token = %q, want %q", token, "token-1")
+			}
+		}()
+	}
+
+	// Invalidate does not wait for the request in flight.
+	ts.Invalidate()
+	close(release)
+	wg.Wait()
+
+	if n := issued.Load(); n != 1 {
+		t.Errorf("requested %d tokens, want 1", n)
+	}
+}
+
+func TestClientCredentialsTokenSourceTimeout(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+
+	ts := &ClientCredentialsTokenSource{
+		PDSubDomain: "acme",
+		TokenURL:    server.URL + "/oauth/token",
+		HTTPClient:  &http.Client{Timeout: 50 * time.Millisecond},
+	}
+	if _, err := ts.Token(); err == nil {
+		t.Fatal("expected an error for a token request that times out")
+	}
+}
+
+func TestTokenSourceRefreshOnUnauthorized(t *testing.T) {
+	setup()
+	defer teardown()
+
+	issued := 0
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		issued++
+		fmt.Fprintf(w, `{"access_token": "token-%d", "expires_in": 3600}`, issued)
+	})
+
+	calls, rejectAll := 0, false
+	mux.HandleFunc("/abilities", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if rejectAll || r.Header.Get("Authorization") != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error": {"code": 2006, "message": "Unauthorized"}}`))
+			return
+		}
+		w.Write([]byte(`{"abilities": []}`))
+	})
+
+	c, err := NewClient(&Config{
+		BaseURL:     server.URL,
+		TokenSource: &ClientCredentialsTokenSource{TokenURL: server.URL + "/oauth/token"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := c.Abilities.List(); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 || issued != 2 {
+		t.Errorf("calls = %d, tokens issued = %d, want 2 and 2", calls, issued)
+	}
+
+	// A request refreshes the token at most once.
+	calls, rejectAll = 0, true
+	if _, _, err := c.Abilities.List(); !IsUnauthorized(err) {
+		t.Fatalf("expected an unauthorized error, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2", calls)
+	}
+}
@@ -1,8 +1,11 @@
 package pagerduty
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 )
 
@@ -22,6 +25,58 @@ type NotificationRule struct {
 	Urgency             string                  `json:"urgency,omitempty"`
 }
 
+// Urgencies of the incidents a notification rule applies to.
+const (
+	NotificationRuleUrgencyHigh = "high"
+	NotificationRuleUrgencyLow  = "low"
+)
+
+// NewNotificationRule returns a notification rule notifying the contact
+// method with the given ID and type, one of the ContactMethodType constants,
+// startDelayInMinutes after an incident of the given urgency is assigned.
+func NewNotificationRule(contactMethodID, contactMethodType, urgency string, startDelayInMinutes int) *NotificationRule {
+	return &NotificationRule{
+		ContactMethod:       &ContactMethodReference{ID: contactMethodID, Type: contactMethodReferenceType(contactMethodType)},
+		StartDelayInMinutes: startDelayInMinutes,
+		Type:                "assignment_notification_rule",
+		Urgency:             urgency,
+	}
+}
+
+// contactMethodReferenceType returns the reference type of the given
+// contact method type, e.g. "email_contact_method_reference".
+func contactMethodReferenceType(contactMethodType string) string {
+	if strings.HasSuffix(contactMethodType, "_reference") {
+		return contactMethodType
+	}
+	return contactMethodType + "_reference"
+}
+
+// validateNotificationRule checks that a notification rule references a
+// contact method and has a supported urgency.
+func validateNotificationRule(rule *NotificationRule) error {
+	if rule == nil {
+		return errors.New("a notification rule is required")
+	}
+	if rule.ContactMethod == nil || rule.ContactMethod.ID == "" {
+		return errors.New("a notification rule requires a contact method")
+	}
+	if rule.Urgency != NotificationRuleUrgencyHigh && rule.Urgency != NotificationRuleUrgencyLow {
+		return fmt.Errorf("unsupported notification rule urgency %q", rule.Urgency)
+	}
+	if rule.StartDelayInMinutes < 0 {
+		return fmt.Errorf("invalid notification rule start delay %d", rule.StartDelayInMinutes)
+	}
+	return nil
+}
+
+// ListNotificationRulesOptions represents options when listing notification
+// rules. Include "contact_methods" expands the contact methods of the rules.
+type ListNotificationRulesOptions struct {
+	Include []string `url:"include,omitempty,brackets"`
+	Urgency string   `url:"urgency,omitempty"`
+}
+
 // NotificationRulePayload represents a notification rule.
 type NotificationRulePayload struct {
 	NotificationRule *NotificationRule `json:"notification_rule,omitempty"`
@@ -48,7 +103,45 @@ type User struct {
 	NotificationRules []*NotificationRule       `json:"notification_rules,omitempty"`
 	Teams             []*TeamReference          `json:"teams,omitempty"`
 	ContactMethods    []*ContactMethodReference `json:"contact_methods,omitempty"`
-	License           *LicenseReference         `json:"license,omitempty"`
+
+	// License assigns a license of the account to the user on create and
+	// update, e.g. a stakeholder seat instead of a full user one. It is left
+	// for PagerDuty to choose when nil.
+	License *LicenseReference `json:"license,omitempty"`
+}
+
+// Roles of a user on the account.
+const (
+	RoleAdmin               = "admin"
+	RoleLimitedUser         = "limited_user"
+	RoleObserver            = "observer"
+	RoleOwner               = "owner"
+	RoleReadOnlyUser        = "read_only_user"
+	RoleReadOnlyLimitedUser = "read_only_limited_user"
+	RoleRestrictedAccess    = "restricted_access"
+	RoleUser                = "user"
+)
+
+// Validate checks that the role and the contact method references of the
+// user are known to this library. Create and Update do not call it, so that
+// values added by PagerDuty later keep working; call it before them to catch
+// typos without a round trip.
+func (u *User) Validate() error {
+	switch u.Role {
+	case "", RoleAdmin, RoleLimitedUser, RoleObserver, RoleOwner, RoleReadOnlyUser, RoleReadOnlyLimitedUser, RoleRestrictedAccess, RoleUser:
+	default:
+		return fmt.Errorf("unsupported user role %q", u.Role)
+	}
+
+	for _, c := range u.ContactMethods {
+		if c == nil {
+			continue
+		}
+		if err := validateContactMethod(&ContactMethod{Type: strings.TrimSuffix(c.Type, "_reference")}); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // LicensePayload represents a license.
@@ -93,7 +186,10 @@ type FullUserPayload struct {
 	User *FullUser `json:"user,omitempty"`
 }
 
-// ContactMethod represents a contact method for a user.
+// ContactMethod represents a contact method for a user. Type is one of the
+// ContactMethodType constants. BlackListed and Enabled are set by PagerDuty,
+// e.g. when a phone number stops accepting messages, and are ignored on
+// create and update.
 type ContactMethod struct {
 	ID          string `json:"id,omitempty"`
 	Summary     string `json:"summary,omitempty"`
@@ -117,6 +213,34 @@ type ContactMethod struct {
 	CreatedAt  string                    `json:"created_at,omitempty"`
 }
 
+// Types of the contact methods of a user.
+const (
+	ContactMethodTypeEmail            = "email_contact_method"
+	ContactMethodTypePhone            = "phone_contact_method"
+	ContactMethodTypeSMS              = "sms_contact_method"
+	ContactMethodTypePushNotification = "push_notification_contact_method"
+)
+
+// validateContactMethod checks that a contact method to create has a
+// supported type.
+func validateContactMethod(contactMethod *ContactMethod) error {
+	if contactMethod == nil {
+		return errors.New("a contact method is required")
+	}
+	switch contactMethod.Type {
+	case ContactMethodTypeEmail, ContactMethodTypePhone, ContactMethodTypeSMS, ContactMethodTypePushNotification:
+		return nil
+	}
+	return fmt.Errorf("unsupported contact method type %q", contactMethod.Type)
+}
+
+// Validate checks that the type of the contact method is one of the
+// ContactMethodType constants. CreateContactMethod calls it, UpdateContactMethod
+// does not.
+func (c *ContactMethod) Validate() error {
+	return validateContactMethod(c)
+}
+
 // ContactMethodPayload represents a contact method.
 type ContactMethodPayload struct {
 	ContactMethod *ContactMethod `json:"contact_method"`
@@ -130,10 +254,7 @@ type PushContactMethodSound struct {
 
 // ListContactMethodsResponse represents
 type ListContactMethodsResponse struct {
-	Limit          int              `json:"limit,omitempty"`
-	More           bool             `json:"more,omitempty"`
-	Offset         int              `json:"offset,omitempty"`
-	Total          int              `json:"total,omitempty"`
+	PaginationMeta
 	ContactMethods []*ContactMethod `json:"contact_methods,omitempty"`
 }
 
@@ -141,12 +262,17 @@ type ListNotificationRulesResponse struct {
 	NotificationRules []*NotificationRule `json:"notification_rules,omitempty"`
 }
 
-// ListUsersOptions represents options when listing users.
+// ListUsersOptions represents options when listing users. Query matches
+// the name or email of users, TeamIDs restricts them to members of the
+// given teams and Include expands e.g. "contact_methods",
+// "notification_rules" or "teams" in the returned users, see ListAll for
+// the expanded objects.
 type ListUsersOptions struct {
-	Limit   int      `url:"limit,omitempty"`
-	More    bool     `url:"more,omitempty"`
-	Offset  int      `url:"offset,omitempty"`
-	Total   int      `url:"total,omitempty"`
+	ListOptions
+
+	// Deprecated: More is not a request parameter and is ignored.
+	More bool `url:"-"`
+
 	Include []string `url:"include,omitempty,brackets"`
 	Query   string   `url:"query,omitempty"`
 	TeamIDs []string `url:"team_ids,omitempty,brackets"`
@@ -154,20 +280,14 @@ type ListUsersOptions struct {
 
 // ListUsersResponse represents a list response of users.
 type ListUsersResponse struct {
-	Limit  int     `json:"limit,omitempty"`
-	More   bool    `json:"more,omitempty"`
-	Offset int     `json:"offset,omitempty"`
-	Total  int     `json:"total,omitempty"`
-	Users  []*User `json:"users,omitempty"`
+	PaginationMeta
+	Users []*User `json:"users,omitempty"`
 }
 
 // ListFullUsersResponse represents a list response containing FullUser objects.
 type ListFullUsersResponse struct {
-	Limit  int         `json:"limit,omitempty"`
-	More   bool        `json:"more,omitempty"`
-	Offset int         `json:"offset,omitempty"`
-	Total  int         `json:"total,omitempty"`
-	Users  []*FullUser `json:"users,omitempty"`
+	PaginationMeta
+	Users []*FullUser `json:"users,omitempty"`
 }
 
 // GetUserOptions represents options when retrieving a user.
@@ -177,10 +297,15 @@ type GetUserOptions struct {
 
 // List lists existing users.
 func (s *UserService) List(o *ListUsersOptions) (*ListUsersResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists existing users.
+func (s *UserService) ListContext(ctx context.Context, o *ListUsersOptions) (*ListUsersResponse, *Response, error) {
 	u := "/users"
 	v := new(ListUsersResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/users"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -190,37 +315,51 @@ func (s *UserService) List(o *ListUsersOptions) (*ListUsersResponse, *Response,
 
 // ListAll lists users into FullUser objects
 func (s *UserService) ListAll(o *ListUsersOptions) ([]*FullUser, error) {
-	var users = make([]*FullUser, 0, 25)
-	var v *ListFullUsersResponse
-	more := true
-	offset := 0
-
-	for more {
-		log.Printf("==== Getting users at offset %d", offset)
-		v = new(ListFullUsersResponse)
-		_, err := s.client.newRequestDo("GET", "/users", o, nil, &v)
+	return s.ListAllContext(context.Background(), o)
+}
+
+// ListAllContext lists users into FullUser objects
+func (s *UserService) ListAllContext(ctx context.Context, o *ListUsersOptions) ([]*FullUser, error) {
+	opts := ListUsersOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	it := Iterate(opts.ListOptions, func(lo ListOptions) ([]*FullUser, PaginationMeta, *Response, error) {
+		opts.ListOptions = lo
+		v := new(ListFullUsersResponse)
+		resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", "/users", &opts, nil, &v, WithRoute("/users"))
 		if err != nil {
-			return users, err
+			return nil, PaginationMeta{}, resp, err
 		}
-		users = append(users, v.Users...)
-		more = v.More
-		offset += v.Limit
-		o.Offset = offset
+		return v.Users, v.PaginationMeta, resp, nil
+	})
+
+	var users = make([]*FullUser, 0, 25)
+	for it.Next() {
+		users = append(users, it.Value())
 	}
-	return users, nil
+	return users, it.Err()
 }
 
-// Create creates a new user.
+// Create creates a new user. Use User.Validate to check the user
+// beforehand.
 func (s *UserService) Create(user *User) (*User, *Response, error) {
+	return s.CreateContext(context.Background(), user)
+}
+
+// CreateContext creates a new user. Use User.Validate to check the user
+// beforehand.
+func (s *UserService) CreateContext(ctx context.Context, user *User) (*User, *Response, error) {
 	u := "/users"
 	v := new(UserPayload)
-	resp, err := s.client.newRequestDo("POST", u, nil, &UserPayload{User: user}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &UserPayload{User: user}, &v, WithRoute("/users"))
 	if err != nil {
-		if e, ok := err.(*Error); !ok || strings.Compare(fmt.Sprintf("%v", e.Errors), "[Email has already been taken]") != 0 {
+		if !hasErrorMessage(err, "Email has already been taken") {
 			return nil, nil, err
 		}
 
-		sUser, sResp, sErr := s.findExistingUser(user, err)
+		sUser, sResp, sErr := s.findExistingUser(ctx, user, err)
 		if sErr != nil {
 			return nil, nil, sErr
 		}
@@ -238,15 +377,15 @@ func (s *UserService) Create(user *User) (*User, *Response, error) {
 }
 
 // findExistingUser searches for a user based on the email
-func (s *UserService) findExistingUser(user *User, origErr error) (*User, *Response, error) {
-	resp, _, lErr := s.List(&ListUsersOptions{Query: user.Email})
+func (s *UserService) findExistingUser(ctx context.Context, user *User, origErr error) (*User, *Response, error) {
+	resp, _, lErr := s.ListContext(ctx, &ListUsersOptions{Query: user.Email})
 	if lErr != nil {
 		return nil, nil, fmt.Errorf("[Email has already been taken] but failed to fetch existing users: %w", lErr)
 	}
 
 	for _, u := range resp.Users {
 		if isSameUser(u, user) {
-			return s.Get(u.ID, &GetUserOptions{})
+			return s.GetContext(ctx, u.ID, &GetUserOptions{})
 		}
 	}
 
@@ -259,10 +398,69 @@ func isSameUser(existingU, newU *User) bool {
 		existingU.Role == newU.Role
 }
 
-// Delete removes an existing user.
+// FindByEmail finds the user with the given email, compared case
+// insensitively, going through every page of users matching it. When no
+// user has that email, IsNotFound reports true for the error; when several
+// do, the error is an *AmbiguousMatchError.
+func (s *UserService) FindByEmail(email string) (*User, error) {
+	return s.FindByEmailContext(context.Background(), email)
+}
+
+// FindByEmailContext finds the user with the given email, compared case
+// insensitively, going through every page of users matching it. When no
+// user has that email, IsNotFound reports true for the error; when several
+// do, the error is an *AmbiguousMatchError.
+func (s *UserService) FindByEmailContext(ctx context.Context, email string) (*User, error) {
+	if email == "" {
+		return nil, errors.New("an email is required to find a user")
+	}
+
+	// The query also matches users whose name or email merely contains the
+	// email, e.g. "john@example.com" matches "ajohn@example.com".
+	var matches []*User
+	it := s.IterContext(ctx, &ListUsersOptions{ListOptions: ListOptions{Limit: 100}, Query: email})
+	for it.Next() {
+		if u := it.Value(); strings.EqualFold(u.Email, email) {
+			matches = append(matches, u)
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("user with email %q: %w", email, ErrNotFound)
+	case 1:
+		return matches[0], nil
+	}
+
+	ids := make([]string, 0, len(matches))
+	for _, u := range matches {
+		ids = append(ids, u.ID)
+	}
+	return nil, &AmbiguousMatchError{Value: email, IDs: ids}
+}
+
+// Delete removes an existing user. A user still referenced by schedules,
+// escalation policies or open incidents cannot be removed, the error is a
+// *UserDeletionError listing them in that case.
 func (s *UserService) Delete(id string) (*Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext removes an existing user. A user still referenced by
+// schedules, escalation policies or open incidents cannot be removed, the
+// error is a *UserDeletionError listing them in that case.
+func (s *UserService) DeleteContext(ctx context.Context, id string) (*Response, error) {
 	u := fmt.Sprintf("/users/%s", id)
-	resp, err := s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/users/{id}"))
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest {
+			err = newUserDeletionError(id, apiErr)
+		}
+	}
 
 	if cerr := cacheDeleteUser(id); cerr != nil {
 		log.Printf("===== Error deleting user %q from cache: %q", id, cerr)
@@ -275,6 +473,11 @@ func (s *UserService) Delete(id string) (*Response, error) {
 
 // Get retrieves information about a user.
 func (s *UserService) Get(id string, o *GetUserOptions) (*User, *Response, error) {
+	return s.GetContext(context.Background(), id, o)
+}
+
+// GetContext retrieves information about a user.
+func (s *UserService) GetContext(ctx context.Context, id string, o *GetUserOptions) (*User, *Response, error) {
 	u := fmt.Sprintf("/users/%s", id)
 	v := new(UserPayload)
 
@@ -284,7 +487,7 @@ func (s *UserService) Get(id string, o *GetUserOptions) (*User, *Response, error
 		return cv, nil, nil
 	}
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, v, WithRoute("/users/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -292,12 +495,54 @@ func (s *UserService) Get(id string, o *GetUserOptions) (*User, *Response, error
 	return v.User, resp, nil
 }
 
-// GetLicense retrieves a users assigned License.
+// GetCurrentUserOptions represents options when retrieving the current
+// user. Include expands e.g. "contact_methods", "notification_rules" or
+// "teams" in the returned user.
+type GetCurrentUserOptions struct {
+	Include []string `url:"include,omitempty,brackets"`
+}
+
+// GetCurrent retrieves the user the credentials of the client belong to.
+// Account level API keys do not belong to a user, the error is a
+// *NoCurrentUserError in that case.
+func (s *UserService) GetCurrent(o *GetCurrentUserOptions) (*User, *Response, error) {
+	return s.GetCurrentContext(context.Background(), o)
+}
+
+// GetCurrentContext retrieves the user the credentials of the client belong
+// to. Account level API keys do not belong to a user, the error is a
+// *NoCurrentUserError in that case.
+func (s *UserService) GetCurrentContext(ctx context.Context, o *GetCurrentUserOptions) (*User, *Response, error) {
+	v := new(UserPayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", "/users/me", o, nil, v, WithRoute("/users/me"))
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest && apiErr.Code == noCurrentUserErrorCode {
+			err = &NoCurrentUserError{Err: err}
+		}
+		return nil, nil, err
+	}
+
+	return v.User, resp, nil
+}
+
+// GetLicense retrieves a users assigned License, the same License type
+// listed by LicenseService. IsPaymentRequired reports true for the error if
+// the account does not have licenses, IsNotFound if the user does not exist.
 func (s *UserService) GetLicense(id string) (*License, *Response, error) {
+	return s.GetLicenseContext(context.Background(), id)
+}
+
+// GetLicenseContext retrieves a users assigned License, the same License
+// type listed by LicenseService. IsPaymentRequired reports true for the
+// error if the account does not have licenses, IsNotFound if the user does
+// not exist.
+func (s *UserService) GetLicenseContext(ctx context.Context, id string) (*License, *Response, error) {
 	u := fmt.Sprintf("/users/%s/license", id)
 	v := new(LicensePayload)
 
-	resp, err := s.client.newRequestDo("GET", u, nil, nil, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, v, WithRoute("/users/{id}/license"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -309,12 +554,17 @@ func (s *UserService) GetLicense(id string) (*License, *Response, error) {
 // This function exists because the interface for GetLicense does not return
 // a user's assigned account Role, which is required in order to manage licenses
 func (s *UserService) GetWithLicense(id string, o *GetUserOptions) (*User, error) {
-	user, _, err := s.Get(id, o)
+	return s.GetWithLicenseContext(context.Background(), id, o)
+}
+
+// GetWithLicenseContext retrieves information about a user and their assigned License.
+func (s *UserService) GetWithLicenseContext(ctx context.Context, id string, o *GetUserOptions) (*User, error) {
+	user, _, err := s.GetContext(ctx, id, o)
 	if err != nil {
 		return nil, err
 	}
 
-	license, _, err := s.GetLicense(id)
+	license, _, err := s.GetLicenseContext(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -331,51 +581,84 @@ func (s *UserService) GetWithLicense(id string, o *GetUserOptions) (*User, error
 // requires that the caller list all users since the users &
 // license_allocations API's are not sorted in the same way.
 func (s *UserService) ListAllWithLicenses(o *ListUsersOptions) ([]*User, error) {
-	users := make(map[string]*User)
-	o.More, o.Offset = true, 0
+	return s.ListAllWithLicensesContext(context.Background(), o)
+}
 
-	for o.More {
-		log.Printf("==== Getting users at offset %d", o.Offset)
-		v, _, err := s.List(o)
-		if err != nil {
-			return prepareUsers(users), err
-		}
-		for _, u := range v.Users {
-			users[u.ID] = u
+// ListAllWithLicensesContext lists users into User objects with assigned licenses.
+func (s *UserService) ListAllWithLicensesContext(ctx context.Context, o *ListUsersOptions) ([]*User, error) {
+	opts := ListUsersOptions{}
+	if o != nil {
+		opts = *o
+	}
+	opts.Offset = 0
+
+	var users []*User
+	byID := make(map[string]*User)
+	it := s.IterContext(ctx, &opts)
+	for it.Next() {
+		u := it.Value()
+		if _, ok := byID[u.ID]; !ok {
+			users = append(users, u)
 		}
-		o.More = v.More
-		o.Offset = o.Offset + v.Limit
+		byID[u.ID] = u
+	}
+	if err := it.Err(); err != nil {
+		return users, err
 	}
 
-	licenseAllocations, err := s.client.Licenses.ListAllAllocations(&ListLicenseAllocationsOptions{})
+	licenseAllocations, err := s.client.Licenses.ListAllAllocationsContext(ctx, &ListLicenseAllocationsOptions{})
 	if err != nil {
-		return prepareUsers(users), err
+		return users, err
 	}
 	for _, la := range licenseAllocations {
-		users[la.User.ID].License = &LicenseReference{ID: la.License.ID, Type: "license_reference"}
+		// Allocations cover every user of the account, not only the listed ones.
+		if u, ok := byID[la.User.ID]; ok {
+			u.License = &LicenseReference{ID: la.License.ID, Type: "license_reference"}
+		}
 	}
-	return prepareUsers(users), nil
+	return users, nil
 }
 
-func prepareUsers(users map[string]*User) []*User {
-	usersSlice := make([]*User, 0, len(users))
+// ListAuditRecords lists a single page of the audit records of a user, e.g.
+// changes of their role. Use the NextCursor of the response as the Cursor
+// option to fetch the following page.
+func (s *UserService) ListAuditRecords(userID string, o *ListAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	return s.ListAuditRecordsContext(context.Background(), userID, o)
+}
 
-	for _, u := range users {
-		usersSlice = append(usersSlice, u)
-	}
+// ListAuditRecordsContext lists a single page of the audit records of a
+// user, e.g. changes of their role. Use the NextCursor of the response as
+// the Cursor option to fetch the following page.
+func (s *UserService) ListAuditRecordsContext(ctx context.Context, userID string, o *ListAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	return s.client.listAuditRecordsContext(ctx, fmt.Sprintf("/users/%s/audit/records", userID), "/users/{id}/audit/records", o)
+}
+
+// ListAllAuditRecords lists every audit record of a user matching the
+// options, following the cursor until the last page.
+func (s *UserService) ListAllAuditRecords(userID string, o *ListAuditRecordsOptions) ([]*AuditRecord, error) {
+	return s.ListAllAuditRecordsContext(context.Background(), userID, o)
+}
 
-	return usersSlice
+// ListAllAuditRecordsContext lists every audit record of a user matching the
+// options, following the cursor until the last page.
+func (s *UserService) ListAllAuditRecordsContext(ctx context.Context, userID string, o *ListAuditRecordsOptions) ([]*AuditRecord, error) {
+	return s.client.listAllAuditRecordsContext(ctx, fmt.Sprintf("/users/%s/audit/records", userID), "/users/{id}/audit/records", o)
 }
 
 // GetFull retrieves information about a user including contact methods and notification rules.
 func (s *UserService) GetFull(id string) (*FullUser, *Response, error) {
+	return s.GetFullContext(context.Background(), id)
+}
+
+// GetFullContext retrieves information about a user including contact methods and notification rules.
+func (s *UserService) GetFullContext(ctx context.Context, id string) (*FullUser, *Response, error) {
 	u := fmt.Sprintf("/users/%s", id)
 	v := new(FullUserPayload)
 	o := &GetUserOptions{
 		Include: []string{"contact_methods", "notification_rules"},
 	}
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, v, WithRoute("/users/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -385,10 +668,15 @@ func (s *UserService) GetFull(id string) (*FullUser, *Response, error) {
 
 // Update updates an existing user.
 func (s *UserService) Update(id string, user *User) (*User, *Response, error) {
+	return s.UpdateContext(context.Background(), id, user)
+}
+
+// UpdateContext updates an existing user.
+func (s *UserService) UpdateContext(ctx context.Context, id string, user *User) (*User, *Response, error) {
 	u := fmt.Sprintf("/users/%s", id)
 	v := new(UserPayload)
 
-	resp, err := s.client.newRequestDo("PUT", u, nil, &UserPayload{User: user}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &UserPayload{User: user}, &v, WithRoute("/users/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -400,10 +688,15 @@ func (s *UserService) Update(id string, user *User) (*User, *Response, error) {
 
 // ListContactMethods lists contact methods for a user.
 func (s *UserService) ListContactMethods(userID string) (*ListContactMethodsResponse, *Response, error) {
+	return s.ListContactMethodsContext(context.Background(), userID)
+}
+
+// ListContactMethodsContext lists contact methods for a user.
+func (s *UserService) ListContactMethodsContext(ctx context.Context, userID string) (*ListContactMethodsResponse, *Response, error) {
 	u := fmt.Sprintf("/users/%s/contact_methods", userID)
 	v := new(ListContactMethodsResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/users/{id}/contact_methods"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -411,26 +704,40 @@ func (s *UserService) ListContactMethods(userID string) (*ListContactMethodsResp
 	return v, resp, nil
 }
 
-// CreateContactMethod creates a new contact method for a user.
+// CreateContactMethod creates a new contact method for a user. Its Type must
+// be one of the ContactMethodType constants.
 // If the same contact method already exists, it will fetch the existing one, return a 200 instead of fail. This feature is useful in terraform
 // provider, as when the desired user contact method already exists, terraform will be able to sync it to the state automatically. Otherwise,
 // we need to manually fix the conflicts.
 func (s *UserService) CreateContactMethod(userID string, contactMethod *ContactMethod) (*ContactMethod, *Response, error) {
+	return s.CreateContactMethodContext(context.Background(), userID, contactMethod)
+}
+
+// CreateContactMethodContext creates a new contact method for a user. Its
+// Type must be one of the ContactMethodType constants.
+// If the same contact method already exists, it will fetch the existing one, return a 200 instead of fail. This feature is useful in terraform
+// provider, as when the desired user contact method already exists, terraform will be able to sync it to the state automatically. Otherwise,
+// we need to manually fix the conflicts.
+func (s *UserService) CreateContactMethodContext(ctx context.Context, userID string, contactMethod *ContactMethod) (*ContactMethod, *Response, error) {
+	if err := validateContactMethod(contactMethod); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("/users/%s/contact_methods", userID)
 	v := new(ContactMethodPayload)
 
-	resp, err := s.client.newRequestDo("POST", u, nil, &ContactMethodPayload{ContactMethod: contactMethod}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &ContactMethodPayload{ContactMethod: contactMethod}, &v, WithRoute("/users/{id}/contact_methods"))
 
-	return s.processCreateContactMethodResponse(userID, v, contactMethod, resp, err)
+	return s.processCreateContactMethodResponse(ctx, userID, v, contactMethod, resp, err)
 }
 
-func (s *UserService) processCreateContactMethodResponse(userID string, v *ContactMethodPayload, contactMethod *ContactMethod, resp *Response, err error) (*ContactMethod, *Response, error) {
+func (s *UserService) processCreateContactMethodResponse(ctx context.Context, userID string, v *ContactMethodPayload, contactMethod *ContactMethod, resp *Response, err error) (*ContactMethod, *Response, error) {
 	if err != nil {
-		if e, ok := err.(*Error); !ok || strings.Compare(fmt.Sprintf("%v", e.Errors), "[User Contact method must be unique]") != 0 {
+		if !hasErrorMessage(err, "User Contact method must be unique") {
 			return nil, nil, err
 		}
 
-		sContact, sResp, sErr := s.findExistingContactMethod(userID, contactMethod)
+		sContact, sResp, sErr := s.findExistingContactMethod(ctx, userID, contactMethod)
 		if sErr != nil {
 			return nil, nil, sErr
 		}
@@ -446,30 +753,29 @@ func (s *UserService) processCreateContactMethodResponse(userID string, v *Conta
 	return v.ContactMethod, resp, nil
 }
 
-func (s *UserService) processUpdateContactMethodResponse(userID, contactMethodId string, v *ContactMethodPayload, contactMethod *ContactMethod, resp *Response, err error) (*ContactMethod, *Response, error) {
+func (s *UserService) processUpdateContactMethodResponse(ctx context.Context, userID, contactMethodId string, v *ContactMethodPayload, contactMethod *ContactMethod, resp *Response, err error) (*ContactMethod, *Response, error) {
 	if err != nil {
-		e, ok := err.(*Error)
-		isUniqueContactError := ok && strings.Compare(fmt.Sprintf("%v", e.Errors), "[User Contact method must be unique]") == 0
-		if !ok || !isUniqueContactError {
+		isUniqueContactError := hasErrorMessage(err, "User Contact method must be unique")
+		if !isUniqueContactError {
 			return nil, nil, err
 		}
-		sContact, sResp, sErr := s.findExistingContactMethod(userID, contactMethod)
+		sContact, sResp, sErr := s.findExistingContactMethod(ctx, userID, contactMethod)
 		if sErr != nil {
 			return nil, nil, sErr
 		}
 
 		if isUniqueContactError {
-			_, sErr = s.DeleteContactMethod(userID, sContact.ID)
+			_, sErr = s.DeleteContactMethodContext(ctx, userID, sContact.ID)
 			if sErr != nil {
 				return nil, nil, sErr
 			}
 
-			sResp, sErr = s.updateContactMethodCall(userID, contactMethodId, contactMethod, v)
+			sResp, sErr = s.updateContactMethodCall(ctx, userID, contactMethodId, contactMethod, v)
 			if sErr != nil {
 				return nil, nil, sErr
 			}
 
-			sContact, sResp, sErr = s.findExistingContactMethod(userID, contactMethod)
+			sContact, sResp, sErr = s.findExistingContactMethod(ctx, userID, contactMethod)
 			if sErr != nil {
 				return nil, nil, sErr
 			}
@@ -486,15 +792,15 @@ func (s *UserService) processUpdateContactMethodResponse(userID, contactMethodId
 	return v.ContactMethod, resp, nil
 }
 
-func (s *UserService) findExistingContactMethod(userID string, contactMethod *ContactMethod) (*ContactMethod, *Response, error) {
-	lResp, _, lErr := s.ListContactMethods(userID)
+func (s *UserService) findExistingContactMethod(ctx context.Context, userID string, contactMethod *ContactMethod) (*ContactMethod, *Response, error) {
+	lResp, _, lErr := s.ListContactMethodsContext(ctx, userID)
 	if lErr != nil {
 		return nil, nil, fmt.Errorf("[User Contact method must be unique] but failed to fetch existing ones: %w", lErr)
 	}
 
 	for _, contact := range lResp.ContactMethods {
 		if isSameContactMethod(contact, contactMethod) {
-			return s.GetContactMethod(userID, contact.ID)
+			return s.GetContactMethodContext(ctx, userID, contact.ID)
 		}
 	}
 
@@ -511,6 +817,11 @@ func isSameContactMethod(existingContact, newContact *ContactMethod) bool {
 
 // GetContactMethod retrieves a contact method for a user.
 func (s *UserService) GetContactMethod(userID string, contactMethodID string) (*ContactMethod, *Response, error) {
+	return s.GetContactMethodContext(context.Background(), userID, contactMethodID)
+}
+
+// GetContactMethodContext retrieves a contact method for a user.
+func (s *UserService) GetContactMethodContext(ctx context.Context, userID string, contactMethodID string) (*ContactMethod, *Response, error) {
 	u := fmt.Sprintf("/users/%s/contact_methods/%s", userID, contactMethodID)
 	v := new(ContactMethodPayload)
 
@@ -519,7 +830,7 @@ func (s *UserService) GetContactMethod(userID string, contactMethodID string) (*
 		return cv, nil, nil
 	}
 
-	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/users/{id}/contact_methods/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -529,25 +840,35 @@ func (s *UserService) GetContactMethod(userID string, contactMethodID string) (*
 
 // UpdateContactMethod updates a contact method for a user.
 func (s *UserService) UpdateContactMethod(userID, contactMethodID string, contactMethod *ContactMethod) (*ContactMethod, *Response, error) {
+	return s.UpdateContactMethodContext(context.Background(), userID, contactMethodID, contactMethod)
+}
+
+// UpdateContactMethodContext updates a contact method for a user.
+func (s *UserService) UpdateContactMethodContext(ctx context.Context, userID, contactMethodID string, contactMethod *ContactMethod) (*ContactMethod, *Response, error) {
 	// u := fmt.Sprintf("/users/%s/contact_methods/%s", userID, contactMethodID)
 	v := new(ContactMethodPayload)
 
-	// resp, err := s.client.newRequestDo("PUT", u, nil, &ContactMethodPayload{ContactMethod: contactMethod}, &v)
-	resp, err := s.updateContactMethodCall(userID, contactMethodID, contactMethod, v)
+	// resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &ContactMethodPayload{ContactMethod: contactMethod}, &v, WithRoute("/users/{id}/contact_methods/{id}"))
+	resp, err := s.updateContactMethodCall(ctx, userID, contactMethodID, contactMethod, v)
 
-	return s.processUpdateContactMethodResponse(userID, contactMethodID, v, contactMethod, resp, err)
+	return s.processUpdateContactMethodResponse(ctx, userID, contactMethodID, v, contactMethod, resp, err)
 }
 
-func (s *UserService) updateContactMethodCall(userID, contactMethodID string, contactMethod *ContactMethod, v interface{}) (*Response, error) {
+func (s *UserService) updateContactMethodCall(ctx context.Context, userID, contactMethodID string, contactMethod *ContactMethod, v interface{}) (*Response, error) {
 	u := fmt.Sprintf("/users/%s/contact_methods/%s", userID, contactMethodID)
 
-	return s.client.newRequestDo("PUT", u, nil, &ContactMethodPayload{ContactMethod: contactMethod}, &v)
+	return s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &ContactMethodPayload{ContactMethod: contactMethod}, &v, WithRoute("/users/{id}/contact_methods/{id}"))
 }
 
 // DeleteContactMethod deletes a contact method for a user.
 func (s *UserService) DeleteContactMethod(userID, contactMethodID string) (*Response, error) {
+	return s.DeleteContactMethodContext(context.Background(), userID, contactMethodID)
+}
+
+// DeleteContactMethodContext deletes a contact method for a user.
+func (s *UserService) DeleteContactMethodContext(ctx context.Context, userID, contactMethodID string) (*Response, error) {
 	u := fmt.Sprintf("/users/%s/contact_methods/%s", userID, contactMethodID)
-	resp, err := s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/users/{id}/contact_methods/{id}"))
 
 	if cerr := cacheDeleteContactMethod(contactMethodID); cerr != nil {
 		log.Printf("===== Error deleting contact method %q from cache: %q", contactMethodID, cerr)
@@ -558,12 +879,17 @@ func (s *UserService) DeleteContactMethod(userID, contactMethodID string) (*Resp
 	return resp, err
 }
 
-// ListNotificationRules lists contact methods for a user.
-func (s *UserService) ListNotificationRules(userID string) (*ListNotificationRulesResponse, *Response, error) {
+// ListNotificationRules lists notification rules for a user.
+func (s *UserService) ListNotificationRules(userID string, o *ListNotificationRulesOptions) (*ListNotificationRulesResponse, *Response, error) {
+	return s.ListNotificationRulesContext(context.Background(), userID, o)
+}
+
+// ListNotificationRulesContext lists notification rules for a user.
+func (s *UserService) ListNotificationRulesContext(ctx context.Context, userID string, o *ListNotificationRulesOptions) (*ListNotificationRulesResponse, *Response, error) {
 	u := fmt.Sprintf("/users/%s/notification_rules", userID)
 	v := new(ListNotificationRulesResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/users/{id}/notification_rules"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -571,21 +897,36 @@ func (s *UserService) ListNotificationRules(userID string) (*ListNotificationRul
 	return v, resp, nil
 }
 
-// CreateNotificationRule creates a new notification rule for a user.
+// CreateNotificationRule creates a new notification rule for a user, see
+// NewNotificationRule to build one from a contact method ID. The rule needs
+// a contact method and an urgency, NotificationRuleUrgencyHigh or
+// NotificationRuleUrgencyLow.
 func (s *UserService) CreateNotificationRule(userID string, rule *NotificationRule) (*NotificationRule, *Response, error) {
+	return s.CreateNotificationRuleContext(context.Background(), userID, rule)
+}
+
+// CreateNotificationRuleContext creates a new notification rule for a user,
+// see NewNotificationRule to build one from a contact method ID. The rule
+// needs a contact method and an urgency, NotificationRuleUrgencyHigh or
+// NotificationRuleUrgencyLow.
+func (s *UserService) CreateNotificationRuleContext(ctx context.Context, userID string, rule *NotificationRule) (*NotificationRule, *Response, error) {
+	if err := validateNotificationRule(rule); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("/users/%s/notification_rules", userID)
 	v := new(NotificationRulePayload)
 
-	resp, err := s.client.newRequestDo("POST", u, nil, &NotificationRulePayload{NotificationRule: rule}, &v)
-	return s.processNotificationRule(userID, v, rule, resp, err)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &NotificationRulePayload{NotificationRule: rule}, &v, WithRoute("/users/{id}/notification_rules"))
+	return s.processNotificationRule(ctx, userID, v, rule, resp, err)
 }
-func (s *UserService) processNotificationRule(userID string, v *NotificationRulePayload, rule *NotificationRule, resp *Response, err error) (*NotificationRule, *Response, error) {
+func (s *UserService) processNotificationRule(ctx context.Context, userID string, v *NotificationRulePayload, rule *NotificationRule, resp *Response, err error) (*NotificationRule, *Response, error) {
 	if err != nil {
-		if e, ok := err.(*Error); !ok || strings.Compare(fmt.Sprintf("%v", e.Errors), "[Channel Start delay must be unique for a given contact method]") != 0 {
+		if !hasErrorMessage(err, "Channel Start delay must be unique for a given contact method") {
 			return nil, nil, err
 		}
 
-		sRule, sResp, sErr := s.findExistingNotificationRule(userID, rule)
+		sRule, sResp, sErr := s.findExistingNotificationRule(ctx, userID, rule)
 		if sErr != nil {
 			return nil, nil, sErr
 		}
@@ -601,15 +942,15 @@ func (s *UserService) processNotificationRule(userID string, v *NotificationRule
 	return v.NotificationRule, resp, nil
 }
 
-func (s *UserService) findExistingNotificationRule(userID string, rule *NotificationRule) (*NotificationRule, *Response, error) {
-	lResp, _, lErr := s.ListNotificationRules(userID)
+func (s *UserService) findExistingNotificationRule(ctx context.Context, userID string, rule *NotificationRule) (*NotificationRule, *Response, error) {
+	lResp, _, lErr := s.ListNotificationRulesContext(ctx, userID, nil)
 	if lErr != nil {
 		return nil, nil, fmt.Errorf("[Channel Start delay must be unique for a given contact method]. Failed to fetch existing rules: %w", lErr)
 	}
 
 	for _, nr := range lResp.NotificationRules {
 		if isSameNotificationRule(nr, rule) {
-			return s.GetNotificationRule(userID, nr.ID)
+			return s.GetNotificationRuleContext(ctx, userID, nr.ID)
 		}
 	}
 
@@ -625,6 +966,11 @@ func isSameNotificationRule(existingRule, newRule *NotificationRule) bool {
 
 // GetNotificationRule retrieves a notification rule for a user.
 func (s *UserService) GetNotificationRule(userID string, ruleID string) (*NotificationRule, *Response, error) {
+	return s.GetNotificationRuleContext(context.Background(), userID, ruleID)
+}
+
+// GetNotificationRuleContext retrieves a notification rule for a user.
+func (s *UserService) GetNotificationRuleContext(ctx context.Context, userID string, ruleID string) (*NotificationRule, *Response, error) {
 	u := fmt.Sprintf("/users/%s/notification_rules/%s", userID, ruleID)
 	v := new(NotificationRulePayload)
 
@@ -633,7 +979,7 @@ func (s *UserService) GetNotificationRule(userID string, ruleID string) (*Notifi
 		return cv, nil, nil
 	}
 
-	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/users/{id}/notification_rules/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -643,21 +989,35 @@ func (s *UserService) GetNotificationRule(userID string, ruleID string) (*Notifi
 
 // UpdateNotificationRule updates a notification rulefor a user.
 func (s *UserService) UpdateNotificationRule(userID, ruleID string, rule *NotificationRule) (*NotificationRule, *Response, error) {
+	return s.UpdateNotificationRuleContext(context.Background(), userID, ruleID, rule)
+}
+
+// UpdateNotificationRuleContext updates a notification rulefor a user.
+func (s *UserService) UpdateNotificationRuleContext(ctx context.Context, userID, ruleID string, rule *NotificationRule) (*NotificationRule, *Response, error) {
 	u := fmt.Sprintf("/users/%s/notification_rules/%s", userID, ruleID)
 	v := new(NotificationRulePayload)
 
-	resp, err := s.client.newRequestDo("PUT", u, nil, &NotificationRulePayload{NotificationRule: rule}, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &NotificationRulePayload{NotificationRule: rule}, &v, WithRoute("/users/{id}/notification_rules/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return s.processNotificationRule(userID, v, rule, resp, err)
+	return s.processNotificationRule(ctx, userID, v, rule, resp, err)
 }
 
-// DeleteNotificationRule deletes a notification rule for a user.
+// DeleteNotificationRule deletes a notification rule for a user. PagerDuty
+// refuses to delete the last rule of an urgency, the returned *APIError
+// holds the reason in its Errors field.
 func (s *UserService) DeleteNotificationRule(userID, ruleID string) (*Response, error) {
+	return s.DeleteNotificationRuleContext(context.Background(), userID, ruleID)
+}
+
+// DeleteNotificationRuleContext deletes a notification rule for a user.
+// PagerDuty refuses to delete the last rule of an urgency, the returned
+// *APIError holds the reason in its Errors field.
+func (s *UserService) DeleteNotificationRuleContext(ctx context.Context, userID, ruleID string) (*Response, error) {
 	u := fmt.Sprintf("/users/%s/notification_rules/%s", userID, ruleID)
-	resp, err := s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/users/{id}/notification_rules/{id}"))
 
 	if cerr := cacheDeleteNotificationRule(ruleID); cerr != nil {
 		log.Printf("===== Error deleting notification rule %q from cache: %q", ruleID, cerr)
@@ -667,3 +1027,78 @@ func (s *UserService) DeleteNotificationRule(userID, ruleID string) (*Response,
 
 	return resp, err
 }
+
+type listUsersOptionsGen struct {
+	options *ListUsersOptions
+}
+
+func (o *listUsersOptionsGen) currentOffset() int {
+	return o.options.Offset
+}
+
+func (o *listUsersOptionsGen) changeOffset(i int) {
+	o.options.Offset = i
+}
+
+func (o *listUsersOptionsGen) buildStruct() interface{} {
+	return o.options
+}
+
+// ListPages lists existing users, calling fn with every page of results until
+// there are no more pages or fn returns an error.
+func (s *UserService) ListPages(o *ListUsersOptions, fn func([]*User) error) error {
+	return s.ListPagesContext(context.Background(), o, fn)
+}
+
+// ListPagesContext lists existing users, calling fn with every page of results until
+// there are no more pages or fn returns an error.
+func (s *UserService) ListPagesContext(ctx context.Context, o *ListUsersOptions, fn func([]*User) error) error {
+	opts := ListUsersOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	responseHandler := func(response *Response) (PaginationMeta, *Response, error) {
+		var result ListUsersResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return PaginationMeta{}, response, err
+		}
+
+		if err := fn(result.Users); err != nil {
+			return PaginationMeta{}, response, err
+		}
+
+		return PaginationMeta{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+
+	return s.client.newRequestPagedGetQueryDoContext(ctx, "/users", responseHandler, &listUsersOptionsGen{options: &opts}, WithRoute("/users"))
+}
+
+// Iter returns an Iterator over existing users, which requests pages of
+// results as they are needed.
+func (s *UserService) Iter(o *ListUsersOptions) *Iterator[*User] {
+	return s.IterContext(context.Background(), o)
+}
+
+// IterContext returns an Iterator over existing users, which requests pages
+// of results as they are needed.
+func (s *UserService) IterContext(ctx context.Context, o *ListUsersOptions) *Iterator[*User] {
+	opts := ListUsersOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	return Iterate(opts.ListOptions, func(lo ListOptions) ([]*User, PaginationMeta, *Response, error) {
+		opts.ListOptions = lo
+		v, resp, err := s.ListContext(ctx, &opts)
+		if err != nil {
+			return nil, PaginationMeta{}, resp, err
+		}
+		return v.Users, v.PaginationMeta, resp, nil
+	})
+}
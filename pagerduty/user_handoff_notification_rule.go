@@ -0,0 +1,156 @@
+package pagerduty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Handoffs a handoff notification rule notifies about.
+const (
+	HandoffTypeBoth    = "both"
+	HandoffTypeOnCall  = "oncall"
+	HandoffTypeOffCall = "offcall"
+)
+
+// HandoffNotificationRule represents a rule notifying a user ahead of going
+// on or off call. HandoffType is one of the HandoffType constants.
+type HandoffNotificationRule struct {
+	ID                     string                  `json:"id,omitempty"`
+	Type                   string                  `json:"type,omitempty"`
+	HandoffType            string                  `json:"handoff_type,omitempty"`
+	NotifyAdvanceInMinutes int                     `json:"notify_advance_in_minutes"`
+	ContactMethod          *ContactMethodReference `json:"contact_method,omitempty"`
+}
+
+// HandoffNotificationRulePayload represents payload with a handoff
+// notification rule object.
+type HandoffNotificationRulePayload struct {
+	HandoffNotificationRule *HandoffNotificationRule `json:"oncall_handoff_notification_rule,omitempty"`
+}
+
+// ListHandoffNotificationRulesResponse represents a list response of handoff
+// notification rules.
+type ListHandoffNotificationRulesResponse struct {
+	HandoffNotificationRules []*HandoffNotificationRule `json:"oncall_handoff_notification_rules,omitempty"`
+}
+
+// validateHandoffNotificationRule checks that a handoff notification rule
+// references a contact method and has a supported handoff type.
+func validateHandoffNotificationRule(rule *HandoffNotificationRule) error {
+	if rule == nil {
+		return errors.New("a handoff notification rule is required")
+	}
+	if rule.ContactMethod == nil || rule.ContactMethod.ID == "" {
+		return errors.New("a handoff notification rule requires a contact method")
+	}
+	switch rule.HandoffType {
+	case HandoffTypeBoth, HandoffTypeOnCall, HandoffTypeOffCall:
+	default:
+		return fmt.Errorf("unsupported handoff type %q", rule.HandoffType)
+	}
+	if rule.NotifyAdvanceInMinutes < 0 {
+		return fmt.Errorf("invalid handoff notification advance %d", rule.NotifyAdvanceInMinutes)
+	}
+	return nil
+}
+
+// ListHandoffNotificationRules lists handoff notification rules for a user.
+func (s *UserService) ListHandoffNotificationRules(userID string) (*ListHandoffNotificationRulesResponse, *Response, error) {
+	return s.ListHandoffNotificationRulesContext(context.Background(), userID)
+}
+
+// ListHandoffNotificationRulesContext lists handoff notification rules for a
+// user.
+func (s *UserService) ListHandoffNotificationRulesContext(ctx context.Context, userID string) (*ListHandoffNotificationRulesResponse, *Response, error) {
+	u := fmt.Sprintf("/users/%s/oncall_handoff_notification_rules", userID)
+	v := new(ListHandoffNotificationRulesResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/users/{id}/oncall_handoff_notification_rules"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// GetHandoffNotificationRule retrieves a handoff notification rule for a
+// user.
+func (s *UserService) GetHandoffNotificationRule(userID, ruleID string) (*HandoffNotificationRule, *Response, error) {
+	return s.GetHandoffNotificationRuleContext(context.Background(), userID, ruleID)
+}
+
+// GetHandoffNotificationRuleContext retrieves a handoff notification rule
+// for a user.
+func (s *UserService) GetHandoffNotificationRuleContext(ctx context.Context, userID, ruleID string) (*HandoffNotificationRule, *Response, error) {
+	u := fmt.Sprintf("/users/%s/oncall_handoff_notification_rules/%s", userID, ruleID)
+	v := new(HandoffNotificationRulePayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/users/{id}/oncall_handoff_notification_rules/{id}"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.HandoffNotificationRule, resp, nil
+}
+
+// CreateHandoffNotificationRule creates a new handoff notification rule for
+// a user. The rule needs a contact method and a handoff type.
+func (s *UserService) CreateHandoffNotificationRule(userID string, rule *HandoffNotificationRule) (*HandoffNotificationRule, *Response, error) {
+	return s.CreateHandoffNotificationRuleContext(context.Background(), userID, rule)
+}
+
+// CreateHandoffNotificationRuleContext creates a new handoff notification
+// rule for a user. The rule needs a contact method and a handoff type.
+func (s *UserService) CreateHandoffNotificationRuleContext(ctx context.Context, userID string, rule *HandoffNotificationRule) (*HandoffNotificationRule, *Response, error) {
+	if err := validateHandoffNotificationRule(rule); err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("/users/%s/oncall_handoff_notification_rules", userID)
+	v := new(HandoffNotificationRulePayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &HandoffNotificationRulePayload{HandoffNotificationRule: rule}, &v, WithRoute("/users/{id}/oncall_handoff_notification_rules"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.HandoffNotificationRule, resp, nil
+}
+
+// UpdateHandoffNotificationRule updates a handoff notification rule for a
+// user.
+func (s *UserService) UpdateHandoffNotificationRule(userID, ruleID string, rule *HandoffNotificationRule) (*HandoffNotificationRule, *Response, error) {
+	return s.UpdateHandoffNotificationRuleContext(context.Background(), userID, ruleID, rule)
+}
+
+// UpdateHandoffNotificationRuleContext updates a handoff notification rule
+// for a user.
+func (s *UserService) UpdateHandoffNotificationRuleContext(ctx context.Context, userID, ruleID string, rule *HandoffNotificationRule) (*HandoffNotificationRule, *Response, error) {
+	if err := validateHandoffNotificationRule(rule); err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("/users/%s/oncall_handoff_notification_rules/%s", userID, ruleID)
+	v := new(HandoffNotificationRulePayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &HandoffNotificationRulePayload{HandoffNotificationRule: rule}, &v, WithRoute("/users/{id}/oncall_handoff_notification_rules/{id}"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.HandoffNotificationRule, resp, nil
+}
+
+// DeleteHandoffNotificationRule deletes a handoff notification rule for a
+// user.
+func (s *UserService) DeleteHandoffNotificationRule(userID, ruleID string) (*Response, error) {
+	return s.DeleteHandoffNotificationRuleContext(context.Background(), userID, ruleID)
+}
+
+// DeleteHandoffNotificationRuleContext deletes a handoff notification rule
+// for a user.
+func (s *UserService) DeleteHandoffNotificationRuleContext(ctx context.Context, userID, ruleID string) (*Response, error) {
+	u := fmt.Sprintf("/users/%s/oncall_handoff_notification_rules/%s", userID, ruleID)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/users/{id}/oncall_handoff_notification_rules/{id}"))
+}
@@ -0,0 +1,125 @@
+package pagerduty
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestUsersListHandoffNotificationRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/oncall_handoff_notification_rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"oncall_handoff_notification_rules": [{"id": "h1", "handoff_type": "both", "notify_advance_in_minutes": 30, "contact_method": {"id": "c1", "type": "sms_contact_method_reference"}}]}`))
+	})
+
+	resp, _, err := client.Users.ListHandoffNotificationRules("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListHandoffNotificationRulesResponse{
+		HandoffNotificationRules: []*HandoffNotificationRule{
+			{
+				ID:                     "h1",
+				HandoffType:            HandoffTypeBoth,
+				NotifyAdvanceInMinutes: 30,
+				ContactMethod:          &ContactMethodReference{ID: "c1", Type: "sms_contact_method_reference"},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestUsersHandoffNotificationRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	const body = `{"oncall_handoff_notification_rule":{"handoff_type":"oncall","notify_advance_in_minutes":30,"contact_method":{"id":"c1","type":"sms_contact_method_reference"}}}`
+	const response = `{"oncall_handoff_notification_rule": {"id": "h1", "handoff_type": "oncall", "notify_advance_in_minutes": 30, "contact_method": {"id": "c1", "type": "sms_contact_method_reference"}}}`
+
+	mux.HandleFunc("/users/1/oncall_handoff_notification_rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, body)
+		w.Write([]byte(response))
+	})
+	mux.HandleFunc("/users/1/oncall_handoff_notification_rules/h1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.Write([]byte(response))
+		case "PUT":
+			testBody(t, r, body)
+			w.Write([]byte(response))
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	input := &HandoffNotificationRule{
+		HandoffType:            HandoffTypeOnCall,
+		NotifyAdvanceInMinutes: 30,
+		ContactMethod:          &ContactMethodReference{ID: "c1", Type: "sms_contact_method_reference"},
+	}
+	want := &HandoffNotificationRule{
+		ID:                     "h1",
+		HandoffType:            HandoffTypeOnCall,
+		NotifyAdvanceInMinutes: 30,
+		ContactMethod:          &ContactMethodReference{ID: "c1", Type: "sms_contact_method_reference"},
+	}
+
+	created, _, err := client.Users.CreateHandoffNotificationRule("1", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(created, want) {
+		t.Errorf("created %#v; want %#v", created, want)
+	}
+
+	got, _, err := client.Users.GetHandoffNotificationRule("1", "h1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v; want %#v", got, want)
+	}
+
+	updated, _, err := client.Users.UpdateHandoffNotificationRule("1", "h1", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(updated, want) {
+		t.Errorf("updated %#v; want %#v", updated, want)
+	}
+
+	if _, err := client.Users.DeleteHandoffNotificationRule("1", "h1"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUsersCreateHandoffNotificationRuleInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/oncall_handoff_notification_rules", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request for an invalid handoff notification rule")
+	})
+
+	contactMethod := &ContactMethodReference{ID: "c1", Type: "sms_contact_method_reference"}
+	for _, rule := range []*HandoffNotificationRule{
+		nil,
+		{HandoffType: HandoffTypeBoth},
+		{HandoffType: "sometimes", ContactMethod: contactMethod},
+		{HandoffType: HandoffTypeBoth, NotifyAdvanceInMinutes: -5, ContactMethod: contactMethod},
+	} {
+		if _, _, err := client.Users.CreateHandoffNotificationRule("1", rule); err == nil {
+			t.Errorf("expected an error creating %#v", rule)
+		}
+	}
+}
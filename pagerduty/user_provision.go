@@ -0,0 +1,181 @@
+package pagerduty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+)
+
+// provisioningRollbackTimeout limits how long UserService.Provision spends
+// deleting the objects it created after a failed step.
+const provisioningRollbackTimeout = 30 * time.Second
+
+// ProvisioningSpec represents the contact methods and notification rules to
+// create for a new responder with UserService.Provision.
+type ProvisioningSpec struct {
+	ContactMethods    []*ContactMethod
+	NotificationRules []*ProvisioningNotificationRule
+}
+
+// ProvisioningNotificationRule represents a notification rule of a
+// ProvisioningSpec. ContactMethod is the index in the ContactMethods of the
+// spec of the contact method to notify, whose ID is not known beforehand.
+type ProvisioningNotificationRule struct {
+	ContactMethod       int
+	Urgency             string
+	StartDelayInMinutes int
+}
+
+// ProvisioningResult represents the contact methods and notification rules
+// created by UserService.Provision, in the order of the spec.
+type ProvisioningResult struct {
+	ContactMethods    []*ContactMethod
+	NotificationRules []*NotificationRule
+}
+
+// ProvisioningError is returned by UserService.Provision when a step failed.
+// Created holds what was created before the failure and NotCreated the
+// remaining entries of the spec, starting with the failed one. The created
+// objects are deleted again, NotRolledBack holds those that could not be and
+// still exist. Err is the error of the failed step.
+type ProvisioningError struct {
+	UserID        string
+	Created       *ProvisioningResult
+	NotCreated    *ProvisioningSpec
+	NotRolledBack *ProvisioningResult
+	Err           error
+}
+
+func (e *ProvisioningError) Error() string {
+	msg := fmt.Sprintf("provisioning user %s failed with %d contact methods and %d notification rules not created: %v",
+		e.UserID, len(e.NotCreated.ContactMethods), len(e.NotCreated.NotificationRules), e.Err)
+
+	if n := len(e.NotRolledBack.ContactMethods) + len(e.NotRolledBack.NotificationRules); n > 0 {
+		msg = fmt.Sprintf("%s, %d created objects could not be rolled back", msg, n)
+	}
+	return msg
+}
+
+// Unwrap returns the error of the failed step.
+func (e *ProvisioningError) Unwrap() error {
+	return e.Err
+}
+
+func validateProvisioningSpec(spec *ProvisioningSpec) error {
+	if spec == nil {
+		return errors.New("a provisioning spec is required")
+	}
+	for _, c := range spec.ContactMethods {
+		if err := validateContactMethod(c); err != nil {
+			return err
+		}
+	}
+	for _, r := range spec.NotificationRules {
+		if r == nil {
+			return errors.New("a notification rule is required")
+		}
+		if r.ContactMethod < 0 || r.ContactMethod >= len(spec.ContactMethods) {
+			return fmt.Errorf("notification rule references contact method %d of %d", r.ContactMethod, len(spec.ContactMethods))
+		}
+		// The contact method is not created yet, its index stands in for its ID.
+		c := spec.ContactMethods[r.ContactMethod]
+		if err := validateNotificationRule(NewNotificationRule(strconv.Itoa(r.ContactMethod), c.Type, r.Urgency, r.StartDelayInMinutes)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Provision creates the contact methods of spec for a user, then the
+// notification rules referencing them. If a step fails, the objects created
+// so far are deleted again and the error is a *ProvisioningError. Unlike
+// CreateContactMethod and CreateNotificationRule, existing contact methods
+// and rules are not reused, so that a rollback only deletes objects created
+// by this call.
+func (s *UserService) Provision(userID string, spec *ProvisioningSpec) (*ProvisioningResult, error) {
+	return s.ProvisionContext(context.Background(), userID, spec)
+}
+
+// ProvisionContext creates the contact methods of spec for a user, then the
+// notification rules referencing them. If a step fails, the objects created
+// so far are deleted again and the error is a *ProvisioningError. Unlike
+// CreateContactMethod and CreateNotificationRule, existing contact methods
+// and rules are not reused, so that a rollback only deletes objects created
+// by this call.
+func (s *UserService) ProvisionContext(ctx context.Context, userID string, spec *ProvisioningSpec) (*ProvisioningResult, error) {
+	if userID == "" {
+		return nil, errors.New("a user ID is required to provision a user")
+	}
+	if err := validateProvisioningSpec(spec); err != nil {
+		return nil, err
+	}
+
+	result := &ProvisioningResult{}
+
+	for i, c := range spec.ContactMethods {
+		u := fmt.Sprintf("/users/%s/contact_methods", userID)
+		v := new(ContactMethodPayload)
+
+		if _, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &ContactMethodPayload{ContactMethod: c}, &v, WithRoute("/users/{id}/contact_methods")); err != nil {
+			return nil, s.rollbackProvisioning(ctx, userID, result, &ProvisioningSpec{
+				ContactMethods:    spec.ContactMethods[i:],
+				NotificationRules: spec.NotificationRules,
+			}, err)
+		}
+		result.ContactMethods = append(result.ContactMethods, v.ContactMethod)
+	}
+
+	for i, r := range spec.NotificationRules {
+		contactMethodID := result.ContactMethods[r.ContactMethod].ID
+		rule := NewNotificationRule(contactMethodID, spec.ContactMethods[r.ContactMethod].Type, r.Urgency, r.StartDelayInMinutes)
+
+		u := fmt.Sprintf("/users/%s/notification_rules", userID)
+		v := new(NotificationRulePayload)
+
+		if _, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &NotificationRulePayload{NotificationRule: rule}, &v, WithRoute("/users/{id}/notification_rules")); err != nil {
+			return nil, s.rollbackProvisioning(ctx, userID, result, &ProvisioningSpec{
+				NotificationRules: spec.NotificationRules[i:],
+			}, err)
+		}
+		result.NotificationRules = append(result.NotificationRules, v.NotificationRule)
+	}
+
+	return result, nil
+}
+
+// rollbackProvisioning deletes the objects created by ProvisionContext,
+// notification rules first, and returns the *ProvisioningError of the
+// failed step. The deletions are not canceled with ctx, so that they still
+// happen when the failure was a cancellation, but are bounded by
+// provisioningRollbackTimeout.
+func (s *UserService) rollbackProvisioning(ctx context.Context, userID string, created *ProvisioningResult, notCreated *ProvisioningSpec, err error) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), provisioningRollbackTimeout)
+	defer cancel()
+	notRolledBack := &ProvisioningResult{}
+
+	for i := len(created.NotificationRules) - 1; i >= 0; i-- {
+		r := created.NotificationRules[i]
+		if _, dErr := s.DeleteNotificationRuleContext(ctx, userID, r.ID); dErr != nil {
+			log.Printf("===== Error rolling back notification rule %q of user %q: %q", r.ID, userID, dErr)
+			notRolledBack.NotificationRules = append([]*NotificationRule{r}, notRolledBack.NotificationRules...)
+		}
+	}
+	for i := len(created.ContactMethods) - 1; i >= 0; i-- {
+		c := created.ContactMethods[i]
+		if _, dErr := s.DeleteContactMethodContext(ctx, userID, c.ID); dErr != nil {
+			log.Printf("===== Error rolling back contact method %q of user %q: %q", c.ID, userID, dErr)
+			notRolledBack.ContactMethods = append([]*ContactMethod{c}, notRolledBack.ContactMethods...)
+		}
+	}
+
+	return &ProvisioningError{
+		UserID:        userID,
+		Created:       created,
+		NotCreated:    notCreated,
+		NotRolledBack: notRolledBack,
+		Err:           err,
+	}
+}
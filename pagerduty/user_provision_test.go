@@ -0,0 +1,221 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+var testProvisioningSpec = &ProvisioningSpec{
+	ContactMethods: []*ContactMethod{
+		{Type: ContactMethodTypeEmail, Address: "foo@bar.com"},
+		{Type: ContactMethodTypePhone, Address: "5555555555", CountryCode: 1},
+		{Type: ContactMethodTypeSMS, Address: "5555555555", CountryCode: 1},
+	},
+	NotificationRules: []*ProvisioningNotificationRule{
+		{ContactMethod: 2, Urgency: NotificationRuleUrgencyHigh},
+		{ContactMethod: 1, Urgency: NotificationRuleUrgencyHigh, StartDelayInMinutes: 5},
+		{ContactMethod: 0, Urgency: NotificationRuleUrgencyLow},
+	},
+}
+
+// handleProvisioning serves the create and delete endpoints of contact
+// methods and notification rules for user 1, failing the creation of the
+// notification rule numbered failRule, counted from 1, and the deletion of
+// the ones in failDelete. It returns the paths of the deletions.
+func handleProvisioning(t *testing.T, failRule int, failDelete ...string) *[]string {
+	var contactMethods, rules int
+	deleted := &[]string{}
+
+	mux.HandleFunc("/users/1/contact_methods", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		v := new(ContactMethodPayload)
+		json.NewDecoder(r.Body).Decode(v)
+
+		contactMethods++
+		v.ContactMethod.ID = fmt.Sprintf("c%d", contactMethods)
+		json.NewEncoder(w).Encode(v)
+	})
+	mux.HandleFunc("/users/1/notification_rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		v := new(NotificationRulePayload)
+		json.NewDecoder(r.Body).Decode(v)
+
+		rules++
+		if rules == failRule {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": {"code": 2001, "message": "Invalid Input Provided"}}`))
+			return
+		}
+		v.NotificationRule.ID = fmt.Sprintf("n%d", rules)
+		json.NewEncoder(w).Encode(v)
+	})
+
+	handleDelete := func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		*deleted = append(*deleted, r.URL.Path)
+		for _, p := range failDelete {
+			if r.URL.Path == p {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+	mux.HandleFunc("/users/1/contact_methods/", handleDelete)
+	mux.HandleFunc("/users/1/notification_rules/", handleDelete)
+
+	return deleted
+}
+
+func TestUsersProvision(t *testing.T) {
+	setup()
+	defer teardown()
+
+	deleted := handleProvisioning(t, 0)
+
+	resp, err := client.Users.Provision("1", testProvisioningSpec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ProvisioningResult{
+		ContactMethods: []*ContactMethod{
+			{ID: "c1", Type: ContactMethodTypeEmail, Address: "foo@bar.com"},
+			{ID: "c2", Type: ContactMethodTypePhone, Address: "5555555555", CountryCode: 1},
+			{ID: "c3", Type: ContactMethodTypeSMS, Address: "5555555555", CountryCode: 1},
+		},
+		NotificationRules: []*NotificationRule{
+			{ID: "n1", Type: "assignment_notification_rule", Urgency: "high", ContactMethod: &ContactMethodReference{ID: "c3", Type: "sms_contact_method_reference"}},
+			{ID: "n2", Type: "assignment_notification_rule", Urgency: "high", StartDelayInMinutes: 5, ContactMethod: &ContactMethodReference{ID: "c2", Type: "phone_contact_method_reference"}},
+			{ID: "n3", Type: "assignment_notification_rule", Urgency: "low", ContactMethod: &ContactMethodReference{ID: "c1", Type: "email_contact_method_reference"}},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+	if len(*deleted) != 0 {
+		t.Errorf("deleted %v, want nothing", *deleted)
+	}
+}
+
+func TestUsersProvisionRollback(t *testing.T) {
+	setup()
+	defer teardown()
+
+	deleted := handleProvisioning(t, 2)
+
+	_, err := client.Users.Provision("1", testProvisioningSpec)
+
+	var provisioningErr *ProvisioningError
+	if !errors.As(err, &provisioningErr) {
+		t.Fatalf("got error %v, want a *ProvisioningError", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("got error %v, want the error of the failed step", err)
+	}
+
+	if len(provisioningErr.Created.ContactMethods) != 3 || len(provisioningErr.Created.NotificationRules) != 1 {
+		t.Errorf("Created = %#v, want 3 contact methods and 1 notification rule", provisioningErr.Created)
+	}
+	if want := testProvisioningSpec.NotificationRules[1:]; len(provisioningErr.NotCreated.ContactMethods) != 0 || !reflect.DeepEqual(provisioningErr.NotCreated.NotificationRules, want) {
+		t.Errorf("NotCreated = %#v, want the last 2 notification rules", provisioningErr.NotCreated)
+	}
+	if want := (&ProvisioningResult{}); !reflect.DeepEqual(provisioningErr.NotRolledBack, want) {
+		t.Errorf("NotRolledBack = %#v, want %#v", provisioningErr.NotRolledBack, want)
+	}
+
+	want := []string{
+		"/users/1/notification_rules/n1",
+		"/users/1/contact_methods/c3",
+		"/users/1/contact_methods/c2",
+		"/users/1/contact_methods/c1",
+	}
+	if !reflect.DeepEqual(*deleted, want) {
+		t.Errorf("deleted %v, want %v", *deleted, want)
+	}
+}
+
+func TestUsersProvisionRollbackFailure(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handleProvisioning(t, 1, "/users/1/contact_methods/c2")
+
+	_, err := client.Users.Provision("1", testProvisioningSpec)
+
+	var provisioningErr *ProvisioningError
+	if !errors.As(err, &provisioningErr) {
+		t.Fatalf("got error %v, want a *ProvisioningError", err)
+	}
+
+	want := &ProvisioningResult{
+		ContactMethods: []*ContactMethod{{ID: "c2", Type: ContactMethodTypePhone, Address: "5555555555", CountryCode: 1}},
+	}
+	if !reflect.DeepEqual(provisioningErr.NotRolledBack, want) {
+		t.Errorf("NotRolledBack = %#v, want %#v", provisioningErr.NotRolledBack, want)
+	}
+}
+
+func TestUsersProvisionRollbackCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var contactMethods int
+	mux.HandleFunc("/users/1/contact_methods", func(w http.ResponseWriter, r *http.Request) {
+		contactMethods++
+		if contactMethods == 2 {
+			// The body is read for the server to notice the cancellation.
+			io.ReadAll(r.Body)
+			cancel()
+			<-r.Context().Done()
+			return
+		}
+		w.Write([]byte(`{"contact_method": {"id": "c1", "type": "email_contact_method"}}`))
+	})
+	var deleted []string
+	mux.HandleFunc("/users/1/contact_methods/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		deleted = append(deleted, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Users.ProvisionContext(ctx, "1", testProvisioningSpec)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want %v", err, context.Canceled)
+	}
+	if want := []string{"/users/1/contact_methods/c1"}; !reflect.DeepEqual(deleted, want) {
+		t.Errorf("deleted %v, want %v", deleted, want)
+	}
+}
+
+func TestUsersProvisionInvalidSpec(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s for an invalid spec", r.Method, r.URL.Path)
+	})
+
+	for _, spec := range []*ProvisioningSpec{
+		nil,
+		{ContactMethods: []*ContactMethod{{Type: "pager_contact_method"}}},
+		{NotificationRules: []*ProvisioningNotificationRule{{ContactMethod: 0, Urgency: NotificationRuleUrgencyHigh}}},
+		{ContactMethods: testProvisioningSpec.ContactMethods, NotificationRules: []*ProvisioningNotificationRule{{ContactMethod: 0, Urgency: "medium"}}},
+	} {
+		if _, err := client.Users.Provision("1", spec); err == nil {
+			t.Errorf("expected an error provisioning %#v", spec)
+		}
+	}
+}
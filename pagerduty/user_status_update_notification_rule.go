@@ -0,0 +1,146 @@
+package pagerduty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// StatusUpdateNotificationRule represents a rule notifying a user of the
+// status updates of the incidents they subscribed to, through the given
+// contact method.
+type StatusUpdateNotificationRule struct {
+	ID            string                  `json:"id,omitempty"`
+	Type          string                  `json:"type,omitempty"`
+	Self          string                  `json:"self,omitempty"`
+	HTMLURL       string                  `json:"html_url,omitempty"`
+	ContactMethod *ContactMethodReference `json:"contact_method,omitempty"`
+}
+
+// StatusUpdateNotificationRulePayload represents payload with a status
+// update notification rule object.
+type StatusUpdateNotificationRulePayload struct {
+	StatusUpdateNotificationRule *StatusUpdateNotificationRule `json:"status_update_notification_rule,omitempty"`
+}
+
+// ListStatusUpdateNotificationRulesOptions represents options when listing
+// status update notification rules. Include "contact_methods" expands the
+// contact methods of the rules.
+type ListStatusUpdateNotificationRulesOptions struct {
+	Include []string `url:"include,omitempty,brackets"`
+}
+
+// ListStatusUpdateNotificationRulesResponse represents a list response of
+// status update notification rules.
+type ListStatusUpdateNotificationRulesResponse struct {
+	PaginationMeta
+	StatusUpdateNotificationRules []*StatusUpdateNotificationRule `json:"status_update_notification_rules,omitempty"`
+}
+
+func validateStatusUpdateNotificationRule(rule *StatusUpdateNotificationRule) error {
+	if rule == nil || rule.ContactMethod == nil || rule.ContactMethod.ID == "" {
+		return errors.New("a status update notification rule requires a contact method")
+	}
+	return nil
+}
+
+// ListStatusUpdateNotificationRules lists status update notification rules
+// for a user.
+func (s *UserService) ListStatusUpdateNotificationRules(userID string, o *ListStatusUpdateNotificationRulesOptions) (*ListStatusUpdateNotificationRulesResponse, *Response, error) {
+	return s.ListStatusUpdateNotificationRulesContext(context.Background(), userID, o)
+}
+
+// ListStatusUpdateNotificationRulesContext lists status update notification
+// rules for a user.
+func (s *UserService) ListStatusUpdateNotificationRulesContext(ctx context.Context, userID string, o *ListStatusUpdateNotificationRulesOptions) (*ListStatusUpdateNotificationRulesResponse, *Response, error) {
+	u := fmt.Sprintf("/users/%s/status_update_notification_rules", userID)
+	v := new(ListStatusUpdateNotificationRulesResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, WithRoute("/users/{id}/status_update_notification_rules"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// GetStatusUpdateNotificationRule retrieves a status update notification
+// rule for a user.
+func (s *UserService) GetStatusUpdateNotificationRule(userID, ruleID string) (*StatusUpdateNotificationRule, *Response, error) {
+	return s.GetStatusUpdateNotificationRuleContext(context.Background(), userID, ruleID)
+}
+
+// GetStatusUpdateNotificationRuleContext retrieves a status update
+// notification rule for a user.
+func (s *UserService) GetStatusUpdateNotificationRuleContext(ctx context.Context, userID, ruleID string) (*StatusUpdateNotificationRule, *Response, error) {
+	u := fmt.Sprintf("/users/%s/status_update_notification_rules/%s", userID, ruleID)
+	v := new(StatusUpdateNotificationRulePayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/users/{id}/status_update_notification_rules/{id}"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.StatusUpdateNotificationRule, resp, nil
+}
+
+// CreateStatusUpdateNotificationRule creates a new status update
+// notification rule for a user. The rule needs a contact method.
+func (s *UserService) CreateStatusUpdateNotificationRule(userID string, rule *StatusUpdateNotificationRule) (*StatusUpdateNotificationRule, *Response, error) {
+	return s.CreateStatusUpdateNotificationRuleContext(context.Background(), userID, rule)
+}
+
+// CreateStatusUpdateNotificationRuleContext creates a new status update
+// notification rule for a user. The rule needs a contact method.
+func (s *UserService) CreateStatusUpdateNotificationRuleContext(ctx context.Context, userID string, rule *StatusUpdateNotificationRule) (*StatusUpdateNotificationRule, *Response, error) {
+	if err := validateStatusUpdateNotificationRule(rule); err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("/users/%s/status_update_notification_rules", userID)
+	v := new(StatusUpdateNotificationRulePayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &StatusUpdateNotificationRulePayload{StatusUpdateNotificationRule: rule}, &v, WithRoute("/users/{id}/status_update_notification_rules"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.StatusUpdateNotificationRule, resp, nil
+}
+
+// UpdateStatusUpdateNotificationRule updates a status update notification
+// rule for a user.
+func (s *UserService) UpdateStatusUpdateNotificationRule(userID, ruleID string, rule *StatusUpdateNotificationRule) (*StatusUpdateNotificationRule, *Response, error) {
+	return s.UpdateStatusUpdateNotificationRuleContext(context.Background(), userID, ruleID, rule)
+}
+
+// UpdateStatusUpdateNotificationRuleContext updates a status update
+// notification rule for a user.
+func (s *UserService) UpdateStatusUpdateNotificationRuleContext(ctx context.Context, userID, ruleID string, rule *StatusUpdateNotificationRule) (*StatusUpdateNotificationRule, *Response, error) {
+	if err := validateStatusUpdateNotificationRule(rule); err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("/users/%s/status_update_notification_rules/%s", userID, ruleID)
+	v := new(StatusUpdateNotificationRulePayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &StatusUpdateNotificationRulePayload{StatusUpdateNotificationRule: rule}, &v, WithRoute("/users/{id}/status_update_notification_rules/{id}"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.StatusUpdateNotificationRule, resp, nil
+}
+
+// DeleteStatusUpdateNotificationRule deletes a status update notification
+// rule for a user.
+func (s *UserService) DeleteStatusUpdateNotificationRule(userID, ruleID string) (*Response, error) {
+	return s.DeleteStatusUpdateNotificationRuleContext(context.Background(), userID, ruleID)
+}
+
+// DeleteStatusUpdateNotificationRuleContext deletes a status update
+// notification rule for a user.
+func (s *UserService) DeleteStatusUpdateNotificationRuleContext(ctx context.Context, userID, ruleID string) (*Response, error) {
+	u := fmt.Sprintf("/users/%s/status_update_notification_rules/%s", userID, ruleID)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/users/{id}/status_update_notification_rules/{id}"))
+}
@@ -0,0 +1,122 @@
+package pagerduty
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestUsersListStatusUpdateNotificationRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/status_update_notification_rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "include[]", "contact_methods")
+		w.Write([]byte(`{"status_update_notification_rules": [{"id": "s1", "type": "status_update_notification_rule", "contact_method": {"id": "c1", "type": "email_contact_method", "summary": "Work"}}], "limit": 25, "more": false}`))
+	})
+
+	resp, _, err := client.Users.ListStatusUpdateNotificationRules("1", &ListStatusUpdateNotificationRulesOptions{Include: []string{"contact_methods"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListStatusUpdateNotificationRulesResponse{
+		PaginationMeta: PaginationMeta{Limit: 25},
+		StatusUpdateNotificationRules: []*StatusUpdateNotificationRule{
+			{
+				ID:            "s1",
+				Type:          "status_update_notification_rule",
+				ContactMethod: &ContactMethodReference{ID: "c1", Type: ContactMethodTypeEmail, Summary: "Work"},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestUsersStatusUpdateNotificationRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	const body = `{"status_update_notification_rule":{"contact_method":{"id":"c1","type":"email_contact_method_reference"}}}`
+	const response = `{"status_update_notification_rule": {"id": "s1", "type": "status_update_notification_rule", "contact_method": {"id": "c1", "type": "email_contact_method_reference"}}}`
+
+	deleted := false
+	mux.HandleFunc("/users/1/status_update_notification_rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, body)
+		w.Write([]byte(response))
+	})
+	mux.HandleFunc("/users/1/status_update_notification_rules/s1", func(w http.ResponseWriter, r *http.Request) {
+		if deleted {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": {"code": 2100, "message": "Not Found"}}`))
+			return
+		}
+		switch r.Method {
+		case "GET":
+			w.Write([]byte(response))
+		case "PUT":
+			testBody(t, r, body)
+			w.Write([]byte(response))
+		case "DELETE":
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	input := &StatusUpdateNotificationRule{ContactMethod: &ContactMethodReference{ID: "c1", Type: "email_contact_method_reference"}}
+	want := &StatusUpdateNotificationRule{
+		ID:            "s1",
+		Type:          "status_update_notification_rule",
+		ContactMethod: &ContactMethodReference{ID: "c1", Type: "email_contact_method_reference"},
+	}
+
+	created, _, err := client.Users.CreateStatusUpdateNotificationRule("1", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(created, want) {
+		t.Errorf("created %#v; want %#v", created, want)
+	}
+
+	got, _, err := client.Users.GetStatusUpdateNotificationRule("1", "s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v; want %#v", got, want)
+	}
+
+	updated, _, err := client.Users.UpdateStatusUpdateNotificationRule("1", "s1", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(updated, want) {
+		t.Errorf("updated %#v; want %#v", updated, want)
+	}
+
+	if _, err := client.Users.DeleteStatusUpdateNotificationRule("1", "s1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := client.Users.GetStatusUpdateNotificationRule("1", "s1"); !IsNotFound(err) {
+		t.Errorf("got error %v, want a not found error for the deleted rule", err)
+	}
+}
+
+func TestUsersCreateStatusUpdateNotificationRuleInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, rule := range []*StatusUpdateNotificationRule{nil, {}, {ContactMethod: &ContactMethodReference{}}} {
+		if _, _, err := client.Users.CreateStatusUpdateNotificationRule("1", rule); err == nil {
+			t.Errorf("expected an error creating %#v", rule)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package pagerduty
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -35,6 +36,90 @@ func TestUsersList(t *testing.T) {
 	}
 }
 
+func TestUsersListTotal(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "total", "true")
+		w.Write([]byte(`{"users": [{"id": "P1D3Z4B"}], "limit": 1, "offset": 0, "more": true, "total": 42}`))
+	})
+
+	resp, _, err := client.Users.List(&ListUsersOptions{ListOptions: ListOptions{Limit: 1, Total: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := PaginationMeta{Limit: 1, More: true, Total: 42}
+	if resp.PaginationMeta != want {
+		t.Errorf("returned pagination %#v; want %#v", resp.PaginationMeta, want)
+	}
+}
+
+func TestUsersListPages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "query", "foo")
+		switch r.URL.Query().Get("offset") {
+		case "":
+			w.Write([]byte(`{"users":[{"id":"P1"},{"id":"P2"}],"limit":2,"offset":0,"more":true}`))
+		case "2":
+			w.Write([]byte(`{"users":[{"id":"P3"}],"limit":2,"offset":2,"more":false}`))
+		default:
+			t.Fatalf("unexpected offset %q", r.URL.Query().Get("offset"))
+		}
+	})
+
+	var pages [][]*User
+	o := &ListUsersOptions{Query: "foo"}
+	err := client.Users.ListPages(o, func(users []*User) error {
+		pages = append(pages, users)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := [][]*User{
+		{{ID: "P1"}, {ID: "P2"}},
+		{{ID: "P3"}},
+	}
+
+	if !reflect.DeepEqual(pages, want) {
+		t.Errorf("returned %#v; want %#v", pages, want)
+	}
+
+	if o.Offset != 0 {
+		t.Errorf("options were modified, offset is %d", o.Offset)
+	}
+}
+
+func TestUsersListPagesCallbackError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"users":[{"id":"P1"}],"limit":1,"offset":0,"more":true}`))
+	})
+
+	stop := fmt.Errorf("stop")
+	err := client.Users.ListPages(nil, func(users []*User) error {
+		return stop
+	})
+	if err != stop {
+		t.Errorf("got %v; want %v", err, stop)
+	}
+	if requests != 1 {
+		t.Errorf("got %d requests; want 1", requests)
+	}
+}
+
 func TestUsersCreate(t *testing.T) {
 	setup()
 	defer teardown()
@@ -128,6 +213,52 @@ func TestUsersDelete(t *testing.T) {
 	}
 }
 
+func TestUsersDeleteReferenced(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{
+			"error": {
+				"code": 2001,
+				"message": "Invalid Input Provided",
+				"errors": ["User cannot be deleted as they are still referenced"],
+				"conflicts": [
+					{"id": "PI7DH85", "type": "schedule_reference", "summary": "Daily Engineering Rotation"},
+					{"id": "PANZZEQ", "type": "escalation_policy_reference", "summary": "Engineering Escalation Policy"},
+					{"id": "PT4KHLK", "type": "incident_reference", "summary": "[#1234] The server is on fire."},
+					{"id": "PXYZ123", "type": "unknown_reference"}
+				]
+			}
+		}`))
+	})
+
+	_, err := client.Users.Delete("1")
+
+	var deletionErr *UserDeletionError
+	if !errors.As(err, &deletionErr) {
+		t.Fatalf("got error %v, want a *UserDeletionError", err)
+	}
+
+	want := &UserDeletionError{
+		UserID:             "1",
+		Schedules:          []*ScheduleReference{{ID: "PI7DH85", Type: "schedule_reference", Summary: "Daily Engineering Rotation"}},
+		EscalationPolicies: []*EscalationPolicyReference{{ID: "PANZZEQ", Type: "escalation_policy_reference", Summary: "Engineering Escalation Policy"}},
+		Incidents:          []*IncidentReference{{ID: "PT4KHLK", Type: "incident_reference", Summary: "[#1234] The server is on fire."}},
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != 2001 {
+		t.Errorf("got error %v, want an *APIError with code 2001", err)
+	}
+
+	deletionErr.Err = nil
+	if !reflect.DeepEqual(deletionErr, want) {
+		t.Errorf("returned %#v; want %#v", deletionErr, want)
+	}
+}
+
 func TestUsersGet(t *testing.T) {
 	setup()
 	defer teardown()
@@ -172,6 +303,55 @@ func TestUsersGetLicense(t *testing.T) {
 	}
 }
 
+func TestUsersGetLicenseFull(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/license", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"license": {"id": "PIP248G", "type": "license", "name": "Business (Full User)", "description": "Prior to 2023, this was the Business plan", "valid_roles": ["owner", "admin", "user", "limited_user"], "role_group": "FullUser"}}`))
+	})
+
+	resp, _, err := client.Users.GetLicense("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &License{
+		ID:          "PIP248G",
+		Type:        "license",
+		Name:        "Business (Full User)",
+		Description: "Prior to 2023, this was the Business plan",
+		ValidRoles:  []string{"owner", "admin", "user", "limited_user"},
+		RoleGroup:   "FullUser",
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestUsersGetLicenseErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/license", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusPaymentRequired)
+		w.Write([]byte(`{"error": {"code": 2012, "message": "Account does not have access to licenses"}}`))
+	})
+	mux.HandleFunc("/users/2/license", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error": {"code": 2100, "message": "Not Found"}}`))
+	})
+
+	if _, _, err := client.Users.GetLicense("1"); !IsPaymentRequired(err) || IsNotFound(err) {
+		t.Errorf("got error %v, want a payment required error", err)
+	}
+	if _, _, err := client.Users.GetLicense("2"); !IsNotFound(err) || IsPaymentRequired(err) {
+		t.Errorf("got error %v, want a not found error", err)
+	}
+}
+
 func TestUsersGetWithLicense(t *testing.T) {
 	setup()
 	defer teardown()
@@ -265,6 +445,78 @@ func TestListAllWithLicenses(t *testing.T) {
 	}
 }
 
+func TestUsersCreateWithLicense(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"user":{"email":"foo@bar.com","name":"foo","license":{"id":"PTDVERC","type":"license_reference"}}}`)
+		w.Write([]byte(`{"user": {"id": "1", "email": "foo@bar.com", "name": "foo", "license": {"id": "PTDVERC", "type": "license_reference", "summary": "Stakeholder"}}}`))
+	})
+
+	resp, _, err := client.Users.Create(&User{
+		Email:   "foo@bar.com",
+		Name:    "foo",
+		License: &LicenseReference{ID: "PTDVERC", Type: "license_reference"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &User{
+		ID:      "1",
+		Email:   "foo@bar.com",
+		Name:    "foo",
+		License: &LicenseReference{ID: "PTDVERC", Type: "license_reference", Summary: "Stakeholder"},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestUsersCreateWithoutLicense(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"user":{"email":"foo@bar.com","name":"foo"}}`)
+		w.Write([]byte(`{"user": {"id": "1", "email": "foo@bar.com", "name": "foo"}}`))
+	})
+
+	resp, _, err := client.Users.Create(&User{Email: "foo@bar.com", Name: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.License != nil {
+		t.Errorf("License = %#v, want nil", resp.License)
+	}
+}
+
+func TestUsersUpdateLicense(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"user":{"license":{"id":"PTDVERC","type":"license_reference"}}}`)
+		w.Write([]byte(`{"user": {"id": "1", "license": {"id": "PTDVERC", "type": "license_reference"}}}`))
+	})
+
+	resp, _, err := client.Users.Update("1", &User{License: &LicenseReference{ID: "PTDVERC", Type: "license_reference"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &User{ID: "1", License: &LicenseReference{ID: "PTDVERC", Type: "license_reference"}}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
 func TestUsersUpdate(t *testing.T) {
 	setup()
 	defer teardown()
@@ -523,3 +775,586 @@ func TestUsersAddDuplicateNotificationRule(t *testing.T) {
 		t.Errorf("returned %#v; want %#v", resp, want)
 	}
 }
+
+func TestUsersContactMethodTypes(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    *ContactMethod
+		body     string
+		response string
+		want     *ContactMethod
+	}{
+		{
+			name:     "email",
+			input:    &ContactMethod{Type: ContactMethodTypeEmail, Label: "Work", Address: "foo@bar.com", SendShortEmail: true},
+			body:     `{"contact_method":{"type":"email_contact_method","label":"Work","address":"foo@bar.com","send_short_email":true}}`,
+			response: `{"contact_method": {"id": "PXPGF42", "type": "email_contact_method", "label": "Work", "address": "foo@bar.com", "send_short_email": true}}`,
+			want:     &ContactMethod{ID: "PXPGF42", Type: ContactMethodTypeEmail, Label: "Work", Address: "foo@bar.com", SendShortEmail: true},
+		},
+		{
+			name:     "phone",
+			input:    &ContactMethod{Type: ContactMethodTypePhone, Label: "Mobile", Address: "5555555555", CountryCode: 1},
+			body:     `{"contact_method":{"type":"phone_contact_method","label":"Mobile","address":"5555555555","country_code":1}}`,
+			response: `{"contact_method": {"id": "PXPGF43", "type": "phone_contact_method", "label": "Mobile", "address": "5555555555", "country_code": 1, "blacklisted": false, "enabled": true}}`,
+			want:     &ContactMethod{ID: "PXPGF43", Type: ContactMethodTypePhone, Label: "Mobile", Address: "5555555555", CountryCode: 1, Enabled: true},
+		},
+		{
+			name:     "sms",
+			input:    &ContactMethod{Type: ContactMethodTypeSMS, Label: "Mobile", Address: "5555555555", CountryCode: 1},
+			body:     `{"contact_method":{"type":"sms_contact_method","label":"Mobile","address":"5555555555","country_code":1}}`,
+			response: `{"contact_method": {"id": "PXPGF44", "type": "sms_contact_method", "label": "Mobile", "address": "5555555555", "country_code": 1, "blacklisted": true, "enabled": true}}`,
+			want:     &ContactMethod{ID: "PXPGF44", Type: ContactMethodTypeSMS, Label: "Mobile", Address: "5555555555", CountryCode: 1, BlackListed: true, Enabled: true},
+		},
+		{
+			name:     "push notification",
+			input:    &ContactMethod{Type: ContactMethodTypePushNotification, Label: "Phone", Address: "token", DeviceType: "ios"},
+			body:     `{"contact_method":{"type":"push_notification_contact_method","label":"Phone","address":"token","device_type":"ios"}}`,
+			response: `{"contact_method": {"id": "PXPGF45", "type": "push_notification_contact_method", "label": "Phone", "address": "token", "device_type": "ios", "sounds": [{"type": "alert_high_urgency", "file": "default"}]}}`,
+			want:     &ContactMethod{ID: "PXPGF45", Type: ContactMethodTypePushNotification, Label: "Phone", Address: "token", DeviceType: "ios", Sounds: []*PushContactMethodSound{{Type: "alert_high_urgency", File: "default"}}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			mux.HandleFunc("/users/1/contact_methods", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "POST")
+				testBody(t, r, tc.body)
+				w.Write([]byte(tc.response))
+			})
+			mux.HandleFunc("/users/1/contact_methods/"+tc.want.ID, func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case "GET":
+				case "PUT":
+					testBody(t, r, tc.body)
+				default:
+					t.Errorf("unexpected method %s", r.Method)
+				}
+				w.Write([]byte(tc.response))
+			})
+
+			created, _, err := client.Users.CreateContactMethod("1", tc.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(created, tc.want) {
+				t.Errorf("created %#v; want %#v", created, tc.want)
+			}
+
+			got, _, err := client.Users.GetContactMethod("1", tc.want.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %#v; want %#v", got, tc.want)
+			}
+
+			updated, _, err := client.Users.UpdateContactMethod("1", tc.want.ID, tc.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(updated, tc.want) {
+				t.Errorf("updated %#v; want %#v", updated, tc.want)
+			}
+		})
+	}
+}
+
+func TestUsersCreateContactMethodUnsupportedType(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/contact_methods", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request for an unsupported contact method type")
+	})
+
+	for _, input := range []*ContactMethod{nil, {Type: "pager_contact_method", Address: "5555555555"}, {Address: "foo@bar.com"}} {
+		if _, _, err := client.Users.CreateContactMethod("1", input); err == nil {
+			t.Errorf("expected an error creating %#v", input)
+		}
+	}
+}
+
+func TestUsersListNotificationRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/notification_rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if want := "include%5B%5D=contact_methods&urgency=high"; r.URL.RawQuery != want {
+			t.Errorf("query = %q, want %q", r.URL.RawQuery, want)
+		}
+		w.Write([]byte(`{"notification_rules": [{"id": "n1", "type": "assignment_notification_rule", "urgency": "high", "start_delay_in_minutes": 5, "contact_method": {"id": "c1", "type": "email_contact_method", "summary": "Work"}}]}`))
+	})
+
+	resp, _, err := client.Users.ListNotificationRules("1", &ListNotificationRulesOptions{
+		Include: []string{"contact_methods"},
+		Urgency: NotificationRuleUrgencyHigh,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListNotificationRulesResponse{
+		NotificationRules: []*NotificationRule{
+			{
+				ID:                  "n1",
+				Type:                "assignment_notification_rule",
+				Urgency:             NotificationRuleUrgencyHigh,
+				StartDelayInMinutes: 5,
+				ContactMethod:       &ContactMethodReference{ID: "c1", Type: ContactMethodTypeEmail, Summary: "Work"},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestUsersCreateNotificationRuleFromContactMethodID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/notification_rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"notification_rule":{"contact_method":{"id":"c1","type":"sms_contact_method_reference"},"start_delay_in_minutes":10,"type":"assignment_notification_rule","urgency":"low"}}`)
+		w.Write([]byte(`{"notification_rule": {"id": "n2", "type": "assignment_notification_rule", "urgency": "low", "start_delay_in_minutes": 10, "contact_method": {"id": "c1", "type": "sms_contact_method_reference"}}}`))
+	})
+
+	resp, _, err := client.Users.CreateNotificationRule("1", NewNotificationRule("c1", ContactMethodTypeSMS, NotificationRuleUrgencyLow, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &NotificationRule{
+		ID:                  "n2",
+		Type:                "assignment_notification_rule",
+		Urgency:             NotificationRuleUrgencyLow,
+		StartDelayInMinutes: 10,
+		ContactMethod:       &ContactMethodReference{ID: "c1", Type: "sms_contact_method_reference"},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestUsersCreateNotificationRuleInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/notification_rules", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request for an invalid notification rule")
+	})
+
+	for _, rule := range []*NotificationRule{
+		nil,
+		{Urgency: NotificationRuleUrgencyHigh},
+		NewNotificationRule("", ContactMethodTypeEmail, NotificationRuleUrgencyHigh, 0),
+		NewNotificationRule("c1", ContactMethodTypeEmail, "medium", 0),
+		NewNotificationRule("c1", ContactMethodTypeEmail, NotificationRuleUrgencyHigh, -1),
+	} {
+		if _, _, err := client.Users.CreateNotificationRule("1", rule); err == nil {
+			t.Errorf("expected an error creating %#v", rule)
+		}
+	}
+}
+
+func TestUsersDeleteLastNotificationRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/notification_rules/n1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error": {"code": 2001, "message": "Invalid Input Provided", "errors": ["User must have at least one high urgency notification rule"]}}`))
+	})
+
+	_, err := client.Users.DeleteNotificationRule("1", "n1")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("got error %v, want an *APIError", err)
+	}
+	if want := []string{"User must have at least one high urgency notification rule"}; !reflect.DeepEqual(apiErr.Errors, want) {
+		t.Errorf("errors = %q, want %q", apiErr.Errors, want)
+	}
+}
+
+func TestUsersGetCurrent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/me", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if want := "include%5B%5D=contact_methods&include%5B%5D=teams"; r.URL.RawQuery != want {
+			t.Errorf("query = %q, want %q", r.URL.RawQuery, want)
+		}
+		w.Write([]byte(`{"user": {"id": "PXPGF42", "name": "Earline Greenholt", "teams": [{"id": "PQ9K7I8", "type": "team_reference"}]}}`))
+	})
+
+	resp, _, err := client.Users.GetCurrent(&GetCurrentUserOptions{Include: []string{"contact_methods", "teams"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &User{
+		ID:    "PXPGF42",
+		Name:  "Earline Greenholt",
+		Teams: []*TeamReference{{ID: "PQ9K7I8", Type: "team_reference"}},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestUsersGetCurrentAccountLevelToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/me", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":{"message":"Because this request was made using an account-level access token, we were unable to determine the user's identity. Please use a user-level token.","code":2500}}`))
+	})
+
+	_, _, err := client.Users.GetCurrent(nil)
+
+	var noUserErr *NoCurrentUserError
+	if !errors.As(err, &noUserErr) {
+		t.Fatalf("got error %v, want a *NoCurrentUserError", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("got error %v, want the underlying API error", err)
+	}
+}
+
+func TestUsersGetCurrentInvalidInput(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/me", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error": {"code": 2001, "message": "Invalid Input Provided", "errors": ["Include is invalid"]}}`))
+	})
+
+	_, _, err := client.Users.GetCurrent(&GetCurrentUserOptions{Include: []string{"foo"}})
+
+	var noUserErr *NoCurrentUserError
+	if errors.As(err, &noUserErr) {
+		t.Fatalf("got error %v, want an API error only", err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("got error %v, want a bad request API error", err)
+	}
+}
+
+func TestUsersGetCurrentOtherError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/me", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error": {"code": 2006, "message": "Invalid Credentials"}}`))
+	})
+
+	_, _, err := client.Users.GetCurrent(nil)
+
+	var noUserErr *NoCurrentUserError
+	if errors.As(err, &noUserErr) || !IsUnauthorized(err) {
+		t.Errorf("got error %v, want an unauthorized API error", err)
+	}
+}
+
+func TestUsersListQueryString(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if want := "include%5B%5D=contact_methods&include%5B%5D=notification_rules&limit=100&query=earline&team_ids%5B%5D=PQ9K7I8&team_ids%5B%5D=PGVXG6U"; r.URL.RawQuery != want {
+			t.Errorf("query = %q, want %q", r.URL.RawQuery, want)
+		}
+		w.Write([]byte(`{"users": [], "limit": 100, "more": false}`))
+	})
+
+	_, _, err := client.Users.List(&ListUsersOptions{
+		ListOptions: ListOptions{Limit: 100},
+		Include:     []string{"contact_methods", "notification_rules"},
+		Query:       "earline",
+		TeamIDs:     []string{"PQ9K7I8", "PGVXG6U"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUsersListAllIncludes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "include[]", "contact_methods")
+		switch r.URL.Query().Get("offset") {
+		case "":
+			w.Write([]byte(`{"users": [{"id": "P1", "contact_methods": [{"id": "c1", "type": "email_contact_method", "address": "one@example.com"}]}], "limit": 1, "offset": 0, "more": true}`))
+		case "1":
+			w.Write([]byte(`{"users": [{"id": "P2", "contact_methods": [{"id": "c2", "type": "email_contact_method", "address": "two@example.com"}]}], "limit": 1, "offset": 1, "more": false}`))
+		default:
+			t.Errorf("unexpected offset %q", r.URL.Query().Get("offset"))
+		}
+	})
+
+	o := &ListUsersOptions{Include: []string{"contact_methods"}}
+	resp, err := client.Users.ListAll(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*FullUser{
+		{ID: "P1", ContactMethods: []*ContactMethod{{ID: "c1", Type: ContactMethodTypeEmail, Address: "one@example.com"}}},
+		{ID: "P2", ContactMethods: []*ContactMethod{{ID: "c2", Type: ContactMethodTypeEmail, Address: "two@example.com"}}},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+	if o.Offset != 0 {
+		t.Error("the options passed in were modified")
+	}
+}
+
+func TestUsersListAuditRecords(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/PXPGF42/audit/records", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "since", "2023-01-01T00:00:00Z")
+		testQueryValue(t, r, "until", "2023-02-01T00:00:00Z")
+		testQueryValue(t, r, "cursor", "abc")
+		w.Write([]byte(`{"records": [{
+			"id": "PDRECORDID1",
+			"execution_time": "2023-01-10T17:22:11.000Z",
+			"actors": [{"id": "PDUSER", "type": "user_reference", "summary": "John Snow"}],
+			"method": {"type": "api_token", "truncated_token": "3xyz"},
+			"root_resource": {"id": "PXPGF42", "type": "user_reference"},
+			"action": "update",
+			"details": {
+				"resource": {"id": "PXPGF42", "type": "user_reference"},
+				"fields": [{"name": "role", "value": "admin", "before_value": "user"}]
+			}
+		}], "limit": 25, "next_cursor": "def"}`))
+	})
+
+	resp, _, err := client.Users.ListAuditRecords("PXPGF42", &ListAuditRecordsOptions{
+		CursorPagination: CursorPagination{Cursor: "abc"},
+		Since:            "2023-01-01T00:00:00Z",
+		Until:            "2023-02-01T00:00:00Z",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListAuditRecordsResponse{
+		CursorPagination: CursorPagination{Limit: 25, NextCursor: "def"},
+		Records: []*AuditRecord{
+			{
+				ID:            "PDRECORDID1",
+				ExecutionTime: "2023-01-10T17:22:11.000Z",
+				Actors:        []*AuditActor{{ID: "PDUSER", Type: "user_reference", Summary: "John Snow"}},
+				Method:        &AuditMethod{Type: "api_token", TruncatedToken: "3xyz"},
+				RootResource:  &AuditResource{ID: "PXPGF42", Type: "user_reference"},
+				Action:        "update",
+				Details: &AuditDetails{
+					Resource: &AuditResource{ID: "PXPGF42", Type: "user_reference"},
+					Fields:   []*AuditField{{Name: "role", Value: "admin", BeforeValue: "user"}},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestUsersListAllAuditRecords(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/PXPGF42/audit/records", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch cursor := r.URL.Query().Get("cursor"); cursor {
+		case "":
+			w.Write([]byte(`{"records": [{"id": "1"}], "next_cursor": "abc"}`))
+		case "abc":
+			w.Write([]byte(`{"records": [{"id": "2"}], "next_cursor": null}`))
+		default:
+			t.Errorf("unexpected cursor %q", cursor)
+		}
+	})
+
+	resp, err := client.Users.ListAllAuditRecords("PXPGF42", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*AuditRecord{{ID: "1"}, {ID: "2"}}
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestUsersFindByEmail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "query", "john@example.com")
+		switch r.URL.Query().Get("offset") {
+		case "":
+			w.Write([]byte(`{"users": [{"id": "P1", "email": "ajohn@example.com"}, {"id": "P2", "email": "john@example.com.au"}], "limit": 2, "offset": 0, "more": true}`))
+		case "2":
+			w.Write([]byte(`{"users": [{"id": "P3", "email": "John@Example.com"}], "limit": 2, "offset": 2, "more": false}`))
+		default:
+			t.Errorf("unexpected offset %q", r.URL.Query().Get("offset"))
+		}
+	})
+
+	user, err := client.Users.FindByEmail("john@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := (&User{ID: "P3", Email: "John@Example.com"}); !reflect.DeepEqual(user, want) {
+		t.Errorf("returned %#v; want %#v", user, want)
+	}
+}
+
+func TestUsersFindByEmailNotFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"users": [{"id": "P1", "email": "ajohn@example.com"}], "limit": 100, "more": false}`))
+	})
+
+	_, err := client.Users.FindByEmail("john@example.com")
+	if !IsNotFound(err) || !errors.Is(err, ErrNotFound) {
+		t.Errorf("got error %v, want a not found error", err)
+	}
+}
+
+func TestUsersFindByEmailAmbiguous(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"users": [{"id": "P1", "email": "john@example.com"}, {"id": "P2", "email": "JOHN@example.com"}], "limit": 100, "more": false}`))
+	})
+
+	_, err := client.Users.FindByEmail("john@example.com")
+
+	var ambiguousErr *AmbiguousMatchError
+	if !errors.As(err, &ambiguousErr) {
+		t.Fatalf("got error %v, want an *AmbiguousMatchError", err)
+	}
+	if want := []string{"P1", "P2"}; !reflect.DeepEqual(ambiguousErr.IDs, want) {
+		t.Errorf("IDs = %v, want %v", ambiguousErr.IDs, want)
+	}
+}
+
+func TestUserValidate(t *testing.T) {
+	valid := []*User{
+		{},
+		{Role: RoleLimitedUser},
+		{Role: RoleReadOnlyLimitedUser, ContactMethods: []*ContactMethodReference{{ID: "c1", Type: "phone_contact_method_reference"}}},
+	}
+	for _, u := range valid {
+		if err := u.Validate(); err != nil {
+			t.Errorf("Validate() of %#v returned %v", u, err)
+		}
+	}
+
+	invalid := []*User{
+		{Role: "admn"},
+		{Role: RoleUser, ContactMethods: []*ContactMethodReference{{ID: "c1", Type: "phone_contact_method_referencee"}}},
+	}
+	for _, u := range invalid {
+		if err := u.Validate(); err == nil {
+			t.Errorf("Validate() of %#v returned no error", u)
+		}
+	}
+}
+
+func TestUsersCreateUnknownRole(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"user":{"email":"foo@bar.com","name":"foo","role":"incident_commander"}}`)
+		w.Write([]byte(`{"user": {"id": "1", "role": "incident_commander"}}`))
+	})
+
+	// Validation is opt-in, roles unknown to this library are still sent.
+	if _, _, err := client.Users.Create(&User{Email: "foo@bar.com", Name: "foo", Role: "incident_commander"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestContactMethodValidate(t *testing.T) {
+	if err := (&ContactMethod{Type: ContactMethodTypeSMS}).Validate(); err != nil {
+		t.Errorf("Validate() returned %v", err)
+	}
+	if err := (&ContactMethod{Type: "sms"}).Validate(); err == nil {
+		t.Error("Validate() returned no error")
+	}
+}
+
+func TestUsersListAllLimitExceeded(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		offset := r.URL.Query().Get("offset")
+		if offset == "" {
+			offset = "0"
+		}
+		w.Write([]byte(fmt.Sprintf(`{"users":[{"id":"P%s"}],"limit":100,"offset":%s,"more":true}`, offset, offset)))
+	})
+
+	for name, listAll := range map[string]func() (int, error){
+		"ListAll": func() (int, error) {
+			users, err := client.Users.ListAll(&ListUsersOptions{ListOptions: ListOptions{Limit: 100}})
+			return len(users), err
+		},
+		"ListAllWithLicenses": func() (int, error) {
+			users, err := client.Users.ListAllWithLicenses(&ListUsersOptions{ListOptions: ListOptions{Limit: 100}})
+			return len(users), err
+		},
+	} {
+		n, err := listAll()
+
+		var limitErr *PaginationLimitError
+		if !errors.As(err, &limitErr) {
+			t.Fatalf("%s: got %v; want *PaginationLimitError", name, err)
+		}
+		if limitErr.Offset != 10000 {
+			t.Errorf("%s: got offset %d; want 10000", name, limitErr.Offset)
+		}
+		if n != 100 {
+			t.Errorf("%s: got %d users; want the 100 listed before the limit", name, n)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -26,19 +27,17 @@ type Vendor struct {
 
 // ListVendorsOptions represents options when listing vendors.
 type ListVendorsOptions struct {
-	Limit  int    `url:"limit,omitempty"`
-	More   bool   `url:"more,omitempty"`
-	Offset int    `url:"offset,omitempty"`
-	Total  int    `url:"total,omitempty"`
-	Query  string `url:"query,omitempty"`
+	ListOptions
+
+	// Deprecated: More is not a request parameter and is ignored.
+	More bool `url:"-"`
+
+	Query string `url:"query,omitempty"`
 }
 
 // ListVendorsResponse represents a list response of vendors.
 type ListVendorsResponse struct {
-	Limit   int       `json:"limit,omitempty"`
-	More    bool      `json:"more,omitempty"`
-	Offset  int       `json:"offset,omitempty"`
-	Total   int       `json:"total,omitempty"`
+	PaginationMeta
 	Vendors []*Vendor `json:"vendors,omitempty"`
 }
 
@@ -49,10 +48,15 @@ type VendorPayload struct {
 
 // List lists existing vendors.
 func (s *VendorService) List(o *ListVendorsOptions) (*ListVendorsResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists existing vendors.
+func (s *VendorService) ListContext(ctx context.Context, o *ListVendorsOptions) (*ListVendorsResponse, *Response, error) {
 	u := "/vendors"
 	v := new(ListVendorsResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, v, WithRoute("/vendors"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -62,13 +66,93 @@ func (s *VendorService) List(o *ListVendorsOptions) (*ListVendorsResponse, *Resp
 
 // Get retrieves information about a vendor.
 func (s *VendorService) Get(id string) (*Vendor, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext retrieves information about a vendor.
+func (s *VendorService) GetContext(ctx context.Context, id string) (*Vendor, *Response, error) {
 	u := fmt.Sprintf("/vendors/%s", id)
 	v := new(VendorPayload)
 
-	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, WithRoute("/vendors/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
 
 	return v.Vendor, resp, nil
 }
+
+type listVendorsOptionsGen struct {
+	options *ListVendorsOptions
+}
+
+func (o *listVendorsOptionsGen) currentOffset() int {
+	return o.options.Offset
+}
+
+func (o *listVendorsOptionsGen) changeOffset(i int) {
+	o.options.Offset = i
+}
+
+func (o *listVendorsOptionsGen) buildStruct() interface{} {
+	return o.options
+}
+
+// ListPages lists existing vendors, calling fn with every page of results until
+// there are no more pages or fn returns an error.
+func (s *VendorService) ListPages(o *ListVendorsOptions, fn func([]*Vendor) error) error {
+	return s.ListPagesContext(context.Background(), o, fn)
+}
+
+// ListPagesContext lists existing vendors, calling fn with every page of results until
+// there are no more pages or fn returns an error.
+func (s *VendorService) ListPagesContext(ctx context.Context, o *ListVendorsOptions, fn func([]*Vendor) error) error {
+	opts := ListVendorsOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	responseHandler := func(response *Response) (PaginationMeta, *Response, error) {
+		var result ListVendorsResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return PaginationMeta{}, response, err
+		}
+
+		if err := fn(result.Vendors); err != nil {
+			return PaginationMeta{}, response, err
+		}
+
+		return PaginationMeta{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+
+	return s.client.newRequestPagedGetQueryDoContext(ctx, "/vendors", responseHandler, &listVendorsOptionsGen{options: &opts}, WithRoute("/vendors"))
+}
+
+// Iter returns an Iterator over existing vendors, which requests pages of
+// results as they are needed.
+func (s *VendorService) Iter(o *ListVendorsOptions) *Iterator[*Vendor] {
+	return s.IterContext(context.Background(), o)
+}
+
+// IterContext returns an Iterator over existing vendors, which requests pages
+// of results as they are needed.
+func (s *VendorService) IterContext(ctx context.Context, o *ListVendorsOptions) *Iterator[*Vendor] {
+	opts := ListVendorsOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	return Iterate(opts.ListOptions, func(lo ListOptions) ([]*Vendor, PaginationMeta, *Response, error) {
+		opts.ListOptions = lo
+		v, resp, err := s.ListContext(ctx, &opts)
+		if err != nil {
+			return nil, PaginationMeta{}, resp, err
+		}
+		return v.Vendors, v.PaginationMeta, resp, nil
+	})
+}
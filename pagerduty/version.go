@@ -0,0 +1,5 @@
+package pagerduty
+
+// Version is the version of this library, sent in the User-Agent header of
+// every request.
+const Version = "0.1.0"
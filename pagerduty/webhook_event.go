@@ -0,0 +1,122 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WebhookEvent represents the event of a V3 webhook payload. Verify the
+// signature of the payload with the webhook package before trusting it.
+type WebhookEvent struct {
+	ID           string                      `json:"id"`
+	EventType    string                      `json:"event_type"`
+	ResourceType string                      `json:"resource_type"`
+	OccurredAt   time.Time                   `json:"occurred_at"`
+	Agent        *IncidentAttributeReference `json:"agent,omitempty"`
+	Client       *WebhookClient              `json:"client,omitempty"`
+
+	// Data is the decoded event data: an *IncidentNoteWebhookData for
+	// incident.annotated, an *IncidentWebhookData for other incident events
+	// and a *ServiceWebhookData for service events. It is nil for other
+	// event types, whose data is only available in RawData.
+	Data interface{} `json:"-"`
+
+	// RawData is the event data as received.
+	RawData json.RawMessage `json:"data"`
+}
+
+// WebhookClient represents the client, e.g. an integration, that caused a
+// webhook event.
+type WebhookClient struct {
+	Name string `json:"name,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+// IncidentWebhookData represents the data of an incident webhook event.
+type IncidentWebhookData struct {
+	ID               string                     `json:"id,omitempty"`
+	Type             string                     `json:"type,omitempty"`
+	Self             string                     `json:"self,omitempty"`
+	HTMLURL          string                     `json:"html_url,omitempty"`
+	Number           int                        `json:"number,omitempty"`
+	Status           string                     `json:"status,omitempty"`
+	IncidentKey      string                     `json:"incident_key,omitempty"`
+	CreatedAt        string                     `json:"created_at,omitempty"`
+	Title            string                     `json:"title,omitempty"`
+	Service          *ServiceReference          `json:"service,omitempty"`
+	Assignees        []*UserReference           `json:"assignees,omitempty"`
+	EscalationPolicy *EscalationPolicyReference `json:"escalation_policy,omitempty"`
+	Teams            []*TeamReference           `json:"teams,omitempty"`
+	Priority         *PriorityReference         `json:"priority,omitempty"`
+	Urgency          string                     `json:"urgency,omitempty"`
+	ConferenceBridge *WebhookConferenceBridge   `json:"conference_bridge,omitempty"`
+	ResolveReason    *WebhookResolveReason      `json:"resolve_reason,omitempty"`
+}
+
+// WebhookConferenceBridge represents the conference bridge of an incident.
+type WebhookConferenceBridge = ConferenceBridge
+
+// WebhookResolveReason represents the reason an incident was resolved, such
+// as being merged into another incident.
+type WebhookResolveReason = ResolveReason
+
+// IncidentNoteWebhookData represents the data of an incident.annotated
+// webhook event.
+type IncidentNoteWebhookData struct {
+	ID       string             `json:"id,omitempty"`
+	Type     string             `json:"type,omitempty"`
+	Content  string             `json:"content,omitempty"`
+	Incident *IncidentReference `json:"incident,omitempty"`
+}
+
+// ServiceWebhookData represents the data of a service webhook event.
+type ServiceWebhookData struct {
+	ID               string                     `json:"id,omitempty"`
+	Type             string                     `json:"type,omitempty"`
+	Self             string                     `json:"self,omitempty"`
+	HTMLURL          string                     `json:"html_url,omitempty"`
+	Summary          string                     `json:"summary,omitempty"`
+	Name             string                     `json:"name,omitempty"`
+	Description      string                     `json:"description,omitempty"`
+	Status           string                     `json:"status,omitempty"`
+	EscalationPolicy *EscalationPolicyReference `json:"escalation_policy,omitempty"`
+	Teams            []*TeamReference           `json:"teams,omitempty"`
+}
+
+type webhookPayload struct {
+	Event *WebhookEvent `json:"event"`
+}
+
+// ParseWebhookPayload parses the body of a V3 webhook request and decodes
+// its data according to the event type. Unknown event types are parsed as
+// well, with their data only available in WebhookEvent.RawData.
+func ParseWebhookPayload(payload []byte) (*WebhookEvent, error) {
+	var p webhookPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return nil, fmt.Errorf("failed to parse webhook payload: %w", err)
+	}
+	if p.Event == nil {
+		return nil, errors.New("failed to parse webhook payload: no event")
+	}
+
+	e := p.Event
+	switch {
+	case e.EventType == "incident.annotated":
+		e.Data = new(IncidentNoteWebhookData)
+	case strings.HasPrefix(e.EventType, "incident.") && e.ResourceType == "incident":
+		e.Data = new(IncidentWebhookData)
+	case strings.HasPrefix(e.EventType, "service.") && e.ResourceType == "service":
+		e.Data = new(ServiceWebhookData)
+	}
+
+	if e.Data != nil && len(e.RawData) > 0 {
+		if err := json.Unmarshal(e.RawData, e.Data); err != nil {
+			return nil, fmt.Errorf("failed to parse %s webhook data: %w", e.EventType, err)
+		}
+	}
+
+	return e, nil
+}
@@ -0,0 +1,112 @@
+package pagerduty
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseWebhookPayloadIncident(t *testing.T) {
+	payload := `{
+		"event": {
+			"id": "01BZ6LJUV6X8M4MYRSTXUZ7C4F",
+			"event_type": "incident.triggered",
+			"resource_type": "incident",
+			"occurred_at": "2020-10-02T18:45:22.169Z",
+			"agent": {"id": "PLH1HKV", "type": "user_reference", "summary": "Tenex Engineer"},
+			"client": {"name": "PagerDuty"},
+			"data": {
+				"id": "PGR0VU2",
+				"type": "incident",
+				"number": 2,
+				"status": "triggered",
+				"title": "A little bump in the road",
+				"service": {"id": "PF9KMXH", "type": "service_reference"},
+				"assignees": [{"id": "PTUXL6G", "type": "user_reference"}],
+				"priority": {"id": "PSO75BM", "type": "priority_reference"},
+				"urgency": "high"
+			}
+		}
+	}`
+
+	e, err := ParseWebhookPayload([]byte(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if e.ID != "01BZ6LJUV6X8M4MYRSTXUZ7C4F" || e.EventType != "incident.triggered" || e.ResourceType != "incident" {
+		t.Errorf("unexpected event %#v", e)
+	}
+	if want := time.Date(2020, 10, 2, 18, 45, 22, 169000000, time.UTC); !e.OccurredAt.Equal(want) {
+		t.Errorf("OccurredAt = %v, want %v", e.OccurredAt, want)
+	}
+	if e.Agent == nil || e.Agent.ID != "PLH1HKV" {
+		t.Errorf("Agent = %#v, want PLH1HKV", e.Agent)
+	}
+
+	want := &IncidentWebhookData{
+		ID:        "PGR0VU2",
+		Type:      "incident",
+		Number:    2,
+		Status:    "triggered",
+		Title:     "A little bump in the road",
+		Service:   &ServiceReference{ID: "PF9KMXH", Type: "service_reference"},
+		Assignees: []*UserReference{{ID: "PTUXL6G", Type: "user_reference"}},
+		Priority:  &PriorityReference{ID: "PSO75BM", Type: "priority_reference"},
+		Urgency:   "high",
+	}
+	if !reflect.DeepEqual(e.Data, want) {
+		t.Errorf("Data = %#v, want %#v", e.Data, want)
+	}
+}
+
+func TestParseWebhookPayloadEventTypes(t *testing.T) {
+	cases := []struct {
+		payload string
+		want    interface{}
+	}{
+		{
+			`{"event": {"event_type": "incident.annotated", "resource_type": "incident", "data": {"id": "P1", "content": "Investigating", "incident": {"id": "P2"}}}}`,
+			&IncidentNoteWebhookData{ID: "P1", Content: "Investigating", Incident: &IncidentReference{ID: "P2"}},
+		},
+		{
+			`{"event": {"event_type": "service.updated", "resource_type": "service", "data": {"id": "P1", "name": "Checkout"}}}`,
+			&ServiceWebhookData{ID: "P1", Name: "Checkout"},
+		},
+	}
+
+	for _, tc := range cases {
+		e, err := ParseWebhookPayload([]byte(tc.payload))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(e.Data, tc.want) {
+			t.Errorf("Data = %#v, want %#v", e.Data, tc.want)
+		}
+	}
+}
+
+func TestParseWebhookPayloadUnknownEventType(t *testing.T) {
+	e, err := ParseWebhookPayload([]byte(`{"event": {"event_type": "pagey.ping", "resource_type": "pagey", "data": {"message": "Hello"}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Data != nil {
+		t.Errorf("Data = %#v, want nil", e.Data)
+	}
+	if string(e.RawData) != `{"message": "Hello"}` {
+		t.Errorf("RawData = %s, want the data as received", e.RawData)
+	}
+}
+
+func TestParseWebhookPayloadErrors(t *testing.T) {
+	for _, payload := range []string{
+		`not json`,
+		`{}`,
+		`{"event": {"event_type": "incident.triggered", "resource_type": "incident", "data": {"number": "two"}}}`,
+	} {
+		if _, err := ParseWebhookPayload([]byte(payload)); err == nil {
+			t.Errorf("ParseWebhookPayload(%s): expected an error, got nil", payload)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package pagerduty
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // WebhookSubscriptionService handle v3 webhooks from PagerDuty.
 type WebhookSubscriptionService service
@@ -52,6 +55,11 @@ type WebhookSubscriptionPayload struct {
 
 // List lists existing webhook subscriptions.
 func (s *WebhookSubscriptionService) List() (*ListWebhookSubscriptionsResponse, *Response, error) {
+	return s.ListContext(context.Background())
+}
+
+// ListContext lists existing webhook subscriptions.
+func (s *WebhookSubscriptionService) ListContext(ctx context.Context) (*ListWebhookSubscriptionsResponse, *Response, error) {
 	u := "/webhook_subscriptions"
 	v := new(ListWebhookSubscriptionsResponse)
 
@@ -59,24 +67,24 @@ func (s *WebhookSubscriptionService) List() (*ListWebhookSubscriptionsResponse,
 
 	// Create a handler closure capable of parsing data from the webhook subscriptions endpoint
 	// and appending resultant response plays to the return slice.
-	responseHandler := func(response *Response) (ListResp, *Response, error) {
+	responseHandler := func(response *Response) (PaginationMeta, *Response, error) {
 		var result ListWebhookSubscriptionsResponse
 
 		if err := s.client.DecodeJSON(response, &result); err != nil {
-			return ListResp{}, response, err
+			return PaginationMeta{}, response, err
 		}
 
 		webhookSubscriptions = append(webhookSubscriptions, result.WebhookSubscriptions...)
 
 		// Return stats on the current page. Caller can use this information to
 		// adjust for requesting additional pages.
-		return ListResp{
+		return PaginationMeta{
 			More:   result.More,
 			Offset: result.Offset,
 			Limit:  result.Limit,
 		}, response, nil
 	}
-	err := s.client.newRequestPagedGetDo(u, responseHandler)
+	err := s.client.newRequestPagedGetDoContext(ctx, u, responseHandler, WithRoute("/webhook_subscriptions"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -87,11 +95,16 @@ func (s *WebhookSubscriptionService) List() (*ListWebhookSubscriptionsResponse,
 
 // Create creates a new webhook subscription.
 func (s *WebhookSubscriptionService) Create(sub *WebhookSubscription) (*WebhookSubscription, *Response, error) {
+	return s.CreateContext(context.Background(), sub)
+}
+
+// CreateContext creates a new webhook subscription.
+func (s *WebhookSubscriptionService) CreateContext(ctx context.Context, sub *WebhookSubscription) (*WebhookSubscription, *Response, error) {
 	u := "/webhook_subscriptions"
 	v := new(WebhookSubscriptionPayload)
 	p := &WebhookSubscriptionPayload{WebhookSubscription: sub}
 
-	resp, err := s.client.newRequestDo("POST", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, p, v, WithRoute("/webhook_subscriptions"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -101,11 +114,16 @@ func (s *WebhookSubscriptionService) Create(sub *WebhookSubscription) (*WebhookS
 
 // Get gets a webhook subscription.
 func (s *WebhookSubscriptionService) Get(ID string) (*WebhookSubscription, *Response, error) {
+	return s.GetContext(context.Background(), ID)
+}
+
+// GetContext gets a webhook subscription.
+func (s *WebhookSubscriptionService) GetContext(ctx context.Context, ID string) (*WebhookSubscription, *Response, error) {
 	u := fmt.Sprintf("/webhook_subscriptions/%s", ID)
 	v := new(WebhookSubscriptionPayload)
 	p := &WebhookSubscriptionPayload{}
 
-	resp, err := s.client.newRequestDo("GET", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, p, v, WithRoute("/webhook_subscriptions/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -115,17 +133,27 @@ func (s *WebhookSubscriptionService) Get(ID string) (*WebhookSubscription, *Resp
 
 // Delete deletes a webhook subscription.
 func (s *WebhookSubscriptionService) Delete(ID string) (*Response, error) {
+	return s.DeleteContext(context.Background(), ID)
+}
+
+// DeleteContext deletes a webhook subscription.
+func (s *WebhookSubscriptionService) DeleteContext(ctx context.Context, ID string) (*Response, error) {
 	u := fmt.Sprintf("/webhook_subscriptions/%s", ID)
-	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, WithRoute("/webhook_subscriptions/{id}"))
 }
 
 // Update updates a webhook subscription.
 func (s *WebhookSubscriptionService) Update(ID string, sub *WebhookSubscription) (*WebhookSubscription, *Response, error) {
+	return s.UpdateContext(context.Background(), ID, sub)
+}
+
+// UpdateContext updates a webhook subscription.
+func (s *WebhookSubscriptionService) UpdateContext(ctx context.Context, ID string, sub *WebhookSubscription) (*WebhookSubscription, *Response, error) {
 	u := fmt.Sprintf("/webhook_subscriptions/%s", ID)
 	v := new(WebhookSubscriptionPayload)
 	p := WebhookSubscriptionPayload{WebhookSubscription: sub}
 
-	resp, err := s.client.newRequestDo("PUT", u, nil, p, v)
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, p, v, WithRoute("/webhook_subscriptions/{id}"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,217 @@
+// Package pagerdutytest provides a mock PagerDuty API server for testing code
+// built on the pagerduty package.
+//
+//	srv := pagerdutytest.NewServer()
+//	defer srv.Close()
+//
+//	srv.Handle("GET", "/users/PXPGF42", http.StatusOK, `{"user": {"id": "PXPGF42"}}`)
+//	user, _, err := srv.Client.Users.Get("PXPGF42", &pagerduty.GetUserOptions{})
+//
+//	req := srv.LastRequest()
+//	pagerdutytest.AssertAuthToken(t, req, pagerdutytest.Token)
+package pagerdutytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// Token is the API token used by the client returned by NewServer.
+const Token = "pagerdutytest-token"
+
+// notFoundBody is returned for requests without a registered response.
+const notFoundBody = `{"error": {"message": "Not Found", "code": 2100}}`
+
+// Server is a mock PagerDuty API server that records every request it
+// receives and replies with the responses registered for its method and
+// path, or HTTP 404 otherwise. It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	// Client is a client for the server, authenticated with Token and with
+	// retries disabled.
+	Client *pagerduty.Client
+
+	mu       sync.Mutex
+	handlers map[string]http.HandlerFunc
+	requests []*Request
+}
+
+// Request is a request received by a Server.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// NewServer starts a Server. Call Close when done with it.
+func NewServer() *Server {
+	s := &Server{handlers: make(map[string]http.HandlerFunc)}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	client, err := pagerduty.NewClient(&pagerduty.Config{
+		BaseURL:       s.URL,
+		EventsBaseURL: s.URL,
+		Token:         Token,
+		MaxRetries:    -1,
+	})
+	if err != nil {
+		s.Close()
+		panic(fmt.Sprintf("pagerdutytest: failed to create client: %v", err))
+	}
+	s.Client = client
+
+	return s
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, &Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	})
+	h, ok := s.handlers[r.Method+" "+r.URL.Path]
+	s.mu.Unlock()
+
+	if !ok {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		io.WriteString(w, notFoundBody)
+		return
+	}
+	h(w, r)
+}
+
+// HandleFunc registers h for requests with the given method and path,
+// replacing any previously registered response.
+func (s *Server) HandleFunc(method, path string, h http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers[method+" "+path] = h
+}
+
+// Handle registers a canned JSON response for requests with the given
+// method and path.
+func (s *Server) Handle(method, path string, status int, body string) {
+	s.HandleFunc(method, path, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		io.WriteString(w, body)
+	})
+}
+
+// HandleJSON registers a response with v encoded as JSON for requests with
+// the given method and path. It panics if v cannot be encoded.
+func (s *Server) HandleJSON(method, path string, status int, v interface{}) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Sprintf("pagerdutytest: failed to encode response for %s %s: %v", method, path, err))
+	}
+	s.Handle(method, path, status, string(body))
+}
+
+// Requests returns every request received so far, oldest first.
+func (s *Server) Requests() []*Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]*Request(nil), s.requests...)
+}
+
+// LastRequest returns the most recent request, or nil if there was none.
+func (s *Server) LastRequest() *Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.requests) == 0 {
+		return nil
+	}
+	return s.requests[len(s.requests)-1]
+}
+
+// Reset forgets all recorded requests and registered responses.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers = make(map[string]http.HandlerFunc)
+	s.requests = nil
+}
+
+// Decode decodes the JSON request body into v, e.g. a
+// *pagerduty.IncidentPayload.
+func (r *Request) Decode(v interface{}) error {
+	return json.Unmarshal(r.Body, v)
+}
+
+// AssertHeader fails the test if the request header key is not want.
+func AssertHeader(t testing.TB, r *Request, key, want string) {
+	t.Helper()
+
+	if got := r.Header.Get(key); got != want {
+		t.Errorf("%s %s: header %s = %q, want %q", r.Method, r.Path, key, got, want)
+	}
+}
+
+// AssertAuthToken fails the test if the request was not authenticated with
+// the REST API key token.
+func AssertAuthToken(t testing.TB, r *Request, token string) {
+	t.Helper()
+	AssertHeader(t, r, "Authorization", "Token token="+token)
+}
+
+// AssertBearerToken fails the test if the request was not authenticated with
+// the OAuth access token.
+func AssertBearerToken(t testing.TB, r *Request, token string) {
+	t.Helper()
+	AssertHeader(t, r, "Authorization", "Bearer "+token)
+}
+
+// AssertFrom fails the test if the request From header is not email.
+func AssertFrom(t testing.TB, r *Request, email string) {
+	t.Helper()
+	AssertHeader(t, r, "From", email)
+}
+
+// AssertEarlyAccess fails the test if the request does not opt into the
+// early access feature.
+func AssertEarlyAccess(t testing.TB, r *Request, feature string) {
+	t.Helper()
+
+	for _, v := range r.Header.Values("X-Early-Access") {
+		if v == feature {
+			return
+		}
+	}
+	t.Errorf("%s %s: header X-Early-Access = %q, want %q", r.Method, r.Path, r.Header.Values("X-Early-Access"), feature)
+}
+
+// DecodeBody decodes the JSON request body into v and fails the test if
+// that is not possible.
+func DecodeBody(t testing.TB, r *Request, v interface{}) {
+	t.Helper()
+
+	if err := r.Decode(v); err != nil {
+		t.Fatalf("%s %s: failed to decode request body %q: %v", r.Method, r.Path, r.Body, err)
+	}
+}
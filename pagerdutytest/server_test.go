@@ -0,0 +1,82 @@
+package pagerdutytest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestServer(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	srv.Handle("GET", "/users/PXPGF42", http.StatusOK, `{"user": {"id": "PXPGF42", "name": "Earline Greenholt"}}`)
+	srv.HandleJSON("POST", "/incidents", http.StatusCreated, &pagerduty.IncidentPayload{
+		Incident: &pagerduty.Incident{ID: "PT4KHLK"},
+	})
+
+	user, _, err := srv.Client.Users.Get("PXPGF42", &pagerduty.GetUserOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.Name != "Earline Greenholt" {
+		t.Errorf("user name = %q, want %q", user.Name, "Earline Greenholt")
+	}
+
+	incident, _, err := srv.Client.Incidents.Create("user@example.com", &pagerduty.CreateIncident{Title: "The server is on fire."})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if incident.ID != "PT4KHLK" {
+		t.Errorf("incident ID = %q, want %q", incident.ID, "PT4KHLK")
+	}
+
+	reqs := srv.Requests()
+	if len(reqs) != 2 {
+		t.Fatalf("got %d requests, want 2", len(reqs))
+	}
+	AssertAuthToken(t, reqs[0], Token)
+
+	req := srv.LastRequest()
+	AssertFrom(t, req, "user@example.com")
+
+	var payload pagerduty.IncidentPayload
+	DecodeBody(t, req, &payload)
+	if payload.Incident.Title != "The server is on fire." {
+		t.Errorf("incident title = %q, want %q", payload.Incident.Title, "The server is on fire.")
+	}
+}
+
+func TestServerNotFound(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	_, _, err := srv.Client.Teams.Get("P1")
+	if !pagerduty.IsNotFound(err) {
+		t.Fatalf("got error %v, want a not found error", err)
+	}
+
+	if req := srv.LastRequest(); req == nil || req.Method != "GET" || req.Path != "/teams/P1" {
+		t.Errorf("got request %+v, want GET /teams/P1", req)
+	}
+}
+
+func TestServerReset(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	srv.Handle("GET", "/teams/P1", http.StatusOK, `{"team": {"id": "P1"}}`)
+	if _, _, err := srv.Client.Teams.Get("P1"); err != nil {
+		t.Fatal(err)
+	}
+
+	srv.Reset()
+
+	if len(srv.Requests()) != 0 {
+		t.Error("requests were not reset")
+	}
+	if _, _, err := srv.Client.Teams.Get("P1"); !pagerduty.IsNotFound(err) {
+		t.Errorf("got error %v, want a not found error", err)
+	}
+}
@@ -0,0 +1,109 @@
+// Package webhook verifies and parses PagerDuty V3 webhooks.
+//
+// PagerDuty signs every webhook payload with the signing secret of the
+// webhook subscription and sends the signatures in the X-PagerDuty-Signature
+// header. Wrap the handler receiving webhooks with Handler to reject
+// requests that were not sent by PagerDuty:
+//
+//	http.Handle("/pagerduty", webhook.Handler([]string{secret}, h))
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// SignatureHeader is the request header holding the webhook signatures.
+const SignatureHeader = "X-PagerDuty-Signature"
+
+// MaxPayloadBytes is the largest request body accepted by Handler.
+const MaxPayloadBytes = 5 << 20
+
+const signatureVersion = "v1="
+
+var (
+	// ErrNoSecrets is returned by VerifySignature when no signing secret is
+	// given.
+	ErrNoSecrets = errors.New("webhook: no signing secrets")
+
+	// ErrMissingSignature is returned by VerifySignature when the header
+	// contains no v1 signature.
+	ErrMissingSignature = errors.New("webhook: missing v1 signature")
+
+	// ErrInvalidSignature is returned by VerifySignature when none of the
+	// signatures matches the payload signed with any of the secrets.
+	ErrInvalidSignature = errors.New("webhook: invalid signature")
+)
+
+// VerifySignature verifies that payload, the raw request body of a webhook,
+// was signed with one of secrets. header is the value of the
+// X-PagerDuty-Signature header, a comma separated list of signatures such as
+// "v1=abc,v1=def". Several secrets can be given to keep accepting webhooks
+// while a signing secret is rotated.
+func VerifySignature(payload []byte, header string, secrets []string) error {
+	if len(secrets) == 0 {
+		return ErrNoSecrets
+	}
+
+	var signatures [][]byte
+	for _, s := range strings.Split(header, ",") {
+		s = strings.TrimSpace(s)
+		if !strings.HasPrefix(s, signatureVersion) {
+			continue
+		}
+		sig, err := hex.DecodeString(strings.TrimPrefix(s, signatureVersion))
+		if err != nil {
+			continue
+		}
+		signatures = append(signatures, sig)
+	}
+	if len(signatures) == 0 {
+		return ErrMissingSignature
+	}
+
+	for _, secret := range secrets {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(payload)
+		expected := mac.Sum(nil)
+
+		for _, sig := range signatures {
+			if hmac.Equal(sig, expected) {
+				return nil
+			}
+		}
+	}
+
+	return ErrInvalidSignature
+}
+
+// Handler returns a handler that verifies the signature of every request
+// with VerifySignature before passing it on to next, which can read the
+// body as usual. Requests with a missing or invalid signature are rejected
+// with HTTP 401, bodies larger than MaxPayloadBytes with HTTP 413.
+func Handler(secrets []string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload, err := io.ReadAll(io.LimitReader(r.Body, MaxPayloadBytes+1))
+		if err != nil {
+			http.Error(w, "failed to read request body", http.StatusBadRequest)
+			return
+		}
+		if len(payload) > MaxPayloadBytes {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		if err := VerifySignature(payload, r.Header.Get(SignatureHeader), secrets); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(payload))
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,126 @@
+package webhook
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// The signatures are the hex encoded HMAC-SHA256 of payload with the
+// respective secret, computed independently of this package.
+const (
+	payload      = `{"event":{"id":"01DEN2HKB2QSUJ4F4ABZDYCJYT","event_type":"incident.triggered"}}`
+	signatureOne = "v1=a73fa5ba5d7ae23d269c9ee13035b94b86b7d60a6cac1ecc4724538fc5718331"
+	signatureTwo = "v1=54f1c95dee15422df799188d8dacde05172207861f45ee156bc1cdf27fb89a68"
+)
+
+func TestVerifySignature(t *testing.T) {
+	cases := []struct {
+		name    string
+		payload string
+		header  string
+		secrets []string
+		want    error
+	}{
+		{"valid", payload, signatureOne, []string{"secret-one"}, nil},
+		{"second signature", payload, signatureTwo + "," + signatureOne, []string{"secret-one"}, nil},
+		{"rotated secret", payload, signatureTwo, []string{"secret-one", "secret-two"}, nil},
+		{"spaces in header", payload, "v1=deadbeef, " + signatureOne, []string{"secret-one"}, nil},
+		{"wrong secret", payload, signatureOne, []string{"secret-two"}, ErrInvalidSignature},
+		{"tampered payload", payload + " ", signatureOne, []string{"secret-one"}, ErrInvalidSignature},
+		{"unknown version", payload, strings.Replace(signatureOne, "v1=", "v2=", 1), []string{"secret-one"}, ErrMissingSignature},
+		{"no signature", payload, "", []string{"secret-one"}, ErrMissingSignature},
+		{"malformed signature", payload, "v1=not-hex", []string{"secret-one"}, ErrMissingSignature},
+		{"no secrets", payload, signatureOne, nil, ErrNoSecrets},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := VerifySignature([]byte(tc.payload), tc.header, tc.secrets)
+			if !errors.Is(err, tc.want) {
+				t.Errorf("VerifySignature() = %v, want %v", err, tc.want)
+			}
+		})
+	}
+}
+
+// TestVerifySignatureTestVector checks a signature against HMAC-SHA256 test
+// case 2 of RFC 4231, sent in the v1 format of the X-PagerDuty-Signature
+// header.
+func TestVerifySignatureTestVector(t *testing.T) {
+	const (
+		payload = "what do ya want for nothing?"
+		secret  = "Jefe"
+		header  = "v1=5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843"
+	)
+
+	if err := VerifySignature([]byte(payload), header, []string{secret}); err != nil {
+		t.Errorf("VerifySignature() = %v, want nil", err)
+	}
+}
+
+// TestVerifySignatureV3Event checks a signature of a V3 webhook event laid
+// out as in the PagerDuty webhook documentation, with an X-PagerDuty-Signature
+// header carrying a signature of the previous secret as well. The signature
+// was computed with openssl dgst -sha256 -hmac.
+func TestVerifySignatureV3Event(t *testing.T) {
+	const (
+		payload = `{"event":{"id":"5ac64822-4adc-4fda-ade0-410becf0de4f","event_type":"incident.priority_updated","resource_type":"incident","occurred_at":"2020-10-02T18:45:22.169Z","agent":{"html_url":"https://acme.pagerduty.com/users/PLH1HKV","id":"PLH1HKV","self":"https://api.pagerduty.com/users/PLH1HKV","summary":"Tenex Engineer","type":"user_reference"},"client":null,"data":{"id":"PGR0VU2","type":"incident","self":"https://api.pagerduty.com/incidents/PGR0VU2","html_url":"https://acme.pagerduty.com/incidents/PGR0VU2","number":2,"status":"triggered","title":"A little bump in the road","priority":{"html_url":"https://acme.pagerduty.com/account/incident_priorities","id":"PSO75BM","self":"https://api.pagerduty.com/priorities/PSO75BM","summary":"P1","type":"priority"}}}}`
+		secret  = "whsec_example"
+		header  = "v1=54f1c95dee15422df799188d8dacde05172207861f45ee156bc1cdf27fb89a68,v1=2dbd7edfba3ef0ec20c29f2646e4c1fa79ea1df68a0201d2a234f25433205081"
+	)
+
+	if err := VerifySignature([]byte(payload), header, []string{secret}); err != nil {
+		t.Errorf("VerifySignature() = %v, want nil", err)
+	}
+	if err := VerifySignature([]byte(payload), header, []string{"whsec_other"}); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("VerifySignature() with another secret = %v, want %v", err, ErrInvalidSignature)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	var received string
+	h := Handler([]string{"secret-one"}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		received = string(body)
+	}))
+
+	cases := []struct {
+		name      string
+		body      string
+		signature string
+		want      int
+	}{
+		{"valid", payload, signatureOne, http.StatusOK},
+		{"invalid", payload, signatureTwo, http.StatusUnauthorized},
+		{"missing", payload, "", http.StatusUnauthorized},
+		{"too large", strings.Repeat(" ", MaxPayloadBytes+1), signatureOne, http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			received = ""
+			req := httptest.NewRequest("POST", "/webhooks", strings.NewReader(tc.body))
+			req.Header.Set(SignatureHeader, tc.signature)
+			rec := httptest.NewRecorder()
+
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tc.want {
+				t.Errorf("status = %d, want %d", rec.Code, tc.want)
+			}
+			if tc.want == http.StatusOK && received != tc.body {
+				t.Errorf("handler received body %q, want %q", received, tc.body)
+			}
+			if tc.want != http.StatusOK && received != "" {
+				t.Error("handler was called for a rejected request")
+			}
+		})
+	}
+}